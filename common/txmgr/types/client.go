@@ -98,9 +98,21 @@ type TxmClient[
 		fee FEE,
 		fromAddress ADDR,
 	) (txhash string, err error)
+	// CallContract replays the attempt at blockNumber to find out why it reverted.
 	CallContract(
 		ctx context.Context,
 		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD],
 		blockNumber *big.Int,
-	) (rpcErr fmt.Stringer, extractErr error)
+	) (revert RevertReason, extractErr error)
+}
+
+// RevertReason is why a transaction reverted on-chain.
+type RevertReason struct {
+	// Name identifies the kind of error, such as the name of a custom error.
+	// It is bounded, so it can be used as a metric label.
+	Name string
+	// Message describes the error with its arguments.
+	Message string
+	// RPCError is the raw error returned by the RPC node.
+	RPCError fmt.Stringer
 }
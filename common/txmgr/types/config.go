@@ -18,6 +18,7 @@ type TxmConfig[FEE_UNIT Unit] interface {
 type BroadcasterConfig[FEE_UNIT Unit] interface {
 	TriggerFallbackDBPollInterval() time.Duration
 	MaxInFlightTransactions() uint32
+	PriorityQueueingEnabled() bool
 
 	// from gas.Config
 	IsL2() bool
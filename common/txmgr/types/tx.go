@@ -1,6 +1,7 @@
 package types
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -28,6 +29,85 @@ type TxStrategy interface {
 	// It accepts the service responsible for deleting
 	// unstarted txs and deletion options
 	PruneQueue(pruneService UnstartedTxQueuePruner, qopt pg.QOpt) (n int64, err error)
+	// Priority will be saved txes.priority
+	Priority() TxPriority
+}
+
+// TxPriority is the priority class of a transaction. When priority queueing
+// is enabled, the Broadcaster shares sending keys between subjects in
+// proportion to the weight of their priority class.
+type TxPriority int16
+
+const (
+	TxPriorityLow      = TxPriority(-1)
+	TxPriorityNormal   = TxPriority(0)
+	TxPriorityHigh     = TxPriority(1)
+	TxPriorityCritical = TxPriority(2)
+)
+
+// Weight returns the share of a sending key that a subject with this priority
+// gets relative to other subjects. Each class has twice the weight of the
+// class below it.
+func (p TxPriority) Weight() uint32 {
+	switch {
+	case p <= TxPriorityLow:
+		return 1
+	case p >= TxPriorityCritical:
+		return 8
+	default:
+		return 1 << uint32(p+1)
+	}
+}
+
+func (p TxPriority) String() string {
+	switch p {
+	case TxPriorityLow:
+		return "low"
+	case TxPriorityNormal:
+		return "normal"
+	case TxPriorityHigh:
+		return "high"
+	case TxPriorityCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("TxPriority(%d)", int16(p))
+	}
+}
+
+// Value implements driver.Valuer, priorities are stored as integers.
+func (p TxPriority) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Scan implements sql.Scanner.
+func (p *TxPriority) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*p = TxPriority(v)
+		return nil
+	default:
+		return errors.Errorf("unable to convert %v of %T to TxPriority", value, value)
+	}
+}
+
+func (p TxPriority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *TxPriority) UnmarshalText(b []byte) error {
+	switch strings.ToLower(string(b)) {
+	case "low":
+		*p = TxPriorityLow
+	case "normal":
+		*p = TxPriorityNormal
+	case "high":
+		*p = TxPriorityHigh
+	case "critical":
+		*p = TxPriorityCritical
+	default:
+		return errors.Errorf("invalid transaction priority %q, must be one of: low, normal, high, critical", string(b))
+	}
+	return nil
 }
 
 type TxAttemptState string
@@ -111,6 +191,10 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// TraceContext is the propagated OpenTelemetry context of the span which
+	// created the tx, so that broadcasting and confirming it continue the trace.
+	TraceContext map[string]string `json:"TraceContext,omitempty"`
 }
 
 type TxAttempt[
@@ -180,6 +264,9 @@ type Tx[
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	FeeLimit uint32
 	Error    null.String
+	// RevertReason is the decoded reason the transaction reverted on-chain,
+	// if it did.
+	RevertReason null.String
 	// BroadcastAt is updated every time an attempt for this eth_tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -191,9 +278,10 @@ type Tx[
 	// Marshalled TxMeta
 	// Used for additional context around transactions which you want to log
 	// at send time.
-	Meta    *datatypes.JSON
-	Subject uuid.NullUUID
-	ChainID CHAIN_ID
+	Meta     *datatypes.JSON
+	Subject  uuid.NullUUID
+	Priority TxPriority
+	ChainID  CHAIN_ID
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
@@ -23,4 +23,8 @@ type Head[BLOCK_HASH Hashable] interface {
 	// HashAtHeight returns the hash of the block at the given height, if it is in the chain.
 	// If not in chain, returns the zero hash
 	HashAtHeight(blockNum int64) BLOCK_HASH
+
+	// LatestFinalizedHead returns the latest head in the chain that is marked as finalized.
+	// If no head in the chain is marked as finalized, returns nil
+	LatestFinalizedHead() Head[BLOCK_HASH]
 }
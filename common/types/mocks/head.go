@@ -100,6 +100,22 @@ func (_m *Head[BLOCK_HASH]) HashAtHeight(blockNum int64) BLOCK_HASH {
 	return r0
 }
 
+// LatestFinalizedHead provides a mock function with given fields:
+func (_m *Head[BLOCK_HASH]) LatestFinalizedHead() types.Head[BLOCK_HASH] {
+	ret := _m.Called()
+
+	var r0 types.Head[BLOCK_HASH]
+	if rf, ok := ret.Get(0).(func() types.Head[BLOCK_HASH]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Head[BLOCK_HASH])
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewHead interface {
 	mock.TestingT
 	Cleanup(func())
@@ -51,6 +51,9 @@ type ChainSet interface {
 	Configs() types.Configs
 
 	SendTx(ctx context.Context, chainID, from, to string, amount *big.Int, balanceCheck bool) error
+
+	// NodeStats returns the rolling RPC stats of the named node, if it is running.
+	NodeStats(chainID, name string) (stats client.NodeStats, ok bool)
 }
 
 type chainSet struct {
@@ -231,6 +234,17 @@ func (cll *chainSet) addStateToNode(n *relaytypes.NodeStatus) {
 	n.State = "NotLoaded"
 }
 
+func (cll *chainSet) NodeStats(chainID, name string) (stats client.NodeStats, ok bool) {
+	cll.chainsMu.RLock()
+	chain, exists := cll.chains[chainID]
+	cll.chainsMu.RUnlock()
+	if !exists {
+		return
+	}
+	stats, ok = chain.Client().NodeStats()[name]
+	return
+}
+
 func (cll *chainSet) SendTx(ctx context.Context, chainID, from, to string, amount *big.Int, balanceCheck bool) error {
 	chain, err := cll.get(chainID)
 	if err != nil {
@@ -37,6 +37,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeStats returns a map of node Name->rolling RPC stats, for primary nodes only
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeStats() map[string]NodeStats
 
 	// Wrapped RPC methods
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -126,6 +129,14 @@ func (client *client) NodeStates() (states map[string]string) {
 	return
 }
 
+func (client *client) NodeStats() (stats map[string]NodeStats) {
+	stats = make(map[string]NodeStats)
+	for _, n := range client.pool.nodes {
+		stats[n.Name()] = n.Stats()
+	}
+	return
+}
+
 // CallArgs represents the data used to call the balance method of a contract.
 // "To" is the address of the ERC contract. "Data" is the message sent
 // to the contract. "From" is the sender address.
@@ -288,10 +299,17 @@ func (client *client) HeadByHash(ctx context.Context, hash common.Hash) (head *e
 	return
 }
 
+// ToBlockNumArg converts a block number to its JSON-RPC representation. Negative numbers are
+// interpreted as block tags, e.g. rpc.FinalizedBlockNumber.
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.Sign() < 0 && number.IsInt64() {
+		if tag, err := rpc.BlockNumber(number.Int64()).MarshalText(); err == nil {
+			return string(tag)
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
@@ -22,6 +22,10 @@ type erroringNode struct {
 
 func (e *erroringNode) ChainID() (chainID *big.Int) { return nil }
 
+func (e *erroringNode) Stats() NodeStats { return NodeStats{} }
+
+func (e *erroringNode) ReportQuorum(agreed bool) {}
+
 func (e *erroringNode) Start(ctx context.Context) error { return errors.New(e.errMsg) }
 
 func (e *erroringNode) Close() error { return nil }
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	clienttypes "github.com/smartcontractkit/chainlink/v2/common/chains/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/revertreason"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -358,6 +361,40 @@ func (err *JsonError) String() string {
 	return fmt.Sprintf("json-rpc error { Code = %d, Message = '%s', Data = '%v' }", err.Code, err.Message, err.Data)
 }
 
+// RevertData returns the ABI encoded revert data of the error, if the RPC node
+// included it. Nodes differ in the format, e.g. "0xABC123..." for geth and
+// "Reverted 0xABC123..." for parity. Data which was already decoded to bytes is
+// returned as is.
+func (err *JsonError) RevertData() []byte {
+	switch data := err.Data.(type) {
+	case []byte:
+		return data
+	case hexutil.Bytes:
+		return data
+	case string:
+		decoded, decodeErr := hexutil.Decode(strings.TrimPrefix(data, "Reverted "))
+		if decodeErr != nil {
+			return nil
+		}
+		return decoded
+	default:
+		return nil
+	}
+}
+
+// RevertReason decodes why a call reverted from the revert data of the error.
+// Without revert data, it falls back to the reason in the message of nodes
+// that decode require(condition, "reason") themselves.
+func (err *JsonError) RevertReason() revertreason.Reason {
+	data := err.RevertData()
+	if len(data) == 0 {
+		if msg, ok := strings.CutPrefix(err.Message, "execution reverted: "); ok {
+			return revertreason.Reason{Name: revertreason.NameError, Message: fmt.Sprintf("Error(%q)", msg)}
+		}
+	}
+	return revertreason.Decode(data)
+}
+
 func ExtractRPCErrorOrNil(err error) *JsonError {
 	jErr, eErr := ExtractRPCError(err)
 	if eErr != nil {
@@ -3,6 +3,7 @@ package client_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
@@ -347,3 +348,36 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 		})
 	}
 }
+
+func Test_JsonError_RevertReason(t *testing.T) {
+	t.Parallel()
+
+	// Error("not enough LINK")
+	const errorData = "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000"
+
+	tests := []struct {
+		name      string
+		jErr      evmclient.JsonError
+		expect    string
+		expectLen int
+	}{
+		{"geth", evmclient.JsonError{Code: 3, Message: "execution reverted: not enough LINK", Data: errorData}, `Error("not enough LINK")`, 100},
+		{"parity", evmclient.JsonError{Code: -32015, Message: "VM execution error.", Data: "Reverted " + errorData}, `Error("not enough LINK")`, 100},
+		{"reason in message only", evmclient.JsonError{Code: 3, Message: "execution reverted: not enough LINK"}, `Error("not enough LINK")`, 0},
+		{"no reason", evmclient.JsonError{Code: 3, Message: "execution reverted"}, "reverted without a reason", 0},
+		{"custom error", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0xf4d678b8"}, "InsufficientBalance()", 4},
+		{"undecodable data", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "foo"}, "reverted without a reason", 0},
+		{"bytes", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: hexutil.MustDecode(errorData)}, `Error("not enough LINK")`, 100},
+		{"hexutil bytes", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: hexutil.Bytes(hexutil.MustDecode(errorData))}, `Error("not enough LINK")`, 100},
+		{"unsupported data", evmclient.JsonError{Code: 3, Message: "execution reverted", Data: 42}, "reverted without a reason", 0},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Len(t, test.jErr.RevertData(), test.expectLen)
+			assert.Equal(t, test.expect, test.jErr.RevertReason().Message)
+		})
+	}
+}
@@ -19,6 +19,14 @@ type TestNodeConfig struct {
 	PollInterval         time.Duration
 	SelectionMode        string
 	SyncThreshold        uint32
+	Quorum               TestQuorumConfig
+}
+
+type TestQuorumConfig struct {
+	CallContext           uint32
+	CallContract          uint32
+	BlockByNumber         uint32
+	DisagreementThreshold uint32
 }
 
 func (tc TestNodeConfig) NodeNoNewHeadsThreshold() time.Duration { return tc.NoNewHeadsThreshold }
@@ -26,6 +34,12 @@ func (tc TestNodeConfig) NodePollFailureThreshold() uint32       { return tc.Pol
 func (tc TestNodeConfig) NodePollInterval() time.Duration        { return tc.PollInterval }
 func (tc TestNodeConfig) NodeSelectionMode() string              { return tc.SelectionMode }
 func (tc TestNodeConfig) NodeSyncThreshold() uint32              { return tc.SyncThreshold }
+func (tc TestNodeConfig) NodeQuorumCallContext() uint32          { return tc.Quorum.CallContext }
+func (tc TestNodeConfig) NodeQuorumCallContract() uint32         { return tc.Quorum.CallContract }
+func (tc TestNodeConfig) NodeQuorumBlockByNumber() uint32        { return tc.Quorum.BlockByNumber }
+func (tc TestNodeConfig) NodeQuorumDisagreementThreshold() uint32 {
+	return tc.Quorum.DisagreementThreshold
+}
 
 func NewClientWithTestNode(t *testing.T, cfg NodeConfig, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, id int32, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
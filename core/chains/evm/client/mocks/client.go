@@ -9,6 +9,8 @@ import (
 
 	chainsclient "github.com/smartcontractkit/chainlink/v2/common/chains/client"
 
+	client "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	context "context"
@@ -527,6 +529,22 @@ func (_m *Client) NodeStates() map[string]string {
 	return r0
 }
 
+// NodeStats provides a mock function with given fields:
+func (_m *Client) NodeStats() map[string]client.NodeStats {
+	ret := _m.Called()
+
+	var r0 map[string]client.NodeStats
+	if rf, ok := ret.Get(0).(func() map[string]client.NodeStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]client.NodeStats)
+		}
+	}
+
+	return r0
+}
+
 // PendingCodeAt provides a mock function with given fields: ctx, account
 func (_m *Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	ret := _m.Called(ctx, account)
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -92,6 +93,11 @@ type Node interface {
 	// Name is a unique identifier for this node.
 	Name() string
 	ChainID() *big.Int
+	// Stats returns the rolling RPC latency and error rate of the node.
+	Stats() NodeStats
+	// ReportQuorum records whether the node agreed with the majority of the
+	// pool on a read made in quorum mode.
+	ReportQuorum(agreed bool)
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -145,6 +151,13 @@ type node struct {
 	stateLatestBlockNumber     int64
 	stateLatestTotalDifficulty *utils.Big
 
+	rpcStats rpcStats
+	// quorumDisagreements counts consecutive disagreements with the pool
+	quorumDisagreements atomic.Uint32
+	// chQuorumDisagreement is signalled when quorumDisagreements reaches
+	// NodeQuorumDisagreementThreshold
+	chQuorumDisagreement chan struct{}
+
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
 	subs []ethereum.Subscription
@@ -174,6 +187,10 @@ type NodeConfig interface {
 	NodePollInterval() time.Duration
 	NodeSelectionMode() string
 	NodeSyncThreshold() uint32
+	NodeQuorumCallContext() uint32
+	NodeQuorumCallContract() uint32
+	NodeQuorumBlockByNumber() uint32
+	NodeQuorumDisagreementThreshold() uint32
 }
 
 // NewNode returns a new *node as Node
@@ -188,6 +205,7 @@ func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url
 		n.http = &rawclient{uri: *httpuri}
 	}
 	n.chStopInFlight = make(chan struct{})
+	n.chQuorumDisagreement = make(chan struct{}, 1)
 	n.nodeCtx, n.cancelNodeCtx = context.WithCancel(context.Background())
 	lggr = lggr.Named("Node").With(
 		"nodeTier", "primary",
@@ -996,6 +1014,7 @@ func (n *node) logResult(
 	results ...interface{},
 ) {
 	lggr = lggr.With("duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	n.observeCall(callDuration, err)
 	promEVMPoolRPCNodeCalls.WithLabelValues(n.chainID.String(), n.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(n.chainID.String(), n.name).Inc()
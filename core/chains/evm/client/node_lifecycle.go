@@ -195,6 +195,16 @@ func (n *node) aliveLoop() {
 				outOfSyncT.Reset(noNewHeadsTimeoutThreshold)
 			}
 			n.setLatestReceived(bh.Number, bh.TotalDifficulty)
+		case <-n.chQuorumDisagreement:
+			lggr.Errorw(fmt.Sprintf("RPC endpoint disagreed with the other RPC endpoints on %d consecutive quorum reads", n.cfg.NodeQuorumDisagreementThreshold()), "nodeState", n.State())
+			if n.nLiveNodes != nil {
+				if l, _, _ := n.nLiveNodes(); l < 2 {
+					lggr.Criticalf("RPC endpoint disagreed with the other RPC endpoints; %s %s", msgCannotDisable, msgDegradedState)
+					continue
+				}
+			}
+			n.declareOutOfSync(n.isOutOfSync)
+			return
 		case err := <-sub.Err():
 			lggr.Errorw("Subscription was terminated", "err", err, "nodeState", n.State())
 			n.declareUnreachable()
@@ -238,7 +248,7 @@ func (n *node) syncStatus(num int64, td *utils.Big) (outOfSync bool, liveNodes i
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.cfg.NodeSelectionMode()
 	switch mode {
-	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin:
+	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin, NodeSelectionMode_LatencyWeighted:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionMode_TotalDifficulty:
 		bigThreshold := utils.NewBigI(int64(threshold))
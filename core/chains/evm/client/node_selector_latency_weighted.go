@@ -0,0 +1,69 @@
+package client
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// latencyWeightedTolerance is how much worse than the best score a node can be
+// while still sharing the top priority level.
+const latencyWeightedTolerance = 1.5
+
+type latencyWeightedNodeSelector struct {
+	nodes           []Node
+	roundRobinCount atomic.Uint32
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector that prefers the alive
+// nodes with the lowest rolling latency and error rate. Nodes scoring within
+// latencyWeightedTolerance of the best form the top priority level and are
+// picked round robin, so that load is spread and stats keep getting refreshed.
+// Slower nodes are demoted rather than taken out of the pool, and are used
+// again as soon as they are the fastest available.
+func NewLatencyWeightedNodeSelector(nodes []Node) NodeSelector {
+	return &latencyWeightedNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *latencyWeightedNodeSelector) Select() Node {
+	var liveNodes []Node
+	var scores []time.Duration
+	var best time.Duration = -1
+	for _, n := range s.nodes {
+		if n.State() != NodeStateAlive {
+			continue
+		}
+		stats := n.Stats()
+		score := stats.Score
+		if stats.Calls == 0 {
+			// Nodes without any calls yet are given the best score so that
+			// they get sampled.
+			score = 0
+		}
+		liveNodes = append(liveNodes, n)
+		scores = append(scores, score)
+		if best < 0 || score < best {
+			best = score
+		}
+	}
+	if len(liveNodes) == 0 {
+		return nil
+	}
+
+	cutoff := time.Duration(float64(best) * latencyWeightedTolerance)
+	var topNodes []Node
+	for i, n := range liveNodes {
+		if scores[i] <= cutoff {
+			topNodes = append(topNodes, n)
+		}
+	}
+
+	// NOTE: Add returns the number after addition, so we must -1 to get the "current" counter
+	count := s.roundRobinCount.Add(1) - 1
+	return topNodes[int(count%uint32(len(topNodes)))]
+}
+
+func (s *latencyWeightedNodeSelector) Name() string {
+	return NodeSelectionMode_LatencyWeighted
+}
@@ -0,0 +1,101 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for _, s := range []struct {
+		state evmclient.NodeState
+		stats evmclient.NodeStats
+	}{
+		{evmclient.NodeStateOutOfSync, evmclient.NodeStats{Score: time.Millisecond, Calls: 10}},
+		{evmclient.NodeStateAlive, evmclient.NodeStats{Score: 100 * time.Millisecond, Calls: 10}},
+		{evmclient.NodeStateAlive, evmclient.NodeStats{Score: 140 * time.Millisecond, Calls: 10}},
+		{evmclient.NodeStateAlive, evmclient.NodeStats{Score: 500 * time.Millisecond, Calls: 10}},
+	} {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(s.state)
+		if s.state == evmclient.NodeStateAlive {
+			node.On("Stats").Return(s.stats)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Equal(t, evmclient.NodeSelectionMode_LatencyWeighted, selector.Name())
+	// the slow node is demoted, the two fastest alive nodes are used in turn
+	assert.Same(t, nodes[1], selector.Select())
+	assert.Same(t, nodes[2], selector.Select())
+	assert.Same(t, nodes[1], selector.Select())
+	assert.Same(t, nodes[2], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_SlowNodeOnly(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 2; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is unreachable
+			node.On("State").Return(evmclient.NodeStateUnreachable)
+		} else {
+			// second node is slow but still alive
+			node.On("State").Return(evmclient.NodeStateAlive)
+			node.On("Stats").Return(evmclient.NodeStats{Score: 3 * time.Second, ErrorRate: 0.5, Calls: 10})
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Same(t, nodes[1], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_NewNodeIsSampled(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for _, calls := range []uint64{10, 0} {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(evmclient.NodeStateAlive)
+		node.On("Stats").Return(evmclient.NodeStats{Score: time.Second, Calls: calls})
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Same(t, nodes[1], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(evmclient.NodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
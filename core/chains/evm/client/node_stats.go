@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promEVMPoolRPCNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_latency_seconds",
+		Help: "The moving average of RPC call durations for the given RPC node",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_error_rate",
+		Help: "The moving average of the fraction of RPC calls to the given RPC node that failed, between 0 and 1",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_score",
+		Help: "The score of the given RPC node used by the LatencyWeighted selection mode, in seconds. Lower is better",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeQuorumDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_quorum_disagreements",
+		Help: "The total number of quorum reads where the given RPC node disagreed with the majority",
+	}, []string{"evmChainID", "nodeName"})
+)
+
+const (
+	// statsSmoothing is the weight of each new sample in the moving averages.
+	// Roughly the last 1/statsSmoothing calls are reflected.
+	statsSmoothing = 0.1
+	// failurePenalty is the latency that a failed call is considered to have
+	// cost when scoring a node, on top of its measured latency.
+	failurePenalty = 5 * time.Second
+)
+
+// NodeStats holds the rolling RPC performance of a node.
+type NodeStats struct {
+	// Latency is the moving average of RPC call durations.
+	Latency time.Duration
+	// ErrorRate is the moving average of the fraction of failed RPC calls,
+	// between 0 and 1.
+	ErrorRate float64
+	// Score ranks nodes for the LatencyWeighted selection mode. It is the
+	// average latency with failed calls weighted by failurePenalty, so lower
+	// is better.
+	Score time.Duration
+	// Calls is the number of RPC calls observed.
+	Calls uint64
+}
+
+// rpcStats tracks the moving averages of RPC latency and error rate of a node.
+type rpcStats struct {
+	mu        sync.RWMutex
+	latency   float64
+	errorRate float64
+	calls     uint64
+}
+
+// observe records the outcome of a call. Calls cancelled by the caller are
+// ignored, and JSON-RPC errors such as reverts count as successful calls since
+// the node did respond.
+func (s *rpcStats) observe(d time.Duration, err error) (stats NodeStats, ok bool) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	var failed float64
+	var rpcErr rpc.Error
+	if err != nil && !errors.As(err, &rpcErr) {
+		failed = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.calls == 0 {
+		s.latency = float64(d)
+		s.errorRate = failed
+	} else {
+		s.latency += statsSmoothing * (float64(d) - s.latency)
+		s.errorRate += statsSmoothing * (failed - s.errorRate)
+	}
+	s.calls++
+	return s.statsLocked(), true
+}
+
+// penalize records a failure without a latency sample, for a call that
+// succeeded but returned a wrong result.
+func (s *rpcStats) penalize() NodeStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.calls == 0 {
+		s.errorRate = 1
+	} else {
+		s.errorRate += statsSmoothing * (1 - s.errorRate)
+	}
+	return s.statsLocked()
+}
+
+func (s *rpcStats) stats() NodeStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.statsLocked()
+}
+
+func (s *rpcStats) statsLocked() NodeStats {
+	return NodeStats{
+		Latency:   time.Duration(s.latency),
+		ErrorRate: s.errorRate,
+		Score:     time.Duration(s.latency + s.errorRate*float64(failurePenalty)),
+		Calls:     s.calls,
+	}
+}
+
+// Stats returns the rolling RPC performance of the node.
+func (n *node) Stats() NodeStats {
+	return n.rpcStats.stats()
+}
+
+func (n *node) observeCall(d time.Duration, err error) {
+	stats, ok := n.rpcStats.observe(d, err)
+	if !ok {
+		return
+	}
+	n.reportStats(stats)
+}
+
+// ReportQuorum records whether the node agreed with the majority of the pool on
+// a quorum read. Disagreements count as failed calls in the node stats, and a
+// node that disagrees NodeQuorumDisagreementThreshold times in a row is
+// declared out of sync by its alive loop.
+func (n *node) ReportQuorum(agreed bool) {
+	if agreed {
+		n.quorumDisagreements.Store(0)
+		return
+	}
+	promEVMPoolRPCNodeQuorumDisagreements.WithLabelValues(n.chainID.String(), n.name).Inc()
+	n.reportStats(n.rpcStats.penalize())
+	threshold := n.cfg.NodeQuorumDisagreementThreshold()
+	if threshold == 0 || n.quorumDisagreements.Add(1) < threshold {
+		return
+	}
+	n.quorumDisagreements.Store(0)
+	select {
+	case n.chQuorumDisagreement <- struct{}{}:
+	default:
+	}
+}
+
+func (n *node) reportStats(stats NodeStats) {
+	chainID := n.chainID.String()
+	promEVMPoolRPCNodeLatency.WithLabelValues(chainID, n.name).Set(stats.Latency.Seconds())
+	promEVMPoolRPCNodeErrorRate.WithLabelValues(chainID, n.name).Set(stats.ErrorRate)
+	promEVMPoolRPCNodeScore.WithLabelValues(chainID, n.name).Set(stats.Score.Seconds())
+}
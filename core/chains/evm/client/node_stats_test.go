@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type jsonRPCError struct{}
+
+func (jsonRPCError) Error() string  { return "execution reverted" }
+func (jsonRPCError) ErrorCode() int { return 3 }
+
+func TestRPCStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("first call sets the averages", func(t *testing.T) {
+		var s rpcStats
+		stats, ok := s.observe(100*time.Millisecond, nil)
+		assert.True(t, ok)
+		assert.Equal(t, 100*time.Millisecond, stats.Latency)
+		assert.Equal(t, 0.0, stats.ErrorRate)
+		assert.Equal(t, 100*time.Millisecond, stats.Score)
+		assert.Equal(t, uint64(1), stats.Calls)
+		assert.Equal(t, stats, s.stats())
+	})
+
+	t.Run("failures raise the error rate and score", func(t *testing.T) {
+		var s rpcStats
+		s.observe(100*time.Millisecond, nil)
+		stats, ok := s.observe(200*time.Millisecond, errors.New("connection refused"))
+		assert.True(t, ok)
+		assert.Equal(t, 110*time.Millisecond, stats.Latency)
+		assert.InDelta(t, 0.1, stats.ErrorRate, 1e-9)
+		assert.Equal(t, 610*time.Millisecond, stats.Score)
+	})
+
+	t.Run("json-rpc errors are not failures", func(t *testing.T) {
+		var s rpcStats
+		stats, ok := s.observe(100*time.Millisecond, errors.Wrap(jsonRPCError{}, "CallContract"))
+		assert.True(t, ok)
+		assert.Equal(t, 0.0, stats.ErrorRate)
+	})
+
+	t.Run("cancelled calls are ignored", func(t *testing.T) {
+		var s rpcStats
+		_, ok := s.observe(time.Second, errors.Wrap(context.Canceled, "CallContext"))
+		assert.False(t, ok)
+		assert.Equal(t, NodeStats{}, s.stats())
+	})
+}
+
+func TestNode_ReportQuorum(t *testing.T) {
+	t.Parallel()
+
+	cfg := TestNodeConfig{Quorum: TestQuorumConfig{DisagreementThreshold: 2}}
+	n := NewNode(cfg, logger.TestLogger(t), url.URL{Scheme: "ws", Host: "localhost"}, nil, "test", 0, big.NewInt(1)).(*node)
+
+	n.ReportQuorum(false)
+	n.ReportQuorum(true)
+	n.ReportQuorum(false)
+	assert.Len(t, n.chQuorumDisagreement, 0)
+	assert.Greater(t, n.Stats().ErrorRate, 0.0)
+
+	n.ReportQuorum(false)
+	assert.Len(t, n.chQuorumDisagreement, 1)
+	// the counter starts over once the alive loop has been signalled
+	n.ReportQuorum(false)
+	assert.Len(t, n.chQuorumDisagreement, 1)
+}
@@ -34,6 +34,7 @@ const (
 	NodeSelectionMode_HighestHead     = "HighestHead"
 	NodeSelectionMode_RoundRobin      = "RoundRobin"
 	NodeSelectionMode_TotalDifficulty = "TotalDifficulty"
+	NodeSelectionMode_LatencyWeighted = "LatencyWeighted"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -49,6 +50,9 @@ type NodeSelector interface {
 type PoolConfig interface {
 	NodeSelectionMode() string
 	NodeNoNewHeadsThreshold() time.Duration
+	NodeQuorumCallContext() uint32
+	NodeQuorumCallContract() uint32
+	NodeQuorumBlockByNumber() uint32
 }
 
 // Pool represents an abstraction over one or more primary nodes
@@ -83,6 +87,8 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 			return NewRoundRobinSelector(nodes)
 		case NodeSelectionMode_TotalDifficulty:
 			return NewTotalDifficultyNodeSelector(nodes)
+		case NodeSelectionMode_LatencyWeighted:
+			return NewLatencyWeightedNodeSelector(nodes)
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
@@ -276,6 +282,9 @@ func (p *Pool) selectNode() (node Node) {
 }
 
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if size := p.config.NodeQuorumCallContext(); size > 1 && quorumSafe(method, args) {
+		return p.quorumCallContext(ctx, int(size), result, method, args...)
+	}
 	return p.selectNode().CallContext(ctx, result, method, args...)
 }
 
@@ -385,6 +394,9 @@ func (p *Pool) TransactionByHash(ctx context.Context, txHash common.Hash) (*type
 }
 
 func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if size := p.config.NodeQuorumBlockByNumber(); size > 1 {
+		return p.quorumBlockByNumber(ctx, int(size), number)
+	}
 	return p.selectNode().BlockByNumber(ctx, number)
 }
 
@@ -417,6 +429,9 @@ func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if size := p.config.NodeQuorumCallContract(); size > 1 {
+		return p.quorumCallContract(ctx, int(size), msg, blockNumber)
+	}
 	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
@@ -0,0 +1,336 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// QuorumError is returned by reads in quorum mode when not enough nodes agree
+// on the result.
+type QuorumError struct {
+	Method string
+	// Required is the number of matching responses needed
+	Required int
+	// Responses is the number of nodes that responded
+	Responses int
+	// Agreeing is the number of responses matching the most common result
+	Agreeing int
+}
+
+func (e *QuorumError) Error() string {
+	return fmt.Sprintf("quorum not reached for %s: %d of %d responses agreed, but %d are required", e.Method, e.Agreeing, e.Responses, e.Required)
+}
+
+// quorumSafeMethods return the same result from every node in sync once their
+// latest block tags are pinned. Other methods, e.g. eth_blockNumber or
+// eth_gasPrice, depend on the node's head, mempool or peers, and so
+// legitimately differ between nodes. They are never verified.
+var quorumSafeMethods = map[string]struct{}{
+	"eth_call":                                {},
+	"eth_chainId":                             {},
+	"eth_getBalance":                          {},
+	"eth_getBlockByHash":                      {},
+	"eth_getBlockByNumber":                    {},
+	"eth_getBlockTransactionCountByHash":      {},
+	"eth_getBlockTransactionCountByNumber":    {},
+	"eth_getCode":                             {},
+	"eth_getLogs":                             {},
+	"eth_getProof":                            {},
+	"eth_getStorageAt":                        {},
+	"eth_getTransactionByBlockHashAndIndex":   {},
+	"eth_getTransactionByBlockNumberAndIndex": {},
+	"net_version":                             {},
+}
+
+// quorumFilterBlockKeys are the keys of the block tags of an eth_getLogs
+// filter.
+var quorumFilterBlockKeys = []string{"fromBlock", "toBlock"}
+
+// quorumSafe returns true if the call can be verified against other nodes.
+func quorumSafe(method string, args []interface{}) bool {
+	if _, ok := quorumSafeMethods[method]; !ok {
+		return false
+	}
+	for _, a := range args {
+		if f, ok := a.(map[string]interface{}); ok && method == "eth_getLogs" {
+			for _, key := range quorumFilterBlockKeys {
+				if !quorumSafeTag(f[key]) {
+					return false
+				}
+			}
+		} else if !quorumSafeTag(a) {
+			return false
+		}
+	}
+	// Filters of other types cannot be pinned.
+	if method == "eth_getLogs" {
+		if len(args) != 1 {
+			return false
+		}
+		if _, ok := args[0].(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// quorumSafeTag returns false for the block tags which differ between nodes
+// and cannot be pinned.
+func quorumSafeTag(a interface{}) bool {
+	switch v := a.(type) {
+	case string:
+		return v != "pending" && v != "safe" && v != "finalized"
+	case rpc.BlockNumber:
+		return v != rpc.PendingBlockNumber && v != rpc.SafeBlockNumber && v != rpc.FinalizedBlockNumber
+	}
+	return true
+}
+
+// quorumNodes returns up to size alive nodes, starting with the selected one.
+func (p *Pool) quorumNodes(size int) (nodes []Node) {
+	main := p.selectNode()
+	if main.State() == NodeStateAlive {
+		nodes = append(nodes, main)
+	}
+	for _, n := range p.nodes {
+		if len(nodes) >= size {
+			break
+		}
+		if n != main && n.State() == NodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	return
+}
+
+// quorumBlock returns the latest block seen by all nodes, so that reads of the
+// latest block can be compared between nodes that are a few blocks apart.
+// It returns nil if a node has not seen any head yet.
+func quorumBlock(nodes []Node) *big.Int {
+	var lowest int64 = -1
+	for _, n := range nodes {
+		_, num, _ := n.StateAndLatest()
+		if num <= 0 {
+			return nil
+		}
+		if lowest < 0 || num < lowest {
+			lowest = num
+		}
+	}
+	if lowest < 0 {
+		return nil
+	}
+	return big.NewInt(lowest)
+}
+
+// pinLatest replaces latest block tags in args, including the fromBlock and
+// toBlock of eth_getLogs filters, with block. Filters without a block hash
+// default to the latest block. It returns false if args need pinning but block
+// is nil.
+func pinLatest(method string, args []interface{}, block *big.Int) ([]interface{}, bool) {
+	ok := true
+	pin := func(a interface{}) interface{} {
+		if !isLatestTag(a) {
+			return a
+		}
+		if block == nil {
+			ok = false
+			return a
+		}
+		return hexutil.EncodeBig(block)
+	}
+	pinned := make([]interface{}, len(args))
+	for i, a := range args {
+		if f, isFilter := a.(map[string]interface{}); isFilter && method == "eth_getLogs" {
+			pf := make(map[string]interface{}, len(f)+len(quorumFilterBlockKeys))
+			for k, v := range f {
+				pf[k] = v
+			}
+			if _, byHash := f["blockHash"]; !byHash {
+				for _, key := range quorumFilterBlockKeys {
+					v, set := f[key]
+					if !set || v == nil {
+						v = "latest"
+					}
+					pf[key] = pin(v)
+				}
+			}
+			a = pf
+		} else {
+			a = pin(a)
+		}
+		pinned[i] = a
+	}
+	return pinned, ok
+}
+
+func isLatestTag(a interface{}) bool {
+	switch v := a.(type) {
+	case string:
+		return v == "latest"
+	case rpc.BlockNumber:
+		return v == rpc.LatestBlockNumber
+	}
+	return false
+}
+
+type quorumResponse[T any] struct {
+	result T
+	err    error
+	key    string
+	ok     bool
+}
+
+// quorumCall calls every node concurrently and returns the result that a
+// majority of size nodes agree on, as identified by key. JSON-RPC errors such
+// as reverts count as results, other errors mean that the node did not respond.
+// Once a majority is reached, every node that responded reports whether it
+// agreed, to feed its health checks.
+func quorumCall[T any](ctx context.Context, lggr logger.Logger, method string, size int, nodes []Node, call func(context.Context, Node) (T, error), key func(T) string) (result T, err error) {
+	responses := make([]quorumResponse[T], len(nodes))
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n Node) {
+			defer wg.Done()
+			r := &responses[i]
+			r.result, r.err = call(ctx, n)
+			var rpcErr rpc.Error
+			switch {
+			case r.err == nil:
+				r.key, r.ok = key(r.result), true
+			case errors.As(r.err, &rpcErr):
+				r.key, r.ok = quorumErrorKey(rpcErr), true
+			default:
+				lggr.Debugw("Node failed to respond to quorum read", "node", n.String(), "method", method, "err", r.err)
+			}
+		}(i, n)
+	}
+	wg.Wait()
+
+	votes := make(map[string]int)
+	var responded int
+	var best string
+	for _, r := range responses {
+		if !r.ok {
+			continue
+		}
+		responded++
+		votes[r.key]++
+		if votes[r.key] > votes[best] {
+			best = r.key
+		}
+	}
+
+	required := size/2 + 1
+	if votes[best] < required {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+			return
+		}
+		err = &QuorumError{Method: method, Required: required, Responses: responded, Agreeing: votes[best]}
+		lggr.Warnw("Quorum not reached", "method", method, "err", err)
+		return
+	}
+
+	var winner *quorumResponse[T]
+	for i, r := range responses {
+		if !r.ok {
+			continue
+		}
+		agreed := r.key == best
+		if !agreed {
+			lggr.Warnw("Node disagreed with the majority on quorum read", "node", nodes[i].String(), "method", method)
+		} else if winner == nil {
+			winner = &responses[i]
+		}
+		nodes[i].ReportQuorum(agreed)
+	}
+	return winner.result, winner.err
+}
+
+func quorumErrorKey(err rpc.Error) string {
+	key := fmt.Sprintf("error %d: %s", err.ErrorCode(), err.Error())
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		key += fmt.Sprintf(" %v", dataErr.ErrorData())
+	}
+	return key
+}
+
+// canonicalJSON returns raw with insignificant whitespace removed and object
+// keys sorted.
+func canonicalJSON(raw json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(raw)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(raw)
+	}
+	return string(b)
+}
+
+func (p *Pool) quorumCallContext(ctx context.Context, size int, result interface{}, method string, args ...interface{}) error {
+	nodes := p.quorumNodes(size)
+	args, ok := pinLatest(method, args, quorumBlock(nodes))
+	if !ok {
+		// Nodes which have not seen a head yet may be at any block.
+		return p.selectNode().CallContext(ctx, result, method, args...)
+	}
+	raw, err := quorumCall(ctx, p.logger, method, size, nodes, func(ctx context.Context, n Node) (raw json.RawMessage, err error) {
+		err = n.CallContext(ctx, &raw, method, args...)
+		return
+	}, canonicalJSON)
+	if err != nil || result == nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func (p *Pool) quorumCallContract(ctx context.Context, size int, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	nodes := p.quorumNodes(size)
+	if blockNumber == nil {
+		if blockNumber = quorumBlock(nodes); blockNumber == nil {
+			// Nodes which have not seen a head yet may be at any block.
+			return p.selectNode().CallContract(ctx, msg, nil)
+		}
+	}
+	return quorumCall(ctx, p.logger, "CallContract", size, nodes, func(ctx context.Context, n Node) ([]byte, error) {
+		return n.CallContract(ctx, msg, blockNumber)
+	}, func(b []byte) string {
+		return hexutil.Encode(b)
+	})
+}
+
+func (p *Pool) quorumBlockByNumber(ctx context.Context, size int, number *big.Int) (*types.Block, error) {
+	nodes := p.quorumNodes(size)
+	if number == nil {
+		if number = quorumBlock(nodes); number == nil {
+			// Nodes which have not seen a head yet may be at any block.
+			return p.selectNode().BlockByNumber(ctx, nil)
+		}
+	}
+	// Comparing hashes covers every consensus field, and ignores the ones
+	// that are local to the node.
+	return quorumCall(ctx, p.logger, "BlockByNumber", size, nodes, func(ctx context.Context, n Node) (*types.Block, error) {
+		return n.BlockByNumber(ctx, number)
+	}, func(b *types.Block) string {
+		if b == nil {
+			return "nil"
+		}
+		return b.Hash().Hex()
+	})
+}
@@ -0,0 +1,260 @@
+package client_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var quorumConfig evmclient.PoolConfig = &poolConfig{
+	selectionMode: evmclient.NodeSelectionMode_RoundRobin,
+	quorum:        3,
+}
+
+type jsonRPCError struct{ msg string }
+
+func (e jsonRPCError) Error() string  { return e.msg }
+func (e jsonRPCError) ErrorCode() int { return 3 }
+
+func newQuorumNodes(t *testing.T, latest ...int64) (nodes []evmclient.Node, mocks []*evmmocks.Node) {
+	for i, num := range latest {
+		n := evmmocks.NewNode(t)
+		n.On("State").Return(evmclient.NodeStateAlive).Maybe()
+		n.On("StateAndLatest").Return(evmclient.NodeStateAlive, num, nil).Maybe()
+		n.On("String").Return(string(rune('a' + i))).Maybe()
+		nodes = append(nodes, n)
+		mocks = append(mocks, n)
+	}
+	return
+}
+
+func TestPool_Quorum_CallContract(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	msg := ethereum.CallMsg{Data: []byte{0x42}}
+
+	t.Run("returns the majority result at the latest common block", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 12, 10, 11)
+		pinned := big.NewInt(10)
+		ms[0].On("CallContract", mock.Anything, msg, pinned).Return([]byte{1}, nil).Once()
+		ms[1].On("CallContract", mock.Anything, msg, pinned).Return([]byte{2}, nil).Once()
+		ms[2].On("CallContract", mock.Anything, msg, pinned).Return([]byte{1}, nil).Once()
+		ms[0].On("ReportQuorum", true).Once()
+		ms[1].On("ReportQuorum", false).Once()
+		ms[2].On("ReportQuorum", true).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		b, err := p.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, b)
+	})
+
+	t.Run("reverts are results", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		block := big.NewInt(5)
+		revert := jsonRPCError{"execution reverted"}
+		ms[0].On("CallContract", mock.Anything, msg, block).Return(nil, errors.Wrap(revert, "CallContract failed")).Once()
+		ms[1].On("CallContract", mock.Anything, msg, block).Return(nil, errors.New("connection refused")).Once()
+		ms[2].On("CallContract", mock.Anything, msg, block).Return(nil, errors.Wrap(revert, "CallContract failed")).Once()
+		ms[0].On("ReportQuorum", true).Once()
+		ms[2].On("ReportQuorum", true).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		_, err := p.CallContract(ctx, msg, block)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, revert))
+	})
+
+	t.Run("fails without a majority", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		ms[0].On("CallContract", mock.Anything, msg, big.NewInt(10)).Return([]byte{1}, nil).Once()
+		ms[1].On("CallContract", mock.Anything, msg, big.NewInt(10)).Return([]byte{2}, nil).Once()
+		ms[2].On("CallContract", mock.Anything, msg, big.NewInt(10)).Return(nil, errors.New("connection refused")).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		_, err := p.CallContract(ctx, msg, nil)
+		var qErr *evmclient.QuorumError
+		require.True(t, errors.As(err, &qErr))
+		assert.Equal(t, evmclient.QuorumError{Method: "CallContract", Required: 2, Responses: 2, Agreeing: 1}, *qErr)
+	})
+
+	t.Run("fails with too few live nodes", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10)
+		ms[0].On("CallContract", mock.Anything, msg, big.NewInt(10)).Return([]byte{1}, nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		_, err := p.CallContract(ctx, msg, nil)
+		var qErr *evmclient.QuorumError
+		require.True(t, errors.As(err, &qErr))
+		assert.Equal(t, 1, qErr.Agreeing)
+	})
+
+	t.Run("latest block is not verified before every node has a head", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 0, 10)
+		ms[0].On("CallContract", mock.Anything, msg, (*big.Int)(nil)).Return([]byte{1}, nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		b, err := p.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, b)
+	})
+}
+
+func TestPool_Quorum_CallContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	t.Run("compares results and pins the latest block", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 16, 17, 16)
+		for i, res := range []string{`{"a": 1, "b": "x"}`, `{"b":"x","a":1}`, `{"a":2,"b":"x"}`} {
+			res := res
+			ms[i].On("CallContext", mock.Anything, mock.Anything, "eth_getBalance", "0xabc", "0x10").
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*json.RawMessage) = json.RawMessage(res)
+				}).Return(nil).Once()
+		}
+		ms[0].On("ReportQuorum", true).Once()
+		ms[1].On("ReportQuorum", true).Once()
+		ms[2].On("ReportQuorum", false).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		var result struct {
+			A int
+			B string
+		}
+		require.NoError(t, p.CallContext(ctx, &result, "eth_getBalance", "0xabc", "latest"))
+		assert.Equal(t, 1, result.A)
+		assert.Equal(t, "x", result.B)
+	})
+
+	t.Run("nonce sensitive methods are not verified", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		var nonce string
+		ms[0].On("CallContext", mock.Anything, &nonce, "eth_getTransactionCount", "0xabc", "latest").Return(nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		require.NoError(t, p.CallContext(ctx, &nonce, "eth_getTransactionCount", "0xabc", "latest"))
+	})
+
+	t.Run("head dependent methods are not verified", func(t *testing.T) {
+		for _, method := range []string{"eth_blockNumber", "eth_syncing", "net_peerCount", "eth_gasPrice"} {
+			nodes, ms := newQuorumNodes(t, 10, 11, 12)
+			var result string
+			ms[0].On("CallContext", mock.Anything, &result, method).Return(nil).Once()
+
+			p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+			require.NoError(t, p.CallContext(ctx, &result, method))
+		}
+	})
+
+	t.Run("pins the latest block of log filters", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 16, 17, 16)
+		filter := map[string]interface{}{"address": "0xabc", "fromBlock": "0x1"}
+		pinned := map[string]interface{}{"address": "0xabc", "fromBlock": "0x1", "toBlock": "0x10"}
+		for i := range ms {
+			ms[i].On("CallContext", mock.Anything, mock.Anything, "eth_getLogs", pinned).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*json.RawMessage) = json.RawMessage(`[]`)
+				}).Return(nil).Once()
+			ms[i].On("ReportQuorum", true).Once()
+		}
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		var logs []interface{}
+		require.NoError(t, p.CallContext(ctx, &logs, "eth_getLogs", filter))
+		assert.Equal(t, map[string]interface{}{"address": "0xabc", "fromBlock": "0x1"}, filter, "the filter of the caller is not modified")
+	})
+
+	t.Run("log filters by block hash are not pinned", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 16, 17, 16)
+		filter := map[string]interface{}{"blockHash": "0x1234"}
+		for i := range ms {
+			ms[i].On("CallContext", mock.Anything, mock.Anything, "eth_getLogs", filter).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*json.RawMessage) = json.RawMessage(`[]`)
+				}).Return(nil).Once()
+			ms[i].On("ReportQuorum", true).Once()
+		}
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		var logs []interface{}
+		require.NoError(t, p.CallContext(ctx, &logs, "eth_getLogs", filter))
+	})
+
+	t.Run("log filters of pending blocks are not verified", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		var logs []interface{}
+		filter := map[string]interface{}{"fromBlock": "0x1", "toBlock": "pending"}
+		ms[0].On("CallContext", mock.Anything, &logs, "eth_getLogs", filter).Return(nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		require.NoError(t, p.CallContext(ctx, &logs, "eth_getLogs", filter))
+	})
+
+	t.Run("latest block is not verified before every node has a head", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 0, 10)
+		var balance string
+		ms[0].On("CallContext", mock.Anything, &balance, "eth_getBalance", "0xabc", "latest").Return(nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		require.NoError(t, p.CallContext(ctx, &balance, "eth_getBalance", "0xabc", "latest"))
+	})
+
+	t.Run("pending state is not verified", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		var balance string
+		ms[0].On("CallContext", mock.Anything, &balance, "eth_getBalance", "0xabc", "pending").Return(nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		require.NoError(t, p.CallContext(ctx, &balance, "eth_getBalance", "0xabc", "pending"))
+	})
+}
+
+func TestPool_Quorum_BlockByNumber(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	t.Run("returns the majority block", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 10)
+		good := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7)})
+		bad := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7), Extra: []byte("fork")})
+		ms[0].On("BlockByNumber", mock.Anything, big.NewInt(7)).Return(bad, nil).Once()
+		ms[1].On("BlockByNumber", mock.Anything, big.NewInt(7)).Return(good, nil).Once()
+		ms[2].On("BlockByNumber", mock.Anything, big.NewInt(7)).Return(good, nil).Once()
+		ms[0].On("ReportQuorum", false).Once()
+		ms[1].On("ReportQuorum", true).Once()
+		ms[2].On("ReportQuorum", true).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		b, err := p.BlockByNumber(ctx, big.NewInt(7))
+		require.NoError(t, err)
+		assert.Equal(t, good.Hash(), b.Hash())
+	})
+
+	t.Run("latest block is not verified before every node has a head", func(t *testing.T) {
+		nodes, ms := newQuorumNodes(t, 10, 10, 0)
+		latest := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)})
+		ms[0].On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(latest, nil).Once()
+
+		p := evmclient.NewPool(logger.TestLogger(t), quorumConfig, nodes, nil, &cltest.FixtureChainID, "")
+		b, err := p.BlockByNumber(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, latest.Hash(), b.Hash())
+	})
+}
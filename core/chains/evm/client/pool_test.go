@@ -27,6 +27,7 @@ import (
 type poolConfig struct {
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
+	quorum              uint32
 }
 
 func (c poolConfig) NodeSelectionMode() string {
@@ -37,6 +38,18 @@ func (c poolConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.noNewHeadsThreshold
 }
 
+func (c poolConfig) NodeQuorumCallContext() uint32 {
+	return c.quorum
+}
+
+func (c poolConfig) NodeQuorumCallContract() uint32 {
+	return c.quorum
+}
+
+func (c poolConfig) NodeQuorumBlockByNumber() uint32 {
+	return c.quorum
+}
+
 var defaultConfig evmclient.PoolConfig = &poolConfig{
 	selectionMode:       evmclient.NodeSelectionMode_RoundRobin,
 	noNewHeadsThreshold: 0,
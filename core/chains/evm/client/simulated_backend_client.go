@@ -3,8 +3,10 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
@@ -104,6 +107,8 @@ func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interfa
 			return fmt.Errorf("first arg to SimulatedBackendClient.Call is an "+
 				"unrecognized type: %T; add processing logic for it here", result)
 		}
+	case "eth_feeHistory":
+		return c.feeHistory(ctx, result, args)
 	default:
 		return fmt.Errorf("second arg to SimulatedBackendClient.Call is an RPC "+
 			"API method which has not yet been implemented: %s. Add processing for "+
@@ -111,6 +116,118 @@ func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interfa
 	}
 }
 
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// feeHistory serves eth_feeHistory from the simulated chain, computing the
+// reward percentiles the same way go-ethereum does.
+func (c *SimulatedBackendClient) feeHistory(ctx context.Context, result interface{}, args []interface{}) error {
+	if len(args) != 3 {
+		return fmt.Errorf("should have three arguments after \"eth_feeHistory\", got %d", len(args))
+	}
+	var blockCount uint64
+	switch n := args[0].(type) {
+	case hexutil.Uint64:
+		blockCount = uint64(n)
+	case uint64:
+		blockCount = n
+	case int:
+		blockCount = uint64(n)
+	default:
+		return fmt.Errorf("first arg to eth_feeHistory must be a block count, got %T", args[0])
+	}
+	newest, err := c.blockNumber(args[1])
+	if err != nil {
+		return err
+	}
+	percentiles, ok := args[2].([]float64)
+	if !ok {
+		return fmt.Errorf("third arg to eth_feeHistory must be []float64, got %T", args[2])
+	}
+	if blockCount == 0 {
+		return errors.New("eth_feeHistory: block count must be greater than zero")
+	}
+
+	oldest := new(big.Int).Sub(newest, new(big.Int).SetUint64(blockCount-1))
+	if oldest.Sign() < 0 {
+		oldest.SetInt64(0)
+	}
+	res := feeHistoryResult{OldestBlock: (*hexutil.Big)(oldest)}
+	chainConfig := c.b.Blockchain().Config()
+	for n := new(big.Int).Set(oldest); n.Cmp(newest) <= 0; n.Add(n, big.NewInt(1)) {
+		block, err := c.b.BlockByNumber(ctx, n)
+		if err != nil {
+			return err
+		}
+		baseFee := block.BaseFee()
+		if baseFee == nil {
+			baseFee = new(big.Int)
+		}
+		res.BaseFee = append(res.BaseFee, (*hexutil.Big)(baseFee))
+		res.GasUsedRatio = append(res.GasUsedRatio, float64(block.GasUsed())/float64(block.GasLimit()))
+		rewards, err := c.blockRewards(ctx, block, percentiles)
+		if err != nil {
+			return err
+		}
+		res.Reward = append(res.Reward, rewards)
+
+		if n.Cmp(newest) == 0 {
+			nextBaseFee := new(big.Int)
+			if chainConfig.IsLondon(new(big.Int).Add(n, big.NewInt(1))) {
+				nextBaseFee = misc.CalcBaseFee(chainConfig, block.Header())
+			}
+			res.BaseFee = append(res.BaseFee, (*hexutil.Big)(nextBaseFee))
+		}
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}
+
+func (c *SimulatedBackendClient) blockRewards(ctx context.Context, block *types.Block, percentiles []float64) ([]*hexutil.Big, error) {
+	rewards := make([]*hexutil.Big, len(percentiles))
+	txs := block.Transactions()
+	if len(txs) == 0 {
+		for i := range rewards {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+		return rewards, nil
+	}
+
+	type gasAndReward struct {
+		gasUsed uint64
+		reward  *big.Int
+	}
+	sorted := make([]gasAndReward, len(txs))
+	for i, tx := range txs {
+		receipt, err := c.b.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = gasAndReward{gasUsed: receipt.GasUsed, reward: tx.EffectiveGasTipValue(block.BaseFee())}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].reward.Cmp(sorted[j].reward) < 0 })
+
+	var txIndex int
+	sumGasUsed := sorted[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(block.GasUsed()) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(sorted)-1 {
+			txIndex++
+			sumGasUsed += sorted[txIndex].gasUsed
+		}
+		rewards[i] = (*hexutil.Big)(sorted[txIndex].reward)
+	}
+	return rewards, nil
+}
+
 func (c *SimulatedBackendClient) FilterEvents(ctx context.Context, q ethereum.FilterQuery) (logs []types.Log, err error) {
 	return c.b.FilterLogs(ctx, q)
 }
@@ -228,7 +345,8 @@ func (c *SimulatedBackendClient) blockNumber(number interface{}) (blockNumber *b
 
 // HeadByNumber returns our own header type.
 func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	if n == nil {
+	if n == nil || n.Sign() < 0 {
+		// block tags such as `finalized` resolve to the latest block, since the simulated chain has instant finality
 		n = c.currentBlockNumber()
 	}
 	header, err := c.b.HeaderByNumber(ctx, n)
@@ -611,6 +729,9 @@ func (c *SimulatedBackendClient) Backend() *backends.SimulatedBackend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeStats implements evmclient.Client
+func (c *SimulatedBackendClient) NodeStats() map[string]NodeStats { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
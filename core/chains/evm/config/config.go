@@ -6,6 +6,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -32,6 +33,7 @@ type ChainScopedOnlyConfig interface {
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint32
@@ -58,10 +60,14 @@ type ChainScopedOnlyConfig interface {
 	EvmMaxGasPriceWei() *assets.Wei
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
+	EvmTxPriorityQueueingEnabled() bool
+	EvmTxPriority(jobType string) txmgrtypes.TxPriority
 	EvmMinGasPriceWei() *assets.Wei
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() config.ChainType
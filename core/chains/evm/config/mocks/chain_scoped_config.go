@@ -12,6 +12,8 @@ import (
 
 	commontypes "github.com/smartcontractkit/libocr/commontypes"
 
+	context "context"
+
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 
 	dialects "github.com/smartcontractkit/chainlink/v2/core/store/dialects"
@@ -34,6 +36,8 @@ import (
 
 	time "time"
 
+	types "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+
 	url "net/url"
 
 	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -1022,6 +1026,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1452,6 +1470,34 @@ func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmTxPriority provides a mock function with given fields: jobType
+func (_m *ChainScopedConfig) EvmTxPriority(jobType string) types.TxPriority {
+	ret := _m.Called(jobType)
+
+	var r0 types.TxPriority
+	if rf, ok := ret.Get(0).(func(string) types.TxPriority); ok {
+		r0 = rf(jobType)
+	} else {
+		r0 = ret.Get(0).(types.TxPriority)
+	}
+
+	return r0
+}
+
+// EvmTxPriorityQueueingEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxPriorityQueueingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmUseForwarders provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmUseForwarders() bool {
 	ret := _m.Called()
@@ -1622,6 +1668,34 @@ func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1720,6 +1794,62 @@ func (_m *ChainScopedConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineCircuitBreakerEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineCircuitBreakerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineCircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineHTTPHedgingPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineHTTPHedgingPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2235,6 +2365,62 @@ func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
 	return r0
 }
 
+// NodeQuorumBlockByNumber provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumBlockByNumber() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodeQuorumCallContext provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumCallContext() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodeQuorumCallContract provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumCallContract() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodeQuorumDisagreementThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumDisagreementThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // NodeSelectionMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeSelectionMode() string {
 	ret := _m.Called()
@@ -2643,6 +2829,110 @@ func (_m *ChainScopedConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error
 	return r0, r1
 }
 
+// OIDCClientID provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCClientID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCClientSecret provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCClientSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OIDCIssuerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCIssuerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRedirectURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCRedirectURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRoles provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCRoles() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRolesClaim provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCRolesClaim() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // ORMMaxIdleConns provides a mock function with given fields:
 func (_m *ChainScopedConfig) ORMMaxIdleConns() int {
 	ret := _m.Called()
@@ -3157,6 +3447,64 @@ func (_m *ChainScopedConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RefreshSecrets provides a mock function with given fields: ctx
+func (_m *ChainScopedConfig) RefreshSecrets(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoteSignerEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) RemoteSignerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RemoteSignerTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) RemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// RemoteSignerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) RemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) RootDir() string {
 	ret := _m.Called()
@@ -3171,6 +3519,20 @@ func (_m *ChainScopedConfig) RootDir() string {
 	return r0
 }
 
+// SecretsRefreshInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsRefreshInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *ChainScopedConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -3547,6 +3909,62 @@ func (_m *ChainScopedConfig) TelemetryIngressUseBatchSend() bool {
 	return r0
 }
 
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingSamplingRatio provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingSamplingRatio() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// TracingTLSCertPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingTLSCertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
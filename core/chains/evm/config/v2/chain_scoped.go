@@ -10,6 +10,7 @@ import (
 	ocr "github.com/smartcontractkit/libocr/offchainreporting"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	gencfg "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -107,6 +108,14 @@ func (c *ChainScoped) BlockHistoryEstimatorTransactionPercentile() uint16 {
 	return *c.cfg.GasEstimator.BlockHistory.TransactionPercentile
 }
 
+func (c *ChainScoped) FeeHistoryEstimatorBlockCount() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.BlockCount
+}
+
+func (c *ChainScoped) FeeHistoryEstimatorRewardPercentile() uint16 {
+	return *c.cfg.GasEstimator.FeeHistory.RewardPercentile
+}
+
 func (c *ChainScoped) EvmEIP1559DynamicFees() bool {
 	return *c.cfg.GasEstimator.EIP1559DynamicFees
 }
@@ -127,6 +136,10 @@ func (c *ChainScoped) EvmFinalityDepth() uint32 {
 	return *c.cfg.FinalityDepth
 }
 
+func (c *ChainScoped) EvmFinalityTagEnabled() bool {
+	return *c.cfg.FinalityTagEnabled
+}
+
 func (c *ChainScoped) EvmGasBumpPercent() uint16 {
 	return *c.cfg.GasEstimator.BumpPercent
 }
@@ -247,6 +260,34 @@ func (c *ChainScoped) EvmUseForwarders() bool {
 	return *c.cfg.Transactions.ForwardersEnabled
 }
 
+func (c *ChainScoped) EvmTxPriorityQueueingEnabled() bool {
+	return *c.cfg.Transactions.Priority.Enabled
+}
+
+// EvmTxPriority returns the priority class of transactions sent by jobs of the given type.
+func (c *ChainScoped) EvmTxPriority(jobType string) txmgrtypes.TxPriority {
+	p := c.cfg.Transactions.Priority
+	var priority *txmgrtypes.TxPriority
+	switch jobType {
+	case "offchainreporting":
+		priority = p.OCR
+	case "offchainreporting2":
+		priority = p.OCR2
+	case "fluxmonitor":
+		priority = p.FluxMonitor
+	case "keeper":
+		priority = p.Keeper
+	case "vrf":
+		priority = p.VRF
+	case "blockhashstore", "blockheaderfeeder":
+		priority = p.BlockhashStore
+	}
+	if priority == nil {
+		priority = p.Default
+	}
+	return *priority
+}
+
 func (c *ChainScoped) EvmRPCDefaultBatchSize() uint32 {
 	return *c.cfg.RPCDefaultBatchSize
 }
@@ -321,6 +362,22 @@ func (c *ChainScoped) NodeSyncThreshold() uint32 {
 	return *c.cfg.NodePool.SyncThreshold
 }
 
+func (c *ChainScoped) NodeQuorumCallContext() uint32 {
+	return *c.cfg.NodePool.Quorum.CallContext
+}
+
+func (c *ChainScoped) NodeQuorumCallContract() uint32 {
+	return *c.cfg.NodePool.Quorum.CallContract
+}
+
+func (c *ChainScoped) NodeQuorumBlockByNumber() uint32 {
+	return *c.cfg.NodePool.Quorum.BlockByNumber
+}
+
+func (c *ChainScoped) NodeQuorumDisagreementThreshold() uint32 {
+	return *c.cfg.NodePool.Quorum.DisagreementThreshold
+}
+
 func (c *ChainScoped) OCRContractConfirmations() uint16 {
 	return *c.cfg.OCR.ContractConfirmations
 }
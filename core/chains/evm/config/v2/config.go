@@ -13,6 +13,7 @@ import (
 
 	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -321,6 +322,7 @@ type Chain struct {
 	BlockBackfillSkip        *bool
 	ChainType                *string
 	FinalityDepth            *uint32
+	FinalityTagEnabled       *bool
 	FlagsContractAddress     *ethkey.EIP55Address
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
@@ -397,6 +399,8 @@ type Transactions struct {
 	ReaperInterval       *models.Duration
 	ReaperThreshold      *models.Duration
 	ResendAfterThreshold *models.Duration
+
+	Priority TransactionsPriority `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -418,6 +422,45 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	t.Priority.setFrom(&f.Priority)
+}
+
+type TransactionsPriority struct {
+	Enabled        *bool
+	Default        *txmgrtypes.TxPriority
+	OCR            *txmgrtypes.TxPriority
+	OCR2           *txmgrtypes.TxPriority
+	FluxMonitor    *txmgrtypes.TxPriority
+	Keeper         *txmgrtypes.TxPriority
+	VRF            *txmgrtypes.TxPriority
+	BlockhashStore *txmgrtypes.TxPriority
+}
+
+func (p *TransactionsPriority) setFrom(f *TransactionsPriority) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.Default; v != nil {
+		p.Default = v
+	}
+	if v := f.OCR; v != nil {
+		p.OCR = v
+	}
+	if v := f.OCR2; v != nil {
+		p.OCR2 = v
+	}
+	if v := f.FluxMonitor; v != nil {
+		p.FluxMonitor = v
+	}
+	if v := f.Keeper; v != nil {
+		p.Keeper = v
+	}
+	if v := f.VRF; v != nil {
+		p.VRF = v
+	}
+	if v := f.BlockhashStore; v != nil {
+		p.BlockhashStore = v
+	}
 }
 
 type OCR2 struct {
@@ -473,6 +516,7 @@ type GasEstimator struct {
 	TipCapMin     *assets.Wei
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
+	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
 }
 
 func (e *GasEstimator) ValidateConfig() (err error) {
@@ -509,6 +553,10 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" && *e.FeeHistory.BlockCount <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "FeeHistory.BlockCount", Value: *e.FeeHistory.BlockCount,
+			Msg: "must be greater than or equal to 1 with FeeHistory Mode"})
+	}
 
 	return
 }
@@ -564,6 +612,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
+	e.FeeHistory.setFrom(&f.FeeHistory)
 }
 
 type GasLimitJobType struct {
@@ -622,6 +671,35 @@ func (e *BlockHistoryEstimator) setFrom(f *BlockHistoryEstimator) {
 	}
 }
 
+// maxFeeHistoryBlockCount is the largest block range that go-ethereum serves for eth_feeHistory.
+const maxFeeHistoryBlockCount = 1024
+
+type FeeHistoryEstimator struct {
+	BlockCount       *uint16
+	RewardPercentile *uint16
+}
+
+func (e *FeeHistoryEstimator) ValidateConfig() (err error) {
+	if *e.BlockCount > maxFeeHistoryBlockCount {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockCount", Value: *e.BlockCount,
+			Msg: fmt.Sprintf("must be less than or equal to %d", maxFeeHistoryBlockCount)})
+	}
+	if *e.RewardPercentile > 100 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "RewardPercentile", Value: *e.RewardPercentile,
+			Msg: "must be less than or equal to 100"})
+	}
+	return
+}
+
+func (e *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
+	if v := f.BlockCount; v != nil {
+		e.BlockCount = v
+	}
+	if v := f.RewardPercentile; v != nil {
+		e.RewardPercentile = v
+	}
+}
+
 type KeySpecificConfig []KeySpecific
 
 func (ks KeySpecificConfig) ValidateConfig() (err error) {
@@ -675,6 +753,7 @@ type NodePool struct {
 	PollInterval         *models.Duration
 	SelectionMode        *string
 	SyncThreshold        *uint32
+	Quorum               NodePoolQuorum `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -690,6 +769,46 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.SyncThreshold; v != nil {
 		p.SyncThreshold = v
 	}
+	p.Quorum.setFrom(&f.Quorum)
+}
+
+type NodePoolQuorum struct {
+	CallContext           *uint32
+	CallContract          *uint32
+	BlockByNumber         *uint32
+	DisagreementThreshold *uint32
+}
+
+func (q *NodePoolQuorum) ValidateConfig() (err error) {
+	for _, f := range []struct {
+		name string
+		size *uint32
+	}{
+		{"CallContext", q.CallContext},
+		{"CallContract", q.CallContract},
+		{"BlockByNumber", q.BlockByNumber},
+	} {
+		if f.size != nil && *f.size == 1 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: f.name, Value: *f.size,
+				Msg: "must be 0 to disable, or at least 2"})
+		}
+	}
+	return
+}
+
+func (q *NodePoolQuorum) setFrom(f *NodePoolQuorum) {
+	if v := f.CallContext; v != nil {
+		q.CallContext = v
+	}
+	if v := f.CallContract; v != nil {
+		q.CallContract = v
+	}
+	if v := f.BlockByNumber; v != nil {
+		q.BlockByNumber = v
+	}
+	if v := f.DisagreementThreshold; v != nil {
+		q.DisagreementThreshold = v
+	}
 }
 
 type OCR struct {
@@ -119,6 +119,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
+	if v := f.FinalityTagEnabled; v != nil {
+		c.FinalityTagEnabled = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -0,0 +1,341 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_gas_price",
+		Help: "Gas price set by the fee history estimator (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+	promFeeHistoryEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_tip_cap",
+		Help: "Gas tip cap set by the fee history estimator (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+	promFeeHistoryEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fee_history_estimator_next_base_fee",
+		Help: "Base fee of the next block as reported by eth_feeHistory (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+var _ EvmEstimator = &FeeHistoryEstimator{}
+
+// FeeHistory is the response to an eth_feeHistory call.
+type FeeHistory struct {
+	OldestBlock *hexutil.Big `json:"oldestBlock"`
+	// Reward holds the requested reward percentiles for each block
+	Reward [][]*hexutil.Big `json:"reward,omitempty"`
+	// BaseFee holds the base fee of each block, plus that of the block after
+	// the newest one
+	BaseFee      []*hexutil.Big `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64      `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator prices transactions using the eth_feeHistory RPC call
+// instead of downloading full blocks like the BlockHistoryEstimator does.
+//
+// On every new head it requests the configured reward percentile for the most
+// recent blocks. The tip cap is the average reward of the non-empty blocks in
+// that window, and the base fee is the one the node has computed for the
+// next block. Legacy transactions pay both; if the base fee has been rising
+// across the window, the legacy price also allows for one further block of
+// maximum base fee growth, since legacy transactions have no separate fee cap.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	client  rpcClient
+	chainID big.Int
+	config  Config
+
+	mb        *utils.Mailbox[*evmtypes.Head]
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	gasPrice     *assets.Wei
+	tipCap       *assets.Wei
+	baseFee      *assets.Wei
+	priceMu      sync.RWMutex
+	initialFetch atomic.Bool
+
+	logger logger.SugaredLogger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that listens for
+// new heads and updates its prices from eth_feeHistory.
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg Config, chainID big.Int) EvmEstimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		client:    client,
+		chainID:   chainID,
+		config:    cfg,
+		mb:        utils.NewSingleMailbox[*evmtypes.Head](),
+		wg:        new(sync.WaitGroup),
+		ctx:       ctx,
+		ctxCancel: cancel,
+		logger:    logger.Sugared(lggr.Named("FeeHistoryEstimator")),
+	}
+}
+
+// OnNewLongestChain triggers a refresh of the prices, unless one is already in
+// progress
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) Start(ctx context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		if f.config.FeeHistoryEstimatorBlockCount() == 0 {
+			return errors.New("FeeHistoryEstimatorBlockCount must be set to a value greater than 0")
+		}
+
+		fetchCtx, cancel := context.WithTimeout(ctx, MaxStartTime)
+		defer cancel()
+		if err := f.Refresh(fetchCtx); err != nil {
+			f.logger.Warnw("Initial fee history fetch failed", "err", err)
+		}
+
+		// NOTE: This only checks the start context, not the fetch context
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "failed to start FeeHistoryEstimator due to main context error")
+		}
+
+		f.wg.Add(1)
+		go f.runLoop()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.StartStopOnce.Healthy()}
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			head, exists := f.mb.Retrieve()
+			if !exists {
+				continue
+			}
+			if err := f.Refresh(f.ctx); err != nil {
+				f.logger.Warnw("Error refreshing fee history", "head", head.Number, "err", err)
+			}
+		}
+	}
+}
+
+// Refresh fetches the fee history of the latest blocks and recalculates prices.
+func (f *FeeHistoryEstimator) Refresh(ctx context.Context) error {
+	var history FeeHistory
+	blockCount := hexutil.Uint64(f.config.FeeHistoryEstimatorBlockCount())
+	percentile := float64(f.config.FeeHistoryEstimatorRewardPercentile())
+	if err := f.client.CallContext(ctx, &history, "eth_feeHistory", blockCount, "latest", []float64{percentile}); err != nil {
+		return errors.Wrap(err, "eth_feeHistory failed")
+	}
+	f.initialFetch.Store(true)
+	f.Recalculate(history)
+	return nil
+}
+
+// Recalculate sets the gas price, tip cap and base fee from a fee history.
+func (f *FeeHistoryEstimator) Recalculate(history FeeHistory) {
+	tipCap := averageReward(history)
+	var baseFee *assets.Wei
+	if len(history.BaseFee) > 0 {
+		baseFee = (*assets.Wei)(history.BaseFee[len(history.BaseFee)-1])
+	}
+
+	var gasPrice *assets.Wei
+	if tipCap != nil {
+		gasPrice = tipCap
+		if baseFee != nil {
+			legacyBaseFee := baseFee
+			if baseFeeRising(history) {
+				legacyBaseFee = maxNextBaseFee(baseFee)
+			}
+			gasPrice = legacyBaseFee.Add(tipCap)
+		}
+		tipCap = assets.WeiMax(tipCap, f.config.EvmGasTipCapMinimum())
+		gasPrice = assets.WeiMin(assets.WeiMax(gasPrice, f.config.EvmMinGasPriceWei()), f.config.EvmMaxGasPriceWei())
+	}
+
+	f.logger.Debugw("Recalculated fee history prices", "oldestBlock", history.OldestBlock, "blocks", len(history.GasUsedRatio),
+		"gasPrice", gasPrice, "tipCap", tipCap, "baseFee", baseFee)
+
+	chainID := f.chainID.String()
+	if gasPrice != nil {
+		promFeeHistoryEstimatorGasPrice.WithLabelValues(chainID).Set(float64(gasPrice.Int64()))
+	}
+	if tipCap != nil {
+		promFeeHistoryEstimatorTipCap.WithLabelValues(chainID).Set(float64(tipCap.Int64()))
+	}
+	if baseFee != nil {
+		promFeeHistoryEstimatorBaseFee.WithLabelValues(chainID).Set(float64(baseFee.Int64()))
+	}
+
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	f.gasPrice = gasPrice
+	f.tipCap = tipCap
+	f.baseFee = baseFee
+}
+
+// maxNextBaseFee returns the highest base fee the block after one with the
+// given base fee can have, which is 12.5% higher under EIP-1559.
+func maxNextBaseFee(baseFee *assets.Wei) *assets.Wei {
+	next := new(big.Int).Mul(baseFee.ToInt(), big.NewInt(9))
+	return assets.NewWei(next.Div(next, big.NewInt(8)))
+}
+
+// averageReward returns the average of the first reward percentile over all
+// blocks that included transactions, or nil if there were none. Empty blocks
+// report a reward of zero, which says nothing about the price needed to be
+// included.
+func averageReward(history FeeHistory) *assets.Wei {
+	sum := new(big.Int)
+	var n int64
+	for i, rewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if len(rewards) == 0 || rewards[0] == nil {
+			continue
+		}
+		sum.Add(sum, rewards[0].ToInt())
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	return assets.NewWei(sum.Div(sum, big.NewInt(n)))
+}
+
+// baseFeeRising reports whether the base fee of the next block is higher than
+// that of the oldest block in the window.
+func baseFeeRising(history FeeHistory) bool {
+	if len(history.BaseFee) < 2 {
+		return false
+	}
+	oldest, next := history.BaseFee[0], history.BaseFee[len(history.BaseFee)-1]
+	return next.ToInt().Cmp(oldest.ToInt()) > 0
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getBaseFee() *assets.Wei {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.baseFee
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ context.Context, _ []byte, gasLimit uint32, maxGasPriceWei *assets.Wei, _ ...txmgrtypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	ok := f.IfStarted(func() {
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		if !f.initialFetch.Load() {
+			return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		}
+		f.logger.Warn("Failed to estimate gas price. This is likely because recent blocks did not include any transactions. " +
+			"Using EvmGasPriceDefault as fallback.")
+		gasPrice = f.config.EvmGasPriceDefault()
+	}
+	gasPrice, chainSpecificGasLimit = capGasPrice(gasPrice, maxGasPriceWei, f.config.EvmMaxGasPriceWei(), gasLimit, f.config.EvmGasLimitMultiplier())
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(_ context.Context, originalGasPrice *assets.Wei, gasLimit uint32, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint32, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, gasLimit uint32, maxGasPriceWei *assets.Wei) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = commonfee.ApplyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		fee.TipCap = f.getTipCap()
+		if fee.TipCap == nil {
+			if !f.initialFetch.Load() {
+				err = errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+				return
+			}
+			f.logger.Warn("Failed to estimate tip cap. This is likely because recent blocks did not include any transactions. " +
+				"Using EvmGasTipCapDefault as fallback.")
+			fee.TipCap = f.config.EvmGasTipCapDefault()
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.config.EvmMaxGasPriceWei())
+		if f.config.EvmGasBumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+		} else if baseFee := f.getBaseFee(); baseFee != nil && baseFee.Cmp(assets.NewWeiI(0)) > 0 {
+			// leave headroom for the base fee to rise before the transaction
+			// is bumped, see calcFeeCap
+			fee.FeeCap = calcFeeCap(baseFee, f.config, fee.TipCap, maxGasPrice)
+		} else {
+			err = errors.New("FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?")
+		}
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return DynamicFee{}, 0, err
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, originalGasLimit uint32, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return BumpDynamicFeeOnly(f.config, f.logger, f.getTipCap(), f.getBaseFee(), originalFee, originalGasLimit, maxGasPriceWei)
+}
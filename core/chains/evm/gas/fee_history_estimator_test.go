@@ -0,0 +1,288 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newFeeHistoryConfig() *gas.MockConfig {
+	cfg := gas.NewMockConfig()
+	cfg.FeeHistoryEstimatorBlockCountF = 4
+	cfg.FeeHistoryEstimatorRewardPercentileF = 60
+	cfg.EvmGasLimitMultiplierF = 1
+	cfg.EvmGasBumpPercentF = 10
+	cfg.EvmGasBumpThresholdF = 3
+	cfg.EvmGasBumpWeiF = assets.NewWeiI(150)
+	cfg.EvmGasPriceDefaultF = assets.NewWeiI(100)
+	cfg.EvmGasTipCapDefaultF = assets.NewWeiI(10)
+	cfg.EvmGasTipCapMinimumF = assets.NewWeiI(1)
+	cfg.EvmMinGasPriceWeiF = assets.NewWeiI(1)
+	cfg.EvmMaxGasPriceWeiF = assets.GWei(1000)
+	return cfg
+}
+
+func hexBigs(vals ...int64) (out []*hexutil.Big) {
+	for _, v := range vals {
+		out = append(out, (*hexutil.Big)(big.NewInt(v)))
+	}
+	return
+}
+
+func mockFeeHistory(client *mocks.RPCClient, history gas.FeeHistory) *mock.Call {
+	return client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(4), "latest", []float64{60}).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*gas.FeeHistory) = history
+	})
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	const gasLimit uint32 = 80000
+	maxGasPrice := assets.GWei(1000)
+
+	t.Run("returns error when not started", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+
+		_, _, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("fails to start with zero block count", func(t *testing.T) {
+		cfg := newFeeHistoryConfig()
+		cfg.FeeHistoryEstimatorBlockCountF = 0
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), mocks.NewRPCClient(t), cfg, cltest.FixtureChainID)
+
+		require.EqualError(t, e.Start(testutils.Context(t)), "FeeHistoryEstimatorBlockCount must be set to a value greater than 0")
+	})
+
+	t.Run("returns error if the initial fetch failed", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("boom"))
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		_, _, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		_, _, err = e.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+
+	t.Run("averages rewards of non-empty blocks", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(20), hexBigs(0), hexBigs(40), hexBigs(60)},
+			BaseFee:      hexBigs(1000, 900, 800, 700, 600),
+			GasUsedRatio: []float64{0.4, 0, 0.3, 0.2},
+		})
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = 0
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		gasPrice, limit, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// next block base fee of 600 plus the average reward of 40
+		assert.Equal(t, assets.NewWeiI(640), gasPrice)
+		assert.Equal(t, gasLimit, limit)
+
+		fee, limit, err := e.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(40), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(640), fee.FeeCap)
+		assert.Equal(t, gasLimit, limit)
+	})
+
+	t.Run("allows for base fee growth on legacy prices when the base fee is rising", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(10), hexBigs(10), hexBigs(10), hexBigs(10)},
+			BaseFee:      hexBigs(400, 500, 600, 700, 800),
+			GasUsedRatio: []float64{1, 1, 1, 1},
+		})
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(), cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		gasPrice, _, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// 800 * 1.125 + 10
+		assert.Equal(t, assets.NewWeiI(910), gasPrice)
+	})
+
+	t.Run("falls back to defaults when all blocks are empty", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(0), hexBigs(0), hexBigs(0), hexBigs(0)},
+			BaseFee:      hexBigs(1000, 900, 800, 700, 600),
+			GasUsedRatio: []float64{0, 0, 0, 0},
+		})
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = 0
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		gasPrice, _, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, cfg.EvmGasPriceDefaultF, gasPrice)
+
+		fee, _, err := e.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, cfg.EvmGasTipCapDefaultF, fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(610), fee.FeeCap)
+	})
+
+	t.Run("applies min and max gas price", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(1), hexBigs(1), hexBigs(1), hexBigs(1)},
+			BaseFee:      hexBigs(0, 0, 0, 0, 0),
+			GasUsedRatio: []float64{1, 1, 1, 1},
+		})
+		cfg := newFeeHistoryConfig()
+		cfg.EvmMinGasPriceWeiF = assets.NewWeiI(5)
+		cfg.EvmGasTipCapMinimumF = assets.NewWeiI(3)
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		gasPrice, _, err := e.GetLegacyGas(testutils.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), gasPrice)
+
+		gasPrice, _, err = e.GetLegacyGas(testutils.Context(t), nil, gasLimit, assets.NewWeiI(4))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(4), gasPrice)
+	})
+
+	t.Run("refuses dynamic fees on a chain without base fees", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(10), hexBigs(10), hexBigs(10), hexBigs(10)},
+			BaseFee:      hexBigs(0, 0, 0, 0, 0),
+			GasUsedRatio: []float64{1, 1, 1, 1},
+		})
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		_, _, err := e.GetDynamicFee(testutils.Context(t), gasLimit, maxGasPrice)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no value for next block base fee")
+	})
+
+	t.Run("bumps using the current prices", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		mockFeeHistory(client, gas.FeeHistory{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(100)),
+			Reward:       [][]*hexutil.Big{hexBigs(500), hexBigs(500), hexBigs(500), hexBigs(500)},
+			BaseFee:      hexBigs(1000, 1000, 1000, 1000, 1000),
+			GasUsedRatio: []float64{0.5, 0.5, 0.5, 0.5},
+		})
+		cfg := newFeeHistoryConfig()
+		cfg.EvmEIP1559DynamicFeesF = true
+		cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = 0
+		e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, cltest.FixtureChainID)
+		require.NoError(t, e.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+		// the current price of 1500 is higher than the original price bumped by 150
+		gasPrice, _, err := e.BumpLegacyGas(testutils.Context(t), assets.NewWeiI(1000), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1500), gasPrice)
+
+		// the current tip cap of 500 is higher than the original tip cap bumped by 150
+		fee, _, err := e.BumpDynamicFee(testutils.Context(t), gas.DynamicFee{TipCap: assets.NewWeiI(100), FeeCap: assets.NewWeiI(1100)}, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(1500), fee.FeeCap)
+	})
+}
+
+func TestFeeHistoryEstimator_SimulatedBackend(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := cltest.NewSimulatedBackend(t, core.GenesisAlloc{from: {Balance: assets.Ether(100).ToInt()}}, 10_000_000)
+	chainID := backend.Blockchain().Config().ChainID
+	client := evmclient.NewSimulatedBackendClient(t, backend, chainID)
+
+	signer := types.LatestSignerForChainID(chainID)
+	var nonce uint64
+	sendWithTip := func(tipCap *assets.Wei) {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: tipCap.ToInt(),
+			GasFeeCap: assets.GWei(100).ToInt(),
+			Gas:       21_000,
+			To:        &common.Address{},
+			Value:     big.NewInt(1),
+		})
+		require.NoError(t, err)
+		require.NoError(t, backend.SendTransaction(testutils.Context(t), tx))
+		nonce++
+	}
+	sendWithTip(assets.GWei(2))
+	backend.Commit()
+	sendWithTip(assets.GWei(4))
+	backend.Commit()
+	// two empty blocks
+	backend.Commit()
+	backend.Commit()
+	latest, err := backend.HeaderByNumber(testutils.Context(t), nil)
+	require.NoError(t, err)
+
+	cfg := newFeeHistoryConfig()
+	cfg.FeeHistoryEstimatorBlockCountF = 4
+	cfg.EvmEIP1559DynamicFeesF = true
+	cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocksF = 0
+	e := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, cfg, *chainID)
+	require.NoError(t, e.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, e.Close()) })
+
+	nextBaseFee := assets.NewWei(misc.CalcBaseFee(backend.Blockchain().Config(), latest))
+	fee, _, err := e.GetDynamicFee(testutils.Context(t), 21_000, assets.GWei(1000))
+	require.NoError(t, err)
+	assert.Equal(t, assets.GWei(3), fee.TipCap)
+	assert.Equal(t, nextBaseFee.Add(assets.GWei(3)), fee.FeeCap)
+
+	gasPrice, _, err := e.GetLegacyGas(testutils.Context(t), nil, 21_000, assets.GWei(1000))
+	require.NoError(t, err)
+	assert.Equal(t, nextBaseFee.Add(assets.GWei(3)), gasPrice)
+}
@@ -92,6 +92,8 @@ type MockConfig struct {
 	EvmMaxGasPriceWeiF                              *assets.Wei
 	EvmMinGasPriceWeiF                              *assets.Wei
 	EvmGasPriceDefaultF                             *assets.Wei
+	FeeHistoryEstimatorBlockCountF                  uint16
+	FeeHistoryEstimatorRewardPercentileF            uint16
 }
 
 func NewMockConfig() *MockConfig {
@@ -182,6 +184,14 @@ func (m *MockConfig) EvmMinGasPriceWei() *assets.Wei {
 	return m.EvmMinGasPriceWeiF
 }
 
+func (m *MockConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	return m.FeeHistoryEstimatorBlockCountF
+}
+
+func (m *MockConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	return m.FeeHistoryEstimatorRewardPercentileF
+}
+
 func (m *MockConfig) GasEstimatorMode() string {
 	panic("not implemented") // TODO: Implement
 }
@@ -43,6 +43,8 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Ev
 		"blockHistorySize", cfg.BlockHistoryEstimatorBlockHistorySize(),
 		"eip1559FeeCapBufferBlocks", cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks(),
 		"transactionPercentile", cfg.BlockHistoryEstimatorTransactionPercentile(),
+		"feeHistoryBlockCount", cfg.FeeHistoryEstimatorBlockCount(),
+		"feeHistoryRewardPercentile", cfg.FeeHistoryEstimatorRewardPercentile(),
 		"eip1559DynamicFees", cfg.EvmEIP1559DynamicFees(),
 		"gasBumpPercent", cfg.EvmGasBumpPercent(),
 		"gasBumpThreshold", cfg.EvmGasBumpThreshold(),
@@ -60,6 +62,8 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Ev
 		return NewWrappedEvmEstimator(NewArbitrumEstimator(lggr, cfg, ethClient, ethClient), cfg)
 	case "BlockHistory":
 		return NewWrappedEvmEstimator(NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ConfiguredChainID()), cfg)
+	case "FeeHistory":
+		return NewWrappedEvmEstimator(NewFeeHistoryEstimator(lggr, ethClient, cfg, *ethClient.ConfiguredChainID()), cfg)
 	case "FixedPrice":
 		return NewWrappedEvmEstimator(NewFixedPriceEstimator(cfg, lggr), cfg)
 	case "Optimism2", "L2Suggested":
@@ -240,6 +244,8 @@ type Config interface {
 	EvmGasTipCapMinimum() *assets.Wei
 	EvmMaxGasPriceWei() *assets.Wei
 	EvmMinGasPriceWei() *assets.Wei
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	GasEstimatorMode() string
 }
 
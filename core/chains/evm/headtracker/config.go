@@ -12,6 +12,7 @@ import (
 type Config interface {
 	BlockEmissionIdleWarningThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
@@ -31,6 +32,10 @@ func (c *wrappedConfig) FinalityDepth() uint32 {
 	return c.EvmFinalityDepth()
 }
 
+func (c *wrappedConfig) FinalityTagEnabled() bool {
+	return c.EvmFinalityTagEnabled()
+}
+
 func (c *wrappedConfig) HeadTrackerHistoryDepth() uint32 {
 	return c.EvmHeadTrackerHistoryDepth()
 }
@@ -2,6 +2,7 @@ package headtracker
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -15,6 +16,9 @@ type headSaver struct {
 	config Config
 	logger logger.Logger
 	heads  Heads
+
+	finalizedMu     sync.RWMutex
+	latestFinalized *evmtypes.Head
 }
 
 func NewHeadSaver(lggr logger.Logger, orm ORM, config Config) httypes.HeadSaver {
@@ -67,6 +71,30 @@ func (hs *headSaver) Chain(hash common.Hash) *evmtypes.Head {
 	return hs.heads.HeadByHash(hash)
 }
 
+func (hs *headSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error {
+	if hs.heads.HeadByHash(finalized.Hash) == nil {
+		if err := hs.Save(ctx, finalized); err != nil {
+			return err
+		}
+	}
+	if !hs.heads.MarkFinalized(finalized.Hash) {
+		hs.logger.Debugw("finalized head is older than EvmHeadTrackerHistoryDepth", "blockNumber", finalized.Number, "blockHash", finalized.Hash)
+	}
+
+	hs.finalizedMu.Lock()
+	defer hs.finalizedMu.Unlock()
+	if hs.latestFinalized == nil || finalized.Number >= hs.latestFinalized.Number {
+		hs.latestFinalized = finalized
+	}
+	return nil
+}
+
+func (hs *headSaver) LatestFinalizedHead() *evmtypes.Head {
+	hs.finalizedMu.RLock()
+	defer hs.finalizedMu.RUnlock()
+	return hs.latestFinalized
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -76,3 +104,7 @@ func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
 func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head                        { return nil }
+func (*nullSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error {
+	return nil
+}
+func (*nullSaver) LatestFinalizedHead() *evmtypes.Head { return nil }
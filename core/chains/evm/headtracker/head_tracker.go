@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -28,6 +29,11 @@ var (
 		Help: "The highest seen head number",
 	}, []string{"evmChainID"})
 
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The block number of the latest finalized head reported by the RPC (only when EVM.FinalityTagEnabled is true)",
+	}, []string{"evmChainID"})
+
 	promOldHead = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
@@ -166,6 +172,10 @@ func (ht *headTracker) LatestChain() *evmtypes.Head {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *headTracker) LatestFinalizedHead() *evmtypes.Head {
+	return ht.headSaver.LatestFinalizedHead()
+}
+
 func (ht *headTracker) getInitialHead(ctx context.Context) (*evmtypes.Head, error) {
 	head, err := ht.ethClient.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -198,6 +208,15 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	if prevHead == nil || head.Number > prevHead.Number {
 		promCurrentHead.WithLabelValues(ht.chainID.String()).Set(float64(head.Number))
 
+		if ht.config.FinalityTagEnabled() {
+			if err = ht.markFinalized(ctx); ctx.Err() != nil {
+				return nil
+			} else if err != nil {
+				// consumers fall back to FinalityDepth if the chain carries no finalized head
+				ht.log.Warnw("Failed to fetch latest finalized head, falling back to FinalityDepth", "err", err)
+			}
+		}
+
 		headWithChain := ht.headSaver.Chain(head.Hash)
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
@@ -277,7 +296,7 @@ func (ht *headTracker) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.FinalityDepth()))
+					err := ht.Backfill(ctx, head, ht.backfillDepth(head))
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -289,6 +308,40 @@ func (ht *headTracker) backfillLoop() {
 	}
 }
 
+// backfillDepth returns how many heads to keep in the chain below (and including) head.
+// This is FinalityDepth, or enough heads to reach the latest finalized head if that is
+// further away, bounded by HeadTrackerHistoryDepth.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	depth := uint(ht.config.FinalityDepth())
+	if finalized := ht.headSaver.LatestFinalizedHead(); finalized != nil && finalized.Number <= head.Number {
+		if finalizedDepth := uint(head.Number-finalized.Number) + 1; finalizedDepth > depth {
+			depth = finalizedDepth
+		}
+	}
+	if historyDepth := uint(ht.config.HeadTrackerHistoryDepth()); depth > historyDepth {
+		depth = historyDepth
+	}
+	return depth
+}
+
+// markFinalized fetches the latest finalized block from the RPC and marks it in the head saver
+func (ht *headTracker) markFinalized(ctx context.Context) error {
+	finalized, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch finalized head")
+	} else if finalized == nil {
+		return errors.New("got nil finalized head")
+	}
+	if latest := ht.headSaver.LatestFinalizedHead(); latest != nil && latest.Hash == finalized.Hash {
+		return nil
+	}
+	if err = ht.headSaver.MarkFinalized(ctx, finalized); err != nil {
+		return errors.Wrapf(err, "failed to mark head %d as finalized", finalized.Number)
+	}
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(finalized.Number))
+	return nil
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
@@ -359,4 +412,5 @@ func (*nullTracker) SetLogLevel(zapcore.Level)      {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
-func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) LatestChain() *evmtypes.Head         { return nil }
+func (*nullTracker) LatestFinalizedHead() *evmtypes.Head { return nil }
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -410,6 +411,71 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_FinalityTag(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	gCfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityDepth = ptr[uint32](1)
+		c.EVM[0].FinalityTagEnabled = ptr(true)
+	})
+	config := evmtest.NewChainScopedConfig(t, gCfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	heads := []*evmtypes.Head{
+		cltest.Head(0),
+		cltest.Head(1),
+		cltest.Head(2),
+		cltest.Head(3),
+	}
+	var parentHash gethCommon.Hash
+	for i := 0; i < len(heads); i++ {
+		if parentHash != (gethCommon.Hash{}) {
+			heads[i].ParentHash = parentHash
+		}
+		parentHash = heads[i].Hash
+	}
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(heads[2], nil).Maybe()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(heads[0], nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(2)).Return(heads[2], nil).Maybe()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(1)).Return(heads[1], nil).Maybe()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(0)).Return(heads[0], nil).Maybe()
+
+	chchHeaders := make(chan evmtest.RawSub[*evmtypes.Head], 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- evmtest.NewRawSub(ch, sub.Err())
+				return sub
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		)
+
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+	ht := createHeadTracker(t, ethClient, config, orm)
+	ht.Start(t)
+
+	headers := <-chchHeaders
+	headers.TrySend(heads[3])
+
+	// The chain is backfilled down to the finalized head, even though it is
+	// further away than FinalityDepth.
+	g := gomega.NewWithT(t)
+	g.Eventually(func() uint32 {
+		return ht.headSaver.LatestChain().ChainLength()
+	}, 5*time.Second, testutils.TestInterval).Should(gomega.Equal(uint32(4)))
+
+	finalized := ht.headTracker.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	assert.Equal(t, heads[0].Hash, finalized.Hash)
+	chainFinalized := ht.headSaver.LatestChain().LatestFinalizedHead()
+	require.NotNil(t, chainFinalized)
+	assert.Equal(t, int64(0), chainFinalized.BlockNumber())
+}
+
 func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,9 @@ type Heads interface {
 	// AddHeads adds newHeads to the collection, eliminates duplicates,
 	// sorts by head number, fixes parents and cuts off old heads (historyDepth).
 	AddHeads(historyDepth uint, newHeads ...*evmtypes.Head)
+	// MarkFinalized marks the head with the given hash and all of its ancestors as finalized.
+	// Returns false if the head is not in the collection.
+	MarkFinalized(finalized common.Hash) bool
 	// Count returns number of heads in the collection.
 	Count() int
 }
@@ -111,3 +114,53 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	// set
 	h.heads = heads
 }
+
+func (h *heads) MarkFinalized(finalized common.Hash) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	headsMap := make(map[common.Hash]*evmtypes.Head, len(h.heads))
+	for _, head := range h.heads {
+		headsMap[head.Hash] = head
+	}
+	if _, exists := headsMap[finalized]; !exists {
+		return false
+	}
+
+	// everything reachable from the finalized head is finalized too
+	finalizedHashes := make(map[common.Hash]struct{})
+	for hash := finalized; ; {
+		head, exists := headsMap[hash]
+		if !exists {
+			break
+		}
+		if _, seen := finalizedHashes[hash]; seen {
+			break
+		}
+		finalizedHashes[hash] = struct{}{}
+		hash = head.ParentHash
+	}
+
+	// copy all head objects to avoid races when a previous head chain is used
+	// elsewhere (since we mutate IsFinalized and Parent here)
+	heads := make([]*evmtypes.Head, len(h.heads))
+	for i, head := range h.heads {
+		headCopy := *head
+		headCopy.Parent = nil
+		if _, isFinalized := finalizedHashes[head.Hash]; isFinalized {
+			headCopy.IsFinalized = true
+		}
+		heads[i] = &headCopy
+		headsMap[head.Hash] = &headCopy
+	}
+
+	// assign parents
+	for _, head := range heads {
+		if parent, exists := headsMap[head.ParentHash]; exists {
+			head.Parent = parent
+		}
+	}
+
+	h.heads = heads
+	return true
+}
@@ -107,3 +107,43 @@ func TestHeads_AddHeads(t *testing.T) {
 	require.NotNil(t, head)
 	require.Equal(t, 2, int(head.ChainLength()))
 }
+
+func TestHeads_MarkFinalized(t *testing.T) {
+	t.Parallel()
+
+	heads := headtracker.NewHeads()
+
+	var testHeads []*evmtypes.Head
+	var parentHash common.Hash
+	for i := 0; i < 5; i++ {
+		hash := utils.NewHash()
+		h := evmtypes.NewHead(big.NewInt(int64(i)), hash, parentHash, uint64(time.Now().Unix()), utils.NewBigI(0))
+		testHeads = append(testHeads, &h)
+		parentHash = hash
+	}
+	heads.AddHeads(5, testHeads...)
+
+	latest := heads.LatestHead()
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	require.False(t, heads.MarkFinalized(utils.NewHash()))
+	require.True(t, heads.MarkFinalized(testHeads[2].Hash))
+
+	// previously returned chains are not mutated
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	latest = heads.LatestHead()
+	finalized := latest.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	require.Equal(t, int64(2), finalized.BlockNumber())
+	require.False(t, heads.HeadByHash(testHeads[3].Hash).IsFinalized)
+	require.True(t, heads.HeadByHash(testHeads[1].Hash).IsFinalized)
+	require.True(t, heads.HeadByHash(testHeads[0].Hash).IsFinalized)
+
+	// finalized flag survives adding new heads
+	h := evmtypes.NewHead(big.NewInt(5), utils.NewHash(), testHeads[4].Hash, uint64(time.Now().Unix()), utils.NewBigI(0))
+	heads.AddHeads(5, &h)
+	finalized = heads.LatestHead().LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	require.Equal(t, int64(2), finalized.BlockNumber())
+}
@@ -75,6 +75,22 @@ func (_m *HeadTracker) LatestChain() *types.Head {
 	return r0
 }
 
+// LatestFinalizedHead provides a mock function with given fields:
+func (_m *HeadTracker) LatestFinalizedHead() *types.Head {
+	ret := _m.Called()
+
+	var r0 *types.Head
+	if rf, ok := ret.Get(0).(func() *types.Head); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Head)
+		}
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *HeadTracker) Name() string {
 	ret := _m.Called()
@@ -23,6 +23,11 @@ type HeadSaver interface {
 	LatestChain() *evmtypes.Head
 	// Chain returns a head for the specified hash, or nil.
 	Chain(hash common.Hash) *evmtypes.Head
+	// MarkFinalized persists the given finalized head and marks it, along with
+	// all of its ancestors in the chain, as finalized.
+	MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error
+	// LatestFinalizedHead returns the latest head that was marked as finalized, or nil.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
@@ -35,6 +40,10 @@ type HeadTracker interface {
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
 	LatestChain() *evmtypes.Head
+	// LatestFinalizedHead returns the latest head reported as finalized by the RPC.
+	// Returns nil if EVM.FinalityTagEnabled is false, or if the RPC did not return a finalized block,
+	// in which case callers should fall back to EVM.FinalityDepth.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
@@ -562,6 +562,10 @@ func (b *broadcaster) onNewHeads() {
 		b.lastSeenHeadNumber.Store(latestHead.Number)
 
 		keptLogsDepth := uint32(b.config.EvmFinalityDepth())
+		if finalized := latestHead.LatestFinalizedHead(); finalized != nil {
+			// logs in finalized blocks cannot be re-orged out, so there is no need to keep them around
+			keptLogsDepth = uint32(latestHead.Number - finalized.BlockNumber())
+		}
 		if b.registrations.highestNumConfirmations > keptLogsDepth {
 			keptLogsDepth = b.registrations.highestNumConfirmations
 		}
@@ -20,6 +20,7 @@ import (
 //
 // 2. All received logs are kept in an array and deleted ONLY after they are outside the confirmation range for all subscribers
 // (when given log height is lower than (latest height - max(highestNumConfirmations, EVM.FinalityDepth)) ) -> see: pool.go
+// If the head chain carries a finalized head (EVM.FinalityTagEnabled), the distance to it replaces EVM.FinalityDepth.
 //
 // 3. Information about already consumed logs is fetched from the database and used as a filter
 //
@@ -58,6 +58,14 @@ func (disabled) LatestLogEventSigsAddrsWithConfs(fromBlock int64, eventSigs []co
 	return nil, ErrDisabled
 }
 
+func (disabled) QueryLogs(query Query, qopts ...pg.QOpt) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
+func (disabled) Subscribe(req SubscriptionRequest) (Subscription, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) IndexedLogs(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
@@ -11,7 +11,8 @@
 //   - Queries always return the logs from the _current_ canonical chain (same as eth_getLogs). In particular
 //     that means that querying unfinalized logs may change between queries but finalized logs remain stable.
 //     The threshold between unfinalized and finalized logs is the finalityDepth parameter, chosen such that with
-//     exceedingly high probability logs finalityDepth deep cannot be reorged.
+//     exceedingly high probability logs finalityDepth deep cannot be reorged. On chains that support the
+//     `finalized` block tag, useFinalityTag makes the poller use the RPC's latest finalized block instead.
 //   - After calling RegisterFilter with a particular event, it will never miss logs for that event
 //     despite node crashes and reorgs. The granularity of the filter is always at least one block (more when backfilling).
 //   - Old logs stored in the db will only be deleted if all filters matching them have explicit retention periods set, and all
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -43,6 +44,8 @@ type LogPoller interface {
 	LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
 	LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error)
 	LatestLogEventSigsAddrsWithConfs(fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs int, qopts ...pg.QOpt) ([]Log, error)
+	QueryLogs(query Query, qopts ...pg.QOpt) ([]Log, error)
+	Subscribe(req SubscriptionRequest) (Subscription, error)
 
 	// Content based querying
 	IndexedLogs(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
@@ -84,6 +87,8 @@ type logPoller struct {
 	lggr                  logger.Logger
 	pollPeriod            time.Duration // poll period set by block production rate
 	finalityDepth         int64         // finality depth is taken to mean that block (head - finality) is finalized
+	useFinalityTag        bool          // if true, the `finalized` block reported by the RPC is used instead of finalityDepth
+	finalityTagFailing    atomic.Bool   // whether the last request of the `finalized` block failed, to only warn once
 	keepBlocksDepth       int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
 	backfillBatchSize     int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize          int64         // batch size to use for fallback RPC calls made in GetBlocks
@@ -95,6 +100,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subscriptionsMu sync.Mutex
+	subscriptions   map[*subscription]struct{}
+
 	replayStart    chan int64
 	replayComplete chan error
 	ctx            context.Context
@@ -112,8 +120,11 @@ type logPoller struct {
 //
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
+//
+// If useFinalityTag is set, the poller asks the RPC for the `finalized` block to decide which blocks are
+// finalized, falling back to finalityDepth when the RPC doesn't support the tag.
 func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration,
-	finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
+	finalityDepth int64, useFinalityTag bool, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
 
 	return &logPoller{
 		ec:                ec,
@@ -123,11 +134,13 @@ func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Durat
 		replayComplete:    make(chan error),
 		pollPeriod:        pollPeriod,
 		finalityDepth:     finalityDepth,
+		useFinalityTag:    useFinalityTag,
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
 		filters:           make(map[string]Filter),
 		filterDirty:       true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
+		subscriptions:     make(map[*subscription]struct{}),
 	}
 }
 
@@ -375,6 +388,7 @@ func (lp *logPoller) Close() error {
 		}
 		lp.cancel()
 		lp.wg.Wait()
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -483,15 +497,16 @@ func (lp *logPoller) run() {
 					continue
 				}
 				latestNum := latest.Number
+				finalizedNum := lp.latestFinalizedBlockNumber(lp.ctx, latestNum)
 				// Do not support polling chains which don't even have finality depth worth of blocks.
 				// Could conceivably support this but not worth the effort.
 				// Need finality depth + 1, no block 0.
-				if latestNum <= lp.finalityDepth {
+				if finalizedNum <= 0 {
 					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "err", err, "latest", latestNum, "finality", lp.finalityDepth)
 					continue
 				}
 				// Starting at the first finalized block. We do not backfill the first finalized block.
-				start = latestNum - lp.finalityDepth
+				start = finalizedNum
 			} else {
 				start = lastProcessed.BlockNumber + 1
 			}
@@ -553,7 +568,7 @@ func (lp *logPoller) BackupPollAndSaveLogs(ctx context.Context, backupPollerBloc
 		return
 	}
 
-	lastSafeBackfillBlock := latestBlock.Number - lp.finalityDepth - 1
+	lastSafeBackfillBlock := lp.latestFinalizedBlockNumber(ctx, latestBlock.Number) - 1
 	if lastSafeBackfillBlock >= lp.backupPollerNextBlock {
 		lp.lggr.Infow("Backup poller backfilling logs", "start", lp.backupPollerNextBlock, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, lp.backupPollerNextBlock, lastSafeBackfillBlock); err != nil {
@@ -713,10 +728,18 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		// the canonical set per read. Typically, if an application took action on a log
 		// it would be saved elsewhere e.g. eth_txes, so it seems better to just support the fast reads.
 		// Its also nicely analogous to reading from the chain itself.
+		// The removed logs are read first to notify the subscriptions which delivered them.
+		var removed []Log
 		err2 = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+			var err3 error
+			removed, err3 = lp.orm.SelectLogsByQuery(NewQuery(BlockNumber(Gte, blockAfterLCA.Number)), pg.WithQueryer(tx))
+			if err3 != nil {
+				lp.lggr.Warnw("Unable to read reorged logs, retrying", "err", err3)
+				return err3
+			}
 			// These deletes are bounded by reorg depth, so they are
 			// fast and should not slow down the log readers.
-			err3 := lp.orm.DeleteBlocksAfter(blockAfterLCA.Number, pg.WithQueryer(tx))
+			err3 = lp.orm.DeleteBlocksAfter(blockAfterLCA.Number, pg.WithQueryer(tx))
 			if err3 != nil {
 				lp.lggr.Warnw("Unable to clear reorged blocks, retrying", "err", err3)
 				return err3
@@ -733,6 +756,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.rollbackSubscriptions(blockAfterLCA.Number, removed)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -744,6 +768,8 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 // conditions this would be equal to lastProcessed.BlockNumber + 1.
 func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int64) {
 	lp.lggr.Debugw("Polling for logs", "currentBlockNumber", currentBlockNumber)
+	// Deliver whatever was saved, even if polling stops early.
+	defer lp.notifySubscriptions()
 	latestBlock, err := lp.ec.HeadByNumber(ctx, nil)
 	if err != nil {
 		lp.lggr.Warnw("Unable to get latestBlockNumber block", "err", err, "currentBlockNumber", currentBlockNumber)
@@ -777,7 +803,7 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	// E.g. 1<-2<-3(currentBlockNumber)<-4<-5<-6<-7(latestBlockNumber), finality is 2. So 3,4 can be batched.
 	// Although 5 is finalized, we still need to save it to the db for reorg detection if 6 is a reorg.
 	// start = currentBlockNumber = 3, end = latestBlockNumber - finality - 1 = 7-2-1 = 4 (inclusive range).
-	lastSafeBackfillBlock := latestBlockNumber - lp.finalityDepth - 1
+	lastSafeBackfillBlock := lp.latestFinalizedBlockNumber(ctx, latestBlockNumber) - 1
 	if lastSafeBackfillBlock >= currentBlockNumber {
 		lp.lggr.Infow("Backfilling logs", "start", currentBlockNumber, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
@@ -845,6 +871,28 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	}
 }
 
+// latestFinalizedBlockNumber returns the number of the latest finalized block, given the latest block number.
+// If useFinalityTag is set this is the `finalized` block reported by the RPC, otherwise (or if the RPC
+// doesn't support the tag) it is latest - finalityDepth.
+func (lp *logPoller) latestFinalizedBlockNumber(ctx context.Context, latest int64) int64 {
+	if lp.useFinalityTag {
+		finalized, err := lp.ec.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+		if err == nil && finalized != nil && finalized.Number <= latest {
+			if lp.finalityTagFailing.CompareAndSwap(true, false) {
+				lp.lggr.Infow("Got finalized block, no longer falling back to finality depth", "finalized", finalized.Number, "latest", latest)
+			}
+			return finalized.Number
+		}
+		// RPCs which don't support the tag fail on every poll, so only the first failure is a warning.
+		if lp.finalityTagFailing.CompareAndSwap(false, true) {
+			lp.lggr.Warnw("Unable to get finalized block, falling back to finality depth until it succeeds", "err", err, "latest", latest, "finality", lp.finalityDepth)
+		} else {
+			lp.lggr.Debugw("Unable to get finalized block, falling back to finality depth", "err", err, "latest", latest, "finality", lp.finalityDepth)
+		}
+	}
+	return latest - lp.finalityDepth
+}
+
 // Find the first place where our chain and their chain have the same block,
 // that block number is the LCA. Return the block after that, where we want to resume polling.
 func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.Head) (*evmtypes.Head, error) {
@@ -855,12 +903,12 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 		return nil, err
 	}
 	blockAfterLCA := *current
-	reorgStart := parent.Number
 	// We expect reorgs up to the block after (current - finalityDepth),
 	// since the block at (current - finalityDepth) is finalized.
 	// We loop via parent instead of current so current always holds the LCA+1.
 	// If the parent block number becomes < the first finalized block our reorg is too deep.
-	for parent.Number >= (reorgStart - lp.finalityDepth) {
+	finalizedNum := lp.latestFinalizedBlockNumber(ctx, parent.Number)
+	for parent.Number >= finalizedNum {
 		ourParentBlockHash, err := lp.orm.SelectBlockByNumber(parent.Number, pg.WithParentCtx(ctx))
 		if err != nil {
 			return nil, err
@@ -964,6 +1012,12 @@ func (lp *logPoller) LatestLogEventSigsAddrsWithConfs(fromBlock int64, eventSigs
 	return lp.orm.SelectLatestLogEventSigsAddrsWithConfs(fromBlock, addresses, eventSigs, confs, qopts...)
 }
 
+// QueryLogs finds the logs matching query, which combines predicates on the address, event signature, topics
+// and data words of the logs with block range and confirmation filters. The other query methods are shorthands for it.
+func (lp *logPoller) QueryLogs(query Query, qopts ...pg.QOpt) ([]Log, error) {
+	return lp.orm.SelectLogsByQuery(query, qopts...)
+}
+
 // GetBlocksRange tries to get the specified block numbers from the log pollers
 // blocks table. It falls back to the RPC for any unfulfilled requested blocks.
 func (lp *logPoller) GetBlocksRange(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error) {
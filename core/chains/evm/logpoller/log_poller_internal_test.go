@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -60,7 +61,7 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
 	orm := NewORM(chainID, db, lggr, pgtest.NewQConfig(true))
-	lp := NewLogPoller(orm, nil, lggr, 15*time.Second, 1, 1, 2, 1000)
+	lp := NewLogPoller(orm, nil, lggr, 15*time.Second, 1, false, 1, 2, 1000)
 
 	filter := Filter{"test Filter", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0}
 	err := lp.RegisterFilter(filter)
@@ -76,7 +77,7 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_log_poller_filters_evm_chain_id_fkey DEFERRED`)))
 	// Set up a test chain with a log emitting contract deployed.
 
-	lp = NewLogPoller(orm, nil, lggr, 15*time.Second, 1, 1, 2, 1000)
+	lp = NewLogPoller(orm, nil, lggr, 15*time.Second, 1, false, 1, 2, 1000)
 
 	// We expect a zero Filter if nothing registered yet.
 	f := lp.Filter(nil, nil, nil)
@@ -246,7 +247,7 @@ func TestLogPoller_BackupPollerStartup(t *testing.T) {
 
 	ctx := testutils.Context(t)
 
-	lp := NewLogPoller(orm, ec, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(orm, ec, lggr, 1*time.Hour, 2, false, 3, 2, 1000)
 	lp.BackupPollAndSaveLogs(ctx, 100)
 	assert.Equal(t, int64(0), lp.backupPollerNextBlock)
 	assert.Equal(t, 1, observedLogs.FilterMessageSnippet("ran before first successful log poller run").Len())
@@ -287,7 +288,7 @@ func TestLogPoller_Replay(t *testing.T) {
 	ec.On("HeadByNumber", mock.Anything, mock.Anything).Return(&head, nil)
 	ec.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{log1}, nil).Once()
 	ec.On("ConfiguredChainID").Return(chainID, nil)
-	lp := NewLogPoller(orm, ec, lggr, time.Hour, 3, 3, 3, 20)
+	lp := NewLogPoller(orm, ec, lggr, time.Hour, 3, false, 3, 3, 20)
 
 	// process 1 log in block 3
 	lp.PollAndSaveLogs(tctx, 4)
@@ -480,9 +481,59 @@ func TestLogPoller_Replay(t *testing.T) {
 	})
 }
 
+func TestLogPoller_LatestFinalizedBlockNumber(t *testing.T) {
+	t.Parallel()
+	lggr := logger.TestLogger(t)
+	finalizedTag := big.NewInt(rpc.FinalizedBlockNumber.Int64())
+
+	t.Run("uses finality depth when finality tag is disabled", func(t *testing.T) {
+		ec := evmclimocks.NewClient(t)
+		lp := NewLogPoller(nil, ec, lggr, time.Hour, 10, false, 3, 2, 1000)
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+	})
+
+	t.Run("uses finalized block from the rpc", func(t *testing.T) {
+		ec := evmclimocks.NewClient(t)
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(&evmtypes.Head{Number: 97}, nil).Once()
+		lp := NewLogPoller(nil, ec, lggr, time.Hour, 10, true, 3, 2, 1000)
+		assert.Equal(t, int64(97), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+	})
+
+	t.Run("falls back to finality depth on rpc error", func(t *testing.T) {
+		ec := evmclimocks.NewClient(t)
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(nil, errors.New("not supported")).Once()
+		lp := NewLogPoller(nil, ec, lggr, time.Hour, 10, true, 3, 2, 1000)
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+	})
+
+	t.Run("falls back to finality depth when finalized block is ahead of latest", func(t *testing.T) {
+		ec := evmclimocks.NewClient(t)
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(&evmtypes.Head{Number: 101}, nil).Once()
+		lp := NewLogPoller(nil, ec, lggr, time.Hour, 10, true, 3, 2, 1000)
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+	})
+
+	t.Run("warns once until the rpc succeeds again", func(t *testing.T) {
+		lggr, observedLogs := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		ec := evmclimocks.NewClient(t)
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(nil, errors.New("not supported")).Twice()
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(&evmtypes.Head{Number: 97}, nil).Once()
+		ec.On("HeadByNumber", mock.Anything, finalizedTag).Return(nil, errors.New("not supported")).Once()
+		lp := NewLogPoller(nil, ec, lggr, time.Hour, 10, true, 3, 2, 1000)
+
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+		assert.Equal(t, 1, observedLogs.FilterMessageSnippet("Unable to get finalized block").Len())
+
+		assert.Equal(t, int64(97), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+		assert.Equal(t, int64(90), lp.latestFinalizedBlockNumber(testutils.Context(t), 100))
+		assert.Equal(t, 2, observedLogs.FilterMessageSnippet("Unable to get finalized block").Len())
+	})
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, false, 3, 2, 1000)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
@@ -418,7 +418,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := logpoller.NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000)
+		lp := logpoller.NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), false, 3, 2, 1000)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -699,6 +699,71 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 	require.Equal(t, uint64(180+time.Hour.Seconds()), b.Time())
 }
 
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+
+	sub, err := th.LogPoller.Subscribe(logpoller.SubscriptionRequest{
+		Filter: logpoller.Filter{
+			Name:      "Test Emitter 1",
+			EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+			Addresses: []common.Address{th.EmitterAddress1},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(sub.Close)
+
+	next := func() logpoller.LogEvent {
+		select {
+		case event := <-sub.Events():
+			return event
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for log event")
+			return logpoller.LogEvent{}
+		}
+	}
+
+	// Chain gen <- 1 <- 2 (L1_1, L2_1 by emitter 2)
+	newStart := th.PollAndSaveLogs(testutils.Context(t), 1)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	newStart = th.PollAndSaveLogs(testutils.Context(t), newStart)
+
+	event := next()
+	assert.False(t, event.Removed)
+	assert.Equal(t, int64(2), event.BlockNumber)
+	assert.Equal(t, th.EmitterAddress1, event.Address)
+	assert.Equal(t, logpoller.EvmWord(1).Bytes(), event.Data)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	lca, err := th.Client.BlockByNumber(testutils.Context(t), big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(testutils.Context(t), lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+	th.PollAndSaveLogs(testutils.Context(t), newStart)
+
+	// L1_1 is removed before L1_2 is delivered.
+	event = next()
+	assert.True(t, event.Removed)
+	assert.Equal(t, int64(2), event.BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(1).Bytes(), event.Data)
+	event = next()
+	assert.False(t, event.Removed)
+	assert.Equal(t, int64(2), event.BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(2).Bytes(), event.Data)
+
+	sub.Close()
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+}
+
 func TestLogPoller_LoadFilters(t *testing.T) {
 	t.Parallel()
 	th := SetupTH(t, 2, 3, 2)
@@ -916,7 +981,7 @@ func TestLogPoller_DBErrorHandling(t *testing.T) {
 	ec.Commit()
 	ec.Commit()
 
-	lp := logpoller.NewLogPoller(o, client.NewSimulatedBackendClient(t, ec, chainID2), lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(o, client.NewSimulatedBackendClient(t, ec, chainID2), lggr, 1*time.Hour, 2, false, 3, 2, 1000)
 
 	err = lp.Replay(ctx, 5) // block number too high
 	require.ErrorContains(t, err, "Invalid replay block number")
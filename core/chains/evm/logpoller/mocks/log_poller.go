@@ -489,6 +489,39 @@ func (_m *LogPoller) Name() string {
 	return r0
 }
 
+// QueryLogs provides a mock function with given fields: query, qopts
+func (_m *LogPoller) QueryLogs(query logpoller.Query, qopts ...pg.QOpt) ([]logpoller.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(logpoller.Query, ...pg.QOpt) ([]logpoller.Log, error)); ok {
+		return rf(query, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(logpoller.Query, ...pg.QOpt) []logpoller.Log); ok {
+		r0 = rf(query, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(logpoller.Query, ...pg.QOpt) error); ok {
+		r1 = rf(query, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Ready provides a mock function with given fields:
 func (_m *LogPoller) Ready() error {
 	ret := _m.Called()
@@ -550,6 +583,32 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
+// Subscribe provides a mock function with given fields: req
+func (_m *LogPoller) Subscribe(req logpoller.SubscriptionRequest) (logpoller.Subscription, error) {
+	ret := _m.Called(req)
+
+	var r0 logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(logpoller.SubscriptionRequest) (logpoller.Subscription, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(logpoller.SubscriptionRequest) logpoller.Subscription); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(logpoller.SubscriptionRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnregisterFilter provides a mock function with given fields: name, q
 func (_m *LogPoller) UnregisterFilter(name string, q pg.Queryer) error {
 	ret := _m.Called(name, q)
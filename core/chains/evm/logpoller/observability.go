@@ -43,10 +43,10 @@ type ObservedLogPoller struct {
 // NewObservedLogPoller creates an observed version of log poller created by NewLogPoller
 // Please see ObservedLogPoller for more details on how latencies are measured
 func NewObservedLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration,
-	finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) LogPoller {
+	finalityDepth int64, useFinalityTag bool, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) LogPoller {
 
 	return &ObservedLogPoller{
-		LogPoller: NewLogPoller(orm, ec, lggr, pollPeriod, finalityDepth, backfillBatchSize, rpcBatchSize, keepBlocksDepth),
+		LogPoller: NewLogPoller(orm, ec, lggr, pollPeriod, finalityDepth, useFinalityTag, backfillBatchSize, rpcBatchSize, keepBlocksDepth),
 		histogram: lpQueryHistogram,
 	}
 }
@@ -99,6 +99,12 @@ func (o *ObservedLogPoller) LogsDataWordGreaterThan(eventSig common.Hash, addres
 	})
 }
 
+func (o *ObservedLogPoller) QueryLogs(query Query, qopts ...pg.QOpt) ([]Log, error) {
+	return withObservedQuery(o.histogram, "QueryLogs", common.Address{}, func() ([]Log, error) {
+		return o.LogPoller.QueryLogs(query, qopts...)
+	})
+}
+
 func withObservedQuery[T any](histogram *prometheus.HistogramVec, queryName string, address common.Address, query func() (T, error)) (T, error) {
 	queryStarted := time.Now()
 	defer func() {
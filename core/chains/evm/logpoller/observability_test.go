@@ -59,7 +59,7 @@ func createObservedPollLogger(t *testing.T) *ObservedLogPoller {
 	db := pgtest.NewSqlxDB(t)
 	orm := NewORM(testutils.NewRandomEVMChainID(), db, lggr, pgtest.NewQConfig(true))
 	return NewObservedLogPoller(
-		orm, nil, lggr, 1, 1, 1, 1, 1000,
+		orm, nil, lggr, 1, 1, false, 1, 1, 1000,
 	).(*ObservedLogPoller)
 }
 
@@ -565,6 +565,91 @@ func TestORM_DataWords(t *testing.T) {
 	assert.Equal(t, 2, len(lgs))
 }
 
+func TestORM_SelectLogsByQuery(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	o1 := th.ORM
+	eventSig := common.HexToHash("0x1599")
+	otherSig := common.HexToHash("0x1600")
+	addr := common.HexToAddress("0x1234")
+	var logs []logpoller.Log
+	for i := int64(1); i <= 5; i++ {
+		for j := int64(0); j < 2; j++ {
+			sig := eventSig
+			if j == 1 && i%2 == 0 {
+				sig = otherSig
+			}
+			logs = append(logs, logpoller.Log{
+				EvmChainId:  utils.NewBig(th.ChainID),
+				LogIndex:    j,
+				BlockHash:   common.BigToHash(big.NewInt(i)),
+				BlockNumber: i,
+				EventSig:    sig,
+				Topics:      [][]byte{sig[:], logpoller.EvmWord(uint64(i)).Bytes()},
+				Address:     addr,
+				TxHash:      common.HexToHash("0x1888"),
+				Data:        logpoller.EvmWord(uint64(10 * i)).Bytes(),
+			})
+		}
+	}
+	require.NoError(t, o1.InsertLogs(logs))
+	require.NoError(t, o1.InsertBlock(common.HexToHash("0x4"), 4, time.Now()))
+
+	// Page through the confirmed logs of eventSig.
+	q := logpoller.NewQuery(logpoller.Address(addr), logpoller.EventSig(eventSig), logpoller.Confirmations(1))
+	q.Limit = 2
+	var pages [][]logpoller.Log
+	for {
+		page, err := o1.SelectLogsByQuery(q)
+		require.NoError(t, err)
+		pages = append(pages, page)
+		if len(page) < q.Limit {
+			break
+		}
+		q = q.NextPage(page)
+	}
+	require.Len(t, pages, 3)
+	assert.Len(t, pages[0], 2)
+	assert.Len(t, pages[1], 2)
+	assert.Len(t, pages[2], 1)
+	assert.Equal(t, int64(1), pages[0][0].BlockNumber)
+	assert.Equal(t, int64(0), pages[0][0].LogIndex)
+	assert.Equal(t, int64(1), pages[0][1].BlockNumber)
+	assert.Equal(t, int64(1), pages[0][1].LogIndex)
+	assert.Equal(t, int64(2), pages[1][0].BlockNumber)
+	assert.Equal(t, int64(3), pages[1][1].BlockNumber)
+	assert.Equal(t, int64(3), pages[2][0].BlockNumber)
+	assert.Equal(t, int64(1), pages[2][0].LogIndex)
+
+	// Newest first, combining topic and data word predicates.
+	q = logpoller.NewQuery(
+		logpoller.Address(addr),
+		logpoller.Or(logpoller.TopicCompare(1, logpoller.Gte, logpoller.EvmWord(4)), logpoller.DataWordCompare(0, logpoller.Eq, logpoller.EvmWord(10))),
+		logpoller.Not(logpoller.EventSig(otherSig)),
+	)
+	q.Order = logpoller.Descending
+	lgs, err := o1.SelectLogsByQuery(q)
+	require.NoError(t, err)
+	require.Len(t, lgs, 5)
+	assert.Equal(t, int64(5), lgs[0].BlockNumber)
+	assert.Equal(t, int64(1), lgs[0].LogIndex)
+	assert.Equal(t, int64(1), lgs[4].BlockNumber)
+	assert.Equal(t, int64(0), lgs[4].LogIndex)
+
+	// Latest block per event signature.
+	q = logpoller.NewQuery(logpoller.Address(addr), logpoller.BlockRange(1, 4))
+	q.LatestBlockPerEvent = true
+	lgs, err = o1.SelectLogsByQuery(q)
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	for _, lg := range lgs {
+		assert.Equal(t, int64(4), lg.BlockNumber)
+	}
+
+	_, err = o1.SelectLogsByQuery(logpoller.NewQuery(logpoller.Topic(4, eventSig)))
+	require.Error(t, err)
+	assert.Equal(t, "invalid index for topic: 4", err.Error())
+}
+
 func TestORM_SelectLogsWithSigsByBlockRangeFilter(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 	o1 := th.ORM
@@ -0,0 +1,316 @@
+package logpoller
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Query selects logs of the chain matching all of its Expressions, in the
+// order of (block_number, log_index). It compiles to a single parameterized
+// SQL statement.
+//
+// Pages of at most Limit logs are read by passing each page to NextPage:
+//
+//	q := NewQuery(Address(addr), EventSig(sig), Confirmations(10))
+//	q.Limit = 100
+//	for {
+//		logs, err := lp.QueryLogs(q)
+//		...
+//		if len(logs) < q.Limit {
+//			break
+//		}
+//		q = q.NextPage(logs)
+//	}
+type Query struct {
+	Expressions []Expression
+	Order       SortOrder
+	// After excludes the logs up to and including the cursor, in the
+	// order of the query.
+	After *Cursor
+	// Limit is the maximum number of logs returned, or 0 for no limit.
+	Limit int
+	// LatestBlockPerEvent only returns the logs of the latest block with a
+	// matching log, for each address and event signature.
+	LatestBlockPerEvent bool
+}
+
+// NewQuery returns a query for the logs matching all of exprs, in ascending
+// order.
+func NewQuery(exprs ...Expression) Query {
+	return Query{Expressions: exprs}
+}
+
+// NextPage returns the query for the page following logs, which must be the
+// last page returned by q.
+func (q Query) NextPage(logs []Log) Query {
+	if len(logs) > 0 {
+		last := logs[len(logs)-1]
+		q.After = &Cursor{BlockNumber: last.BlockNumber, LogIndex: last.LogIndex}
+	}
+	return q
+}
+
+// SortOrder is the order of the logs returned by a Query.
+type SortOrder int
+
+const (
+	// Ascending returns the oldest logs first.
+	Ascending SortOrder = iota
+	// Descending returns the newest logs first.
+	Descending
+)
+
+// Cursor is the position of a log in the results of a Query.
+type Cursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+// Comparator compares the value of a column with a Hash or block number.
+// Hashes compare as big-endian unsigned integers.
+type Comparator string
+
+const (
+	Eq  Comparator = "="
+	Neq Comparator = "!="
+	Lt  Comparator = "<"
+	Lte Comparator = "<="
+	Gt  Comparator = ">"
+	Gte Comparator = ">="
+)
+
+func (c Comparator) validate() error {
+	switch c {
+	case Eq, Neq, Lt, Lte, Gt, Gte:
+		return nil
+	}
+	return errors.Errorf("invalid comparator: %q", c)
+}
+
+// Expression is a predicate on logs, used to build a Query.
+type Expression interface {
+	// compile returns the SQL condition on the columns of table, adding
+	// its parameters to b.
+	compile(b *queryBuilder, table string) (string, error)
+}
+
+type expressionFunc func(b *queryBuilder, table string) (string, error)
+
+func (f expressionFunc) compile(b *queryBuilder, table string) (string, error) { return f(b, table) }
+
+// Address matches logs emitted by any of addresses.
+func Address(addresses ...common.Address) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		addrs := make([][]byte, 0, len(addresses))
+		for _, addr := range addresses {
+			addrs = append(addrs, addr.Bytes())
+		}
+		return fmt.Sprintf("%s.address = ANY(%s)", table, b.arg(pq.ByteaArray(addrs))), nil
+	})
+}
+
+// EventSig matches logs with any of the event signatures.
+func EventSig(eventSigs ...common.Hash) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		return fmt.Sprintf("%s.event_sig = ANY(%s)", table, b.arg(hashesToBytea(eventSigs))), nil
+	})
+}
+
+// TxHash matches logs emitted by any of the transactions.
+func TxHash(txHashes ...common.Hash) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		return fmt.Sprintf("%s.tx_hash = ANY(%s)", table, b.arg(hashesToBytea(txHashes))), nil
+	})
+}
+
+// Topic matches logs with any of values as the indexed topic at topicIndex,
+// which is 1, 2 or 3.
+func Topic(topicIndex int, values ...common.Hash) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if err := validateTopicIndex(topicIndex); err != nil {
+			return "", err
+		}
+		// Add 1 since postgresql arrays are 1-indexed.
+		return fmt.Sprintf("%s.topics[%s] = ANY(%s)", table, b.arg(topicIndex+1), b.arg(hashesToBytea(values))), nil
+	})
+}
+
+// TopicCompare matches logs for which the indexed topic at topicIndex, which
+// is 1, 2 or 3, compares to value with op. Only works for integer topics.
+func TopicCompare(topicIndex int, op Comparator, value common.Hash) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if err := validateTopicIndex(topicIndex); err != nil {
+			return "", err
+		}
+		if err := op.validate(); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.topics[%s] %s %s", table, b.arg(topicIndex+1), op, b.arg(value.Bytes())), nil
+	})
+}
+
+// DataWordCompare matches logs for which the 32 byte word at wordIndex of
+// the data compares to value with op. Only works for integer words.
+func DataWordCompare(wordIndex int, op Comparator, value common.Hash) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if wordIndex < 0 {
+			return "", errors.Errorf("invalid index for data word: %d", wordIndex)
+		}
+		if err := op.validate(); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("substring(%s.data from 32*%s+1 for 32) %s %s", table, b.arg(wordIndex), op, b.arg(value.Bytes())), nil
+	})
+}
+
+// BlockNumber matches logs for which the block number compares to n with op.
+func BlockNumber(op Comparator, n int64) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if err := op.validate(); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.block_number %s %s", table, op, b.arg(n)), nil
+	})
+}
+
+// BlockRange matches logs from block start to block end, inclusive.
+func BlockRange(start, end int64) Expression {
+	return And(BlockNumber(Gte, start), BlockNumber(Lte, end))
+}
+
+// Confirmations matches logs which have at least confs blocks on top of
+// them, according to the latest block of the poller.
+func Confirmations(confs int) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		return fmt.Sprintf("(%s.block_number + %s) <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)",
+			table, b.arg(confs)), nil
+	})
+}
+
+// Unmatched matches logs for which no other log of the same address, with
+// the same indexed topic at topicIndex, matches all of match. For example,
+// requests without a fulfillment.
+func Unmatched(topicIndex int, match ...Expression) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if err := validateTopicIndex(topicIndex); err != nil {
+			return "", err
+		}
+		alias := b.alias()
+		index := b.arg(topicIndex + 1)
+		cond, err := And(match...).compile(b, alias)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM evm_logs AS %[1]s WHERE %[1]s.evm_chain_id = %[2]s.evm_chain_id AND %[1]s.address = %[2]s.address AND %[1]s.topics[%[3]s] = %[2]s.topics[%[3]s] AND %[4]s)",
+			alias, table, index, cond), nil
+	})
+}
+
+// And matches logs matching all of exprs, or every log if there are none.
+func And(exprs ...Expression) Expression {
+	return junction("AND", "TRUE", exprs)
+}
+
+// Or matches logs matching any of exprs, or no log if there are none.
+func Or(exprs ...Expression) Expression {
+	return junction("OR", "FALSE", exprs)
+}
+
+func junction(operator, empty string, exprs []Expression) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		if len(exprs) == 0 {
+			return empty, nil
+		}
+		conds := make([]string, len(exprs))
+		for i, expr := range exprs {
+			cond, err := expr.compile(b, table)
+			if err != nil {
+				return "", err
+			}
+			conds[i] = cond
+		}
+		return "(" + strings.Join(conds, " "+operator+" ") + ")", nil
+	})
+}
+
+// Not matches logs not matching expr.
+func Not(expr Expression) Expression {
+	return expressionFunc(func(b *queryBuilder, table string) (string, error) {
+		cond, err := expr.compile(b, table)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + cond, nil
+	})
+}
+
+func hashesToBytea(hashes []common.Hash) pq.ByteaArray {
+	bs := make([][]byte, 0, len(hashes))
+	for _, h := range hashes {
+		bs = append(bs, h.Bytes())
+	}
+	return bs
+}
+
+// queryBuilder collects the positional parameters of a query.
+type queryBuilder struct {
+	args    []any
+	aliases int
+}
+
+func (b *queryBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *queryBuilder) alias() string {
+	b.aliases++
+	return fmt.Sprintf("m%d", b.aliases)
+}
+
+// compile returns the SQL statement and parameters of q for the logs of
+// chainID.
+func (q Query) compile(chainID *big.Int) (string, []any, error) {
+	b := &queryBuilder{}
+	chain := b.arg(utils.NewBig(chainID))
+
+	cond, err := And(q.Expressions...).compile(b, "evm_logs")
+	if err != nil {
+		return "", nil, err
+	}
+	if q.LatestBlockPerEvent {
+		cond = fmt.Sprintf(`(evm_logs.block_number, evm_logs.address, evm_logs.event_sig) IN (
+		SELECT MAX(evm_logs.block_number), evm_logs.address, evm_logs.event_sig FROM evm_logs
+			WHERE evm_logs.evm_chain_id = %s AND %s
+			GROUP BY evm_logs.event_sig, evm_logs.address)`, chain, cond)
+	}
+
+	order, cmp := "ASC", ">"
+	switch q.Order {
+	case Ascending:
+	case Descending:
+		order, cmp = "DESC", "<"
+	default:
+		return "", nil, errors.Errorf("invalid sort order: %d", q.Order)
+	}
+	if q.After != nil {
+		cond = fmt.Sprintf("%s AND (evm_logs.block_number, evm_logs.log_index) %s (%s, %s)", cond, cmp, b.arg(q.After.BlockNumber), b.arg(q.After.LogIndex))
+	}
+
+	sql := fmt.Sprintf(`SELECT * FROM evm_logs
+		WHERE evm_logs.evm_chain_id = %s AND %s
+		ORDER BY evm_logs.block_number %s, evm_logs.log_index %s`, chain, cond, order, order)
+	if q.Limit < 0 {
+		return "", nil, errors.Errorf("invalid limit: %d", q.Limit)
+	} else if q.Limit > 0 {
+		sql += " LIMIT " + b.arg(q.Limit)
+	}
+	return sql, b.args, nil
+}
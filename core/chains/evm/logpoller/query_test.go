@@ -0,0 +1,69 @@
+package logpoller
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestQuery_Compile(t *testing.T) {
+	chainID := big.NewInt(1337)
+	addr := common.HexToAddress("0x1234")
+	sig := common.HexToHash("0x1599")
+
+	t.Run("expressions", func(t *testing.T) {
+		q := NewQuery(Address(addr), Or(EventSig(sig), Not(TopicCompare(1, Gt, EvmWord(5)))), Confirmations(2))
+		stmt, args, err := q.compile(chainID)
+		require.NoError(t, err)
+		assert.Contains(t, stmt, "WHERE evm_logs.evm_chain_id = $1 AND (evm_logs.address = ANY($2) AND (evm_logs.event_sig = ANY($3) OR NOT evm_logs.topics[$4] > $5) AND (evm_logs.block_number + $6) <= (SELECT")
+		assert.Contains(t, stmt, "ORDER BY evm_logs.block_number ASC, evm_logs.log_index ASC")
+		assert.NotContains(t, stmt, "LIMIT $")
+		assert.Equal(t, []any{utils.NewBig(chainID), pq.ByteaArray{addr.Bytes()}, pq.ByteaArray{sig.Bytes()}, 2, EvmWord(5).Bytes(), 2}, args)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		q := NewQuery(BlockRange(10, 20))
+		q.Order = Descending
+		q.Limit = 3
+		q = q.NextPage([]Log{{BlockNumber: 18, LogIndex: 4}, {BlockNumber: 17, LogIndex: 2}})
+		stmt, args, err := q.compile(chainID)
+		require.NoError(t, err)
+		assert.Contains(t, stmt, "((evm_logs.block_number >= $2 AND evm_logs.block_number <= $3)) AND (evm_logs.block_number, evm_logs.log_index) < ($4, $5)")
+		assert.Contains(t, stmt, "ORDER BY evm_logs.block_number DESC, evm_logs.log_index DESC LIMIT $6")
+		assert.Equal(t, []any{utils.NewBig(chainID), int64(10), int64(20), int64(17), int64(2), 3}, args)
+
+		// An empty page leaves the cursor in place.
+		assert.Equal(t, q, q.NextPage(nil))
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		q := NewQuery(EventSig(sig), Unmatched(1, EventSig(sig), Unmatched(2, EventSig(sig))))
+		stmt, _, err := q.compile(chainID)
+		require.NoError(t, err)
+		assert.Contains(t, stmt, "NOT EXISTS (SELECT 1 FROM evm_logs AS m1 WHERE m1.evm_chain_id = evm_logs.evm_chain_id AND m1.address = evm_logs.address AND m1.topics[$3] = evm_logs.topics[$3] AND (m1.event_sig = ANY($4) AND NOT EXISTS (SELECT 1 FROM evm_logs AS m2 WHERE m2.evm_chain_id = m1.evm_chain_id")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, test := range []struct {
+			name  string
+			query Query
+			err   string
+		}{
+			{"topic index", NewQuery(Topic(0, sig)), "invalid index for topic: 0"},
+			{"nested topic index", NewQuery(Or(EventSig(sig), Unmatched(4))), "invalid index for topic: 4"},
+			{"data word index", NewQuery(DataWordCompare(-1, Eq, sig)), "invalid index for data word: -1"},
+			{"comparator", NewQuery(BlockNumber("~", 1)), `invalid comparator: "~"`},
+			{"limit", Query{Limit: -1}, "invalid limit: -1"},
+			{"order", Query{Order: 2}, "invalid sort order: 2"},
+		} {
+			_, _, err := test.query.compile(chainID)
+			assert.EqualError(t, err, test.err, test.name)
+		}
+	})
+}
@@ -0,0 +1,284 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// subscriptionBatchSize is the maximum number of logs a subscription reads
+// from the db at once.
+const subscriptionBatchSize = 1000
+
+// SubscriptionRequest describes the logs streamed by a Subscription.
+type SubscriptionRequest struct {
+	// Filter is registered with the poller by Subscribe, and selects the
+	// logs delivered. Closing the subscription does not unregister it.
+	Filter Filter
+	// Confirmations is the number of blocks required on top of the block of
+	// a log before it is delivered.
+	Confirmations int
+	// FromBlock is the first block of which logs are delivered. If 0, only
+	// the logs of blocks confirmed after Subscribe are delivered.
+	FromBlock int64
+}
+
+// LogEvent is a log delivered by a Subscription. Removed is set when a log
+// delivered before was removed from the chain by a reorg.
+type LogEvent struct {
+	Log
+	Removed bool
+}
+
+// Subscription streams the confirmed logs matching a SubscriptionRequest, in
+// the order of (block_number, log_index). Every log is delivered once, unless
+// it is removed by a reorg, in which case a LogEvent with Removed set follows
+// for it, newest first, before the logs of the new chain are delivered.
+//
+// Logs saved below the last delivered log, e.g. by a replay, are not
+// delivered.
+type Subscription interface {
+	// Events returns the channel of the subscription, which is closed by
+	// Close.
+	Events() <-chan LogEvent
+	// Close stops the subscription.
+	Close()
+}
+
+// Subscribe registers req.Filter and returns a Subscription delivering the
+// logs matching it once they have req.Confirmations, as they are polled.
+func (lp *logPoller) Subscribe(req SubscriptionRequest) (Subscription, error) {
+	if req.Confirmations < 0 {
+		return nil, errors.Errorf("invalid confirmations: %d", req.Confirmations)
+	}
+	if req.FromBlock < 0 {
+		return nil, errors.Errorf("invalid from block: %d", req.FromBlock)
+	}
+	if err := lp.RegisterFilter(req.Filter); err != nil {
+		return nil, err
+	}
+
+	// Deliver the logs after the cursor, i.e. of every block from FromBlock on.
+	from := req.FromBlock
+	if from == 0 {
+		latest, err := lp.orm.SelectLatestBlock()
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "failed to get latest block")
+		} else if err == nil {
+			from = latest.BlockNumber - int64(req.Confirmations) + 1
+		}
+	}
+
+	sub := &subscription{
+		lggr:   lp.lggr.With("filter", req.Filter.Name),
+		orm:    lp.orm,
+		req:    req,
+		cursor: Cursor{BlockNumber: from - 1, LogIndex: math.MaxInt64},
+		events: make(chan LogEvent),
+		wake:   make(chan struct{}, 1),
+		chStop: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	sub.unsubscribe = func() {
+		lp.subscriptionsMu.Lock()
+		defer lp.subscriptionsMu.Unlock()
+		delete(lp.subscriptions, sub)
+	}
+
+	lp.subscriptionsMu.Lock()
+	lp.subscriptions[sub] = struct{}{}
+	lp.subscriptionsMu.Unlock()
+
+	go sub.run()
+	// Deliver the logs already confirmed after FromBlock without waiting for
+	// the next poll.
+	sub.notify()
+	return sub, nil
+}
+
+// notifySubscriptions wakes the subscriptions up to deliver the logs saved
+// since they last ran.
+func (lp *logPoller) notifySubscriptions() {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		sub.notify()
+	}
+}
+
+// rollbackSubscriptions notifies the subscriptions that the blocks from
+// blockNumber on, with their logs removed, were reorged out.
+func (lp *logPoller) rollbackSubscriptions(blockNumber int64, removed []Log) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		sub.rollback(blockNumber, removed)
+	}
+}
+
+// closeSubscriptions closes every subscription.
+func (lp *logPoller) closeSubscriptions() {
+	lp.subscriptionsMu.Lock()
+	subs := make([]*subscription, 0, len(lp.subscriptions))
+	for sub := range lp.subscriptions {
+		subs = append(subs, sub)
+	}
+	lp.subscriptionsMu.Unlock()
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+// rollback is a reorg which removed the blocks from blockNumber on.
+type rollback struct {
+	blockNumber int64
+	removed     []Log
+}
+
+type subscription struct {
+	lggr        logger.Logger
+	orm         *ORM
+	req         SubscriptionRequest
+	unsubscribe func()
+
+	// cursor is the last log delivered, and is only used by run.
+	cursor Cursor
+
+	mu        sync.Mutex
+	rollbacks []rollback
+
+	events    chan LogEvent
+	wake      chan struct{}
+	chStop    utils.StopChan
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Subscription = &subscription{}
+
+func (s *subscription) Events() <-chan LogEvent {
+	return s.events
+}
+
+func (s *subscription) Close() {
+	s.closeOnce.Do(func() {
+		close(s.chStop)
+		<-s.done
+		s.unsubscribe()
+	})
+}
+
+func (s *subscription) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscription) rollback(blockNumber int64, removed []Log) {
+	s.mu.Lock()
+	s.rollbacks = append(s.rollbacks, rollback{blockNumber: blockNumber, removed: removed})
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *subscription) run() {
+	defer close(s.done)
+	defer close(s.events)
+	ctx, cancel := s.chStop.NewCtx()
+	defer cancel()
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-s.wake:
+			if !s.deliver(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// deliver sends the pending rollbacks, then the logs confirmed after the
+// cursor. It returns false if the subscription was closed.
+func (s *subscription) deliver(ctx context.Context) bool {
+	q := NewQuery(Address(s.req.Filter.Addresses...), EventSig(s.req.Filter.EventSigs...), Confirmations(s.req.Confirmations))
+	q.Limit = subscriptionBatchSize
+	for {
+		// The logs of a page read before a reorg are part of its removed
+		// logs, so they are always followed by their removal.
+		s.mu.Lock()
+		rollbacks := s.rollbacks
+		s.rollbacks = nil
+		s.mu.Unlock()
+		for _, r := range rollbacks {
+			if !s.deliverRollback(r) {
+				return false
+			}
+		}
+
+		cursor := s.cursor
+		q.After = &cursor
+		logs, err := s.orm.SelectLogsByQuery(q, pg.WithParentCtx(ctx))
+		if err != nil {
+			// Retried on the next poll.
+			s.lggr.Warnw("Unable to read logs of subscription", "err", err, "cursor", cursor)
+			return ctx.Err() == nil
+		}
+		for _, lg := range logs {
+			if !s.send(LogEvent{Log: lg}) {
+				return false
+			}
+			s.cursor = Cursor{BlockNumber: lg.BlockNumber, LogIndex: lg.LogIndex}
+		}
+		if len(logs) < q.Limit {
+			return true
+		}
+	}
+}
+
+func (s *subscription) deliverRollback(r rollback) bool {
+	if s.cursor.BlockNumber < r.blockNumber {
+		// None of the logs delivered were removed.
+		return true
+	}
+	for i := len(r.removed) - 1; i >= 0; i-- {
+		lg := r.removed[i]
+		if !s.matches(lg) || lg.BlockNumber > s.cursor.BlockNumber ||
+			(lg.BlockNumber == s.cursor.BlockNumber && lg.LogIndex > s.cursor.LogIndex) {
+			continue
+		}
+		if !s.send(LogEvent{Log: lg, Removed: true}) {
+			return false
+		}
+	}
+	s.cursor = Cursor{BlockNumber: r.blockNumber - 1, LogIndex: math.MaxInt64}
+	return true
+}
+
+func (s *subscription) matches(lg Log) bool {
+	var address, eventSig bool
+	for _, addr := range s.req.Filter.Addresses {
+		address = address || addr == lg.Address
+	}
+	for _, sig := range s.req.Filter.EventSigs {
+		eventSig = eventSig || sig == lg.EventSig
+	}
+	return address && eventSig
+}
+
+func (s *subscription) send(event LogEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	case <-s.chStop:
+		return false
+	}
+}
@@ -6,7 +6,10 @@ import (
 	context "context"
 	big "math/big"
 
+	client "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+
 	evm "github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 
 	mock "github.com/stretchr/testify/mock"
@@ -218,6 +221,30 @@ func (_m *ChainSet) Name() string {
 	return r0
 }
 
+// NodeStats provides a mock function with given fields: chainID, name
+func (_m *ChainSet) NodeStats(chainID string, name string) (client.NodeStats, bool) {
+	ret := _m.Called(chainID, name)
+
+	var r0 client.NodeStats
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string, string) (client.NodeStats, bool)); ok {
+		return rf(chainID, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) client.NodeStats); ok {
+		r0 = rf(chainID, name)
+	} else {
+		r0 = ret.Get(0).(client.NodeStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) bool); ok {
+		r1 = rf(chainID, name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // NodeStatuses provides a mock function with given fields: ctx, offset, limit, chainIDs
 func (_m *ChainSet) NodeStatuses(ctx context.Context, offset int, limit int, chainIDs ...string) ([]types.NodeStatus, int, error) {
 	_va := make([]interface{}, len(chainIDs))
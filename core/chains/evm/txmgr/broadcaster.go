@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
@@ -139,6 +141,10 @@ type Broadcaster[
 	// Each key has its own trigger
 	triggers map[ADDR]chan struct{}
 
+	// fairQueues pick the next transaction to send for each key when
+	// priority queueing is enabled
+	fairQueues map[ADDR]*fairQueue
+
 	chStop utils.StopChan
 	wg     sync.WaitGroup
 
@@ -243,9 +249,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, AD
 	eb.wg = sync.WaitGroup{}
 	eb.wg.Add(len(eb.enabledAddresses))
 	eb.triggers = make(map[ADDR]chan struct{})
+	eb.fairQueues = make(map[ADDR]*fairQueue)
 	for _, addr := range eb.enabledAddresses {
 		triggerCh := make(chan struct{}, 1)
 		eb.triggers[addr] = triggerCh
+		eb.fairQueues[addr] = newFairQueue()
 		go eb.monitorEthTxs(addr, triggerCh)
 	}
 
@@ -566,7 +574,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, AD
 	cancel()
 
 	lgr.Debugw("Sending transaction", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "meta", etx.Meta, "feeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
-	errType, err := eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	errType, err := eb.sendTransaction(ctx, lgr, etx, attempt)
 
 	if errType != clienttypes.Fatal {
 		etx.InitialBroadcastAt = &initialBroadcastAt
@@ -682,16 +690,46 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, AD
 
 }
 
+// sendTransaction broadcasts attempt, in a span continuing the trace of etx.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD, FEE_UNIT]) sendTransaction(ctx context.Context, lgr logger.Logger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) (clienttypes.SendTxReturnCode, error) {
+	meta, err := etx.GetMeta()
+	if err != nil {
+		lgr.Warnw("Failed to get meta of the transaction, not tracing it", "err", err)
+	}
+	ctx, span := startTxSpan(ctx, meta, "eth tx broadcast",
+		attribute.Int64("evm.eth_tx.id", etx.ID),
+		attribute.String("evm.tx_hash", attempt.Hash.String()),
+	)
+	defer span.End()
+	errType, err := eb.client.SendTransactionReturnCode(ctx, etx, attempt, lgr)
+	span.SetAttributes(attribute.Int("evm.send_tx_return_code", int(errType)))
+	recordError(span, err)
+	return errType, err
+}
+
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
 // Returns nil if no transactions are in queue
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD, FEE_UNIT]) nextUnstartedTransactionWithNonce(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD], error) {
-	etx := &txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]{}
-	if err := eb.txStore.FindNextUnstartedTransactionFromAddress(etx, fromAddress, eb.chainID); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+	var etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]
+	if q, ok := eb.fairQueues[fromAddress]; ok && eb.config.PriorityQueueingEnabled() {
+		etxs, err := eb.txStore.FindNextUnstartedTransactionsPerSubjectFromAddress(fromAddress, eb.chainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "findNextUnstartedTransactionsPerSubjectFromAddress failed")
+		}
+		if len(etxs) == 0 {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
 		}
-		return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
+		etx = etxs[q.next(eb.fairQueueItems(etxs))]
+	} else {
+		etx = &txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]{}
+		if err := eb.txStore.FindNextUnstartedTransactionFromAddress(etx, fromAddress, eb.chainID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// Finish. No more transactions left to process. Hoorah!
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
+		}
 	}
 
 	nonce, err := eb.getNextNonce(etx.FromAddress)
@@ -702,6 +740,28 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, AD
 	return etx, nil
 }
 
+// fairQueueItems sorts etxs oldest first and maps them to their flows
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD, FEE_UNIT]) fairQueueItems(etxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) []fairQueueItem {
+	sort.SliceStable(etxs, func(i, j int) bool {
+		return etxs[i].CreatedAt.Before(etxs[j].CreatedAt) || (etxs[i].CreatedAt.Equal(etxs[j].CreatedAt) && etxs[i].ID < etxs[j].ID)
+	})
+	items := make([]fairQueueItem, len(etxs))
+	for i, etx := range etxs {
+		items[i].priority = etx.Priority
+		if etx.Subject.Valid {
+			items[i].flow = "subject:" + etx.Subject.UUID.String()
+			continue
+		}
+		meta, err := etx.GetMeta()
+		if err != nil {
+			eb.logger.Warnw("Failed to get meta of the transaction, queueing it with other transactions without a job", "etxID", etx.ID, "err", err)
+		} else if meta != nil && meta.JobID != nil {
+			items[i].flow = fmt.Sprintf("job:%d", *meta.JobID)
+		}
+	}
+	return items
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD, FEE_UNIT]) tryAgainBumpingGas(ctx context.Context, lgr logger.Logger, txError error, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD], initialBroadcastAt time.Time) (err error, retryable bool) {
 	lgr.With(
 		"sendError", txError,
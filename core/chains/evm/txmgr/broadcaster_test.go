@@ -14,6 +14,7 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	"github.com/onsi/gomega"
 	"github.com/shopspring/decimal"
@@ -38,10 +39,12 @@ import (
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/remotesignertest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg/datatypes"
 	pgmocks "github.com/smartcontractkit/chainlink/v2/core/services/pg/mocks"
@@ -763,6 +766,54 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_RemoteSigner(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTxStore(t, db, cfg)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	chainID := ethClient.ConfiguredChainID()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	fromAddress := crypto.PubkeyToAddress(privKey.PublicKey)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, err = ethKeyStore.AddRemote(fromAddress, chainID)
+	require.NoError(t, err)
+	signer, err := remotesigner.NewWeb3Signer(remotesignertest.NewServer(t, privKey), time.Minute, logger.TestLogger(t))
+	require.NoError(t, err)
+	ethKeyStore.SetRemoteSigner(signer)
+
+	eb, err := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, evmcfg, &testCheckerFactory{}, false)
+	require.NoError(t, err)
+
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		sender, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(chainID), tx)
+		return err == nil && sender == fromAddress && tx.Nonce() == 0
+	}), fromAddress).Return(clienttypes.Successful, nil).Once()
+
+	etx := txmgr.EvmTx{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          big.Int(assets.NewEthValue(242)),
+		FeeLimit:       1231,
+		CreatedAt:      time.Unix(0, 0),
+		State:          txmgr.EthTxUnstarted,
+	}
+	require.NoError(t, txStore.InsertEthTx(&etx))
+
+	retryable, err := eb.ProcessUnstartedTxs(testutils.Context(t), fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	etx, err = txStore.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+	require.Len(t, etx.TxAttempts, 1)
+	assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
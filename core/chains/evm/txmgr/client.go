@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	clienttypes "github.com/smartcontractkit/chainlink/v2/common/chains/client"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -142,7 +143,7 @@ func (c *evmTxmClient) SendEmptyTransaction(
 	return signedTx.Hash().String(), err
 }
 
-func (c *evmTxmClient) CallContract(ctx context.Context, a EvmTxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error) {
+func (c *evmTxmClient) CallContract(ctx context.Context, a EvmTxAttempt, blockNumber *big.Int) (revert txmgrtypes.RevertReason, extractErr error) {
 	_, errCall := c.client.CallContract(ctx, ethereum.CallMsg{
 		From:       a.Tx.FromAddress,
 		To:         &a.Tx.ToAddress,
@@ -154,5 +155,10 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a EvmTxAttempt, blockNu
 		Data:       a.Tx.EncodedPayload,
 		AccessList: nil,
 	}, blockNumber)
-	return evmclient.ExtractRPCError(errCall)
+	rpcErr, extractErr := evmclient.ExtractRPCError(errCall)
+	if extractErr != nil {
+		return revert, extractErr
+	}
+	reason := rpcErr.RevertReason()
+	return txmgrtypes.RevertReason{Name: reason.Name, Message: reason.Message, RPCError: rpcErr}, nil
 }
@@ -29,6 +29,7 @@ type Config interface {
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
+	EvmTxPriorityQueueingEnabled() bool
 	KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
 	TriggerFallbackDBPollInterval() time.Duration
 }
@@ -59,6 +60,8 @@ func (c evmTxmConfig) MaxQueuedTransactions() uint64 { return c.EvmMaxQueuedTran
 
 func (c evmTxmConfig) MaxInFlightTransactions() uint32 { return c.EvmMaxInFlightTransactions() }
 
+func (c evmTxmConfig) PriorityQueueingEnabled() bool { return c.EvmTxPriorityQueueingEnabled() }
+
 func (c evmTxmConfig) IsL2() bool { return c.ChainType().IsL2() }
 
 func (c evmTxmConfig) MaxFeePrice() *assets.Wei { return c.EvmMaxGasPriceWei() }
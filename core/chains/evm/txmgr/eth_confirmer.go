@@ -12,7 +12,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	clienttypes "github.com/smartcontractkit/chainlink/v2/common/chains/client"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
@@ -21,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/revertreason"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -66,6 +70,10 @@ var (
 		Name: "tx_manager_num_tx_reverted",
 		Help: "Number of times a transaction reverted on-chain. Note that this can err to be too high since transactions are counted on each confirmation, which can happen multiple times per transaction in the case of re-orgs",
 	}, []string{"evmChainID"})
+	promRevertedTxReasonCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_tx_reverted_by_reason",
+		Help: "Number of times a transaction reverted on-chain, by the name of the error it reverted with, such as the name of a custom error, Error, Panic or unknown. Note that this can err to be too high in the same way as tx_manager_num_tx_reverted",
+	}, []string{"evmChainID", "reason"})
 	promFwdTxCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_fwd_tx_count",
 		Help: "The number of forwarded transaction attempts labeled by status",
@@ -277,7 +285,7 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 		return errors.Wrap(err, "CheckConfirmedMissingReceipt failed")
 	}
 
-	if err := ec.CheckForReceipts(ctx, head.BlockNumber()); err != nil {
+	if err := ec.checkForReceipts(ctx, head.BlockNumber(), ec.finalityDepth(head)); err != nil {
 		return errors.Wrap(err, "CheckForReceipts failed")
 	}
 
@@ -362,8 +370,28 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 	return
 }
 
+// finalityDepth returns the number of blocks below head after which a transaction is considered final.
+// If the head's chain contains a finalized head (see EVM.FinalityTagEnabled) the distance to it is used,
+// otherwise this falls back to the configured FinalityDepth.
+//
+// The depth is at least 1, so that transactions broadcast in the head block are not given up on when the RPC
+// reports the head itself as finalized.
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) finalityDepth(head commontypes.Head[BLOCK_HASH]) uint32 {
+	if finalized := head.LatestFinalizedHead(); finalized != nil {
+		if depth := head.BlockNumber() - finalized.BlockNumber(); depth > 0 {
+			return uint32(depth)
+		}
+		return 1
+	}
+	return ec.config.FinalityDepth()
+}
+
 // CheckForReceipts finds attempts that are still pending and checks to see if a receipt is present for the given block number
 func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) CheckForReceipts(ctx context.Context, blockNum int64) error {
+	return ec.checkForReceipts(ctx, blockNum, ec.config.FinalityDepth())
+}
+
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) checkForReceipts(ctx context.Context, blockNum int64, finalityDepth uint32) error {
 	attempts, err := ec.txStore.FindEthTxAttemptsRequiringReceiptFetch(ec.chainID)
 	if err != nil {
 		return errors.Wrap(err, "FindEthTxAttemptsRequiringReceiptFetch failed")
@@ -410,7 +438,7 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 		return errors.Wrap(err, "unable to mark eth_txes as 'confirmed_missing_receipt'")
 	}
 
-	if err := ec.txStore.MarkOldTxesMissingReceiptAsErrored(blockNum, ec.config.FinalityDepth(), ec.chainID); err != nil {
+	if err := ec.txStore.MarkOldTxesMissingReceiptAsErrored(blockNum, finalityDepth, ec.chainID); err != nil {
 		return errors.Wrap(err, "unable to confirm buried unconfirmed eth_txes")
 	}
 	return nil
@@ -555,23 +583,38 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 			continue
 		}
 
+		meta, metaErr := attempt.Tx.GetMeta()
+		if metaErr != nil {
+			l.Warnw("Failed to get meta of the transaction, not tracing it", "err", metaErr)
+		}
+		_, span := startTxSpan(ctx, meta, "eth tx receipt",
+			attribute.Int64("evm.eth_tx.id", attempt.TxID),
+			attribute.String("evm.tx_hash", attempt.Hash.String()),
+			attribute.Int64("evm.block_number", receipt.GetBlockNumber().Int64()),
+			attribute.Int64("evm.receipt_status", int64(receipt.GetStatus())),
+		)
+
 		if receipt.GetStatus() == 0 {
-			rpcError, errExtract := ec.client.CallContract(ctx, attempt, receipt.GetBlockNumber())
+			revert, errExtract := ec.client.CallContract(ctx, attempt, receipt.GetBlockNumber())
 			if errExtract == nil {
-				l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "rpcError", rpcError.String())
+				l.Warnw("transaction reverted on-chain", "hash", receipt.GetTxHash(), "revertReason", revert.Message, "rpcError", revert.RPCError.String())
+				ec.saveRevertReason(attempt.Tx, revert, l)
 			} else {
-				l.Warnw("transaction reverted on-chain unable to extract revert reason", "hash", receipt.GetTxHash(), "err", err)
+				l.Warnw("transaction reverted on-chain unable to extract revert reason", "hash", receipt.GetTxHash(), "err", errExtract)
+				revert.Name = revertreason.NameUnknown
 			}
 			// This might increment more than once e.g. in case of re-orgs going back and forth we might re-fetch the same receipt
 			promRevertedTxCount.WithLabelValues(ec.chainID.String()).Add(1)
+			promRevertedTxReasonCount.WithLabelValues(ec.chainID.String(), revert.Name).Add(1)
+			span.SetStatus(codes.Error, "transaction reverted on-chain")
 		} else {
 			promNumSuccessfulTxs.WithLabelValues(ec.chainID.String()).Add(1)
 		}
+		span.End()
 
 		// This is only recording forwarded tx that were mined and have a status.
 		// Counters are prone to being inaccurate due to re-orgs.
 		if ec.config.UseForwarders() {
-			meta, metaErr := attempt.Tx.GetMeta()
 			if metaErr == nil && meta != nil && meta.FwdrDestAddress != nil {
 				// promFwdTxCount takes two labels, chainId and a boolean of whether a tx was successful or not.
 				promFwdTxCount.WithLabelValues(ec.chainID.String(), strconv.FormatBool(receipt.GetStatus() != 0)).Add(1)
@@ -584,6 +627,13 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 	return
 }
 
+func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) saveRevertReason(etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD], revert txmgrtypes.RevertReason, lggr logger.Logger) {
+	etx.RevertReason = null.StringFrom(revert.Message)
+	if err := ec.txStore.UpdateEthTxRevertReason(&etx); err != nil {
+		lggr.Errorw("Failed to save revert reason", "err", err)
+	}
+}
+
 // RebroadcastWhereNecessary bumps gas or resends transactions that were previously out-of-eth
 func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) RebroadcastWhereNecessary(ctx context.Context, blockHeight int64) error {
 	var wg sync.WaitGroup
@@ -808,7 +858,18 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 
 	now := time.Now()
 	lggr.Debugw("Sending transaction", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "meta", etx.Meta, "feeLimit", etx.FeeLimit, "attempt", attempt, "etx", etx)
-	errType, sendError := ec.client.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+	meta, err := etx.GetMeta()
+	if err != nil {
+		lggr.Warnw("Failed to get meta of the transaction, not tracing it", "err", err)
+	}
+	sendCtx, span := startTxSpan(ctx, meta, "eth tx rebroadcast",
+		attribute.Int64("evm.eth_tx.id", etx.ID),
+		attribute.String("evm.tx_hash", attempt.Hash.String()),
+	)
+	errType, sendError := ec.client.SendTransactionReturnCode(sendCtx, etx, attempt, lggr)
+	span.SetAttributes(attribute.Int("evm.send_tx_return_code", int(errType)))
+	recordError(span, sendError)
+	span.End()
 
 	switch errType {
 	case clienttypes.Underpriced:
@@ -898,9 +959,9 @@ func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, A
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
 func (ec *EthConfirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE, ADD]) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head commontypes.Head[BLOCK_HASH]) error {
-	if head.ChainLength() < ec.config.FinalityDepth() {
+	if finalityDepth := ec.finalityDepth(head); head.ChainLength() < finalityDepth {
 		logArgs := []interface{}{
-			"chainLength", head.ChainLength(), "evmFinalityDepth", ec.config.FinalityDepth(),
+			"chainLength", head.ChainLength(), "evmFinalityDepth", finalityDepth,
 		}
 		if ec.nConsecutiveBlocksChainTooShort > logAfterNConsecutiveBlocksChainTooShort {
 			warnMsg := "Chain length supplied for re-org detection was shorter than EvmFinalityDepth. Re-org protection is not working properly. This could indicate a problem with the remote RPC endpoint, a compatibility issue with a particular blockchain, a bug with this particular blockchain, heads table being truncated too early, remote node out of sync, or something else. If this happens a lot please raise a bug with the Chainlink team including a log output sample and details of the chain and RPC endpoint you are using."
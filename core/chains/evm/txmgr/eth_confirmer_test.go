@@ -178,6 +178,38 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	require.NoError(t, ec.CloseInternal())
 }
 
+func TestEthConfirmer_FinalityDepth(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].FinalityDepth = ptr[uint32](50)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ec := txmgr.NewEthConfirmer(nil, ethClient, txmgr.NewEvmTxmConfig(evmcfg), nil, nil, logger.TestLogger(t))
+
+	newChain := func(headNum int64, finalizedNum int64) *evmtypes.Head {
+		var parent *evmtypes.Head
+		for n := headNum - 3; n <= headNum; n++ {
+			h := &evmtypes.Head{Number: n, Hash: utils.NewHash(), Parent: parent, IsFinalized: n == finalizedNum}
+			parent = h
+		}
+		return parent
+	}
+
+	t.Run("without a finalized head uses FinalityDepth", func(t *testing.T) {
+		assert.Equal(t, uint32(50), ec.FinalityDepth(newChain(100, -1)))
+	})
+
+	t.Run("uses the distance to the finalized head", func(t *testing.T) {
+		assert.Equal(t, uint32(2), ec.FinalityDepth(newChain(100, 98)))
+	})
+
+	t.Run("is at least 1 if the head is finalized", func(t *testing.T) {
+		assert.Equal(t, uint32(1), ec.FinalityDepth(newChain(100, 100)))
+	})
+}
+
 func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	t.Parallel()
 
@@ -589,6 +621,42 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 		// Check receipts
 		require.Len(t, attempt5_1.Receipts, 1)
 	})
+
+	etx6 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, nonce, fromAddress)
+	attempt6_1 := etx6.TxAttempts[0]
+	nonce++
+
+	t.Run("saves the revert reason", func(t *testing.T) {
+		txmReceipt := evmtypes.Receipt{
+			TxHash:           attempt6_1.Hash,
+			BlockHash:        utils.NewHash(),
+			BlockNumber:      big.NewInt(42),
+			TransactionIndex: uint(1),
+			Status:           uint64(0),
+		}
+		ethClient.On("SequenceAt", mock.Anything, mock.Anything, mock.Anything).Return(evmtypes.Nonce(10), nil)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 &&
+				cltest.BatchElemMatchesParams(b[0], attempt6_1.Hash, "eth_getTransactionReceipt")
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Result = &txmReceipt
+		}).Once()
+		data, err := utils.ABIEncode(`[{"type":"uint64"},{"type":"address"}]`, uint64(10), fromAddress)
+		require.NoError(t, err)
+		sig := utils.Keccak256Fixed([]byte(`InvalidConsumer(uint64,address)`))
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, &evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted",
+			Data:    hexutil.Encode(utils.ConcatBytes(sig[:4], data)),
+		}).Once()
+
+		require.NoError(t, ec.CheckForReceipts(ctx, blockNum))
+
+		etx6, err = txStore.FindEthTxWithAttempts(etx6.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("InvalidConsumer(subId=10, consumer=%s)", fromAddress.Hex()), etx6.RevertReason.ValueOrZero())
+	})
 }
 
 func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
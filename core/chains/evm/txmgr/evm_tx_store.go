@@ -130,8 +130,9 @@ type DbEthTx struct {
 	Value          assets.Eth
 	// GasLimit on the EthTx is always the conceptual gas limit, which is not
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
-	GasLimit uint32
-	Error    nullv4.String
+	GasLimit     uint32
+	Error        nullv4.String
+	RevertReason nullv4.String
 	// BroadcastAt is updated every time an attempt for this eth_tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -143,6 +144,7 @@ type DbEthTx struct {
 	// at send time.
 	Meta              *datatypes.JSON
 	Subject           uuid.NullUUID
+	Priority          txmgrtypes.TxPriority
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  null.Uint32
 	EVMChainID        utils.Big
@@ -164,11 +166,13 @@ func DbEthTxFromEthTx(ethTx *EvmTx) DbEthTx {
 		Value:              assets.Eth(ethTx.Value),
 		GasLimit:           ethTx.FeeLimit,
 		Error:              ethTx.Error,
+		RevertReason:       ethTx.RevertReason,
 		BroadcastAt:        ethTx.BroadcastAt,
 		CreatedAt:          ethTx.CreatedAt,
 		State:              ethTx.State,
 		Meta:               ethTx.Meta,
 		Subject:            ethTx.Subject,
+		Priority:           ethTx.Priority,
 		PipelineTaskRunID:  ethTx.PipelineTaskRunID,
 		MinConfirmations:   ethTx.MinConfirmations,
 		AccessList:         ethTx.AdditionalParameters,
@@ -199,11 +203,13 @@ func DbEthTxToEthTx(dbEthTx DbEthTx, evmEthTx *EvmTx) {
 	evmEthTx.Value = *dbEthTx.Value.ToInt()
 	evmEthTx.FeeLimit = dbEthTx.GasLimit
 	evmEthTx.Error = dbEthTx.Error
+	evmEthTx.RevertReason = dbEthTx.RevertReason
 	evmEthTx.BroadcastAt = dbEthTx.BroadcastAt
 	evmEthTx.CreatedAt = dbEthTx.CreatedAt
 	evmEthTx.State = dbEthTx.State
 	evmEthTx.Meta = dbEthTx.Meta
 	evmEthTx.Subject = dbEthTx.Subject
+	evmEthTx.Priority = dbEthTx.Priority
 	evmEthTx.PipelineTaskRunID = dbEthTx.PipelineTaskRunID
 	evmEthTx.MinConfirmations = dbEthTx.MinConfirmations
 	evmEthTx.ChainID = dbEthTx.EVMChainID.ToInt()
@@ -470,8 +476,8 @@ func (o *evmTxStore) InsertEthTx(etx *EvmTx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker
+	const insertEthTxSQL = `INSERT INTO eth_txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, revert_reason, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, priority, pipeline_task_run_id, min_confirmations, evm_chain_id, access_list, transmit_checker) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :revert_reason, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :priority, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :access_list, :transmit_checker
 ) RETURNING *`
 	dbTx := DbEthTxFromEthTx(etx)
 	err := o.q.GetNamed(insertEthTxSQL, &dbTx, &dbTx)
@@ -1230,6 +1236,21 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(etx *EvmTx, fromAdd
 	return pkgerrors.Wrap(err, "failed to FindNextUnstartedTransactionFromAddress")
 }
 
+func (o *evmTxStore) FindNextUnstartedTransactionsPerSubjectFromAddress(fromAddress common.Address, chainID *big.Int, qopts ...pg.QOpt) (etxs []*EvmTx, err error) {
+	qq := o.q.WithOpts(qopts...)
+	var dbEtxs []DbEthTx
+	err = qq.Select(&dbEtxs, `
+SELECT DISTINCT ON (subject, meta->>'JobID') * FROM eth_txes
+WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+ORDER BY subject, meta->>'JobID', value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to FindNextUnstartedTransactionsPerSubjectFromAddress")
+	}
+	etxs = make([]*EvmTx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
 func (o *evmTxStore) UpdateEthTxFatalError(etx *EvmTx, qopts ...pg.QOpt) error {
 	qq := o.q.WithOpts(qopts...)
 
@@ -1254,6 +1275,13 @@ func (o *evmTxStore) UpdateEthTxFatalError(etx *EvmTx, qopts ...pg.QOpt) error {
 	})
 }
 
+// UpdateEthTxRevertReason saves the reason a transaction reverted on-chain.
+func (o *evmTxStore) UpdateEthTxRevertReason(etx *EvmTx, qopts ...pg.QOpt) error {
+	qq := o.q.WithOpts(qopts...)
+	_, err := qq.Exec(`UPDATE eth_txes SET revert_reason = $1 WHERE id = $2`, etx.RevertReason, etx.ID)
+	return pkgerrors.Wrap(err, "UpdateEthTxRevertReason failed")
+}
+
 // Updates eth attempt from in_progress to broadcast. Also updates the eth tx to unconfirmed.
 // Before it updates both tables though it increments the next nonce from the keystore
 // One of the more complicated signatures. We have to accept variable pg.QOpt and QueryerFunc arguments
@@ -1488,12 +1516,12 @@ func (o *evmTxStore) CreateEthTransaction(newTx EvmNewTx, chainID *big.Int, qopt
 			}
 		}
 		err = tx.Get(&dbEtx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, priority, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.FeeLimit, newTx.Meta, newTx.Strategy.Subject(), chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.FeeLimit, newTx.Meta, newTx.Strategy.Subject(), newTx.Strategy.Priority(), chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert eth_tx")
 		}
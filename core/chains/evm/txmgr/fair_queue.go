@@ -0,0 +1,68 @@
+package txmgr
+
+import (
+	"sync"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+// fairQueue picks the next unstarted transaction to send from a single
+// address, using start-time fair queueing across flows. A flow is the set of
+// transactions of one subject, or of one job for transactions without a
+// subject. Every flow gets a share of the sending key proportional to the
+// weight of its priority class, so that a chatty job cannot starve the others.
+type fairQueue struct {
+	mu sync.Mutex
+	// vtime is the virtual start time of the last transaction picked
+	vtime float64
+	// start holds the virtual start time of the next transaction of each
+	// backlogged flow
+	start map[string]float64
+}
+
+type fairQueueItem struct {
+	flow     string
+	priority txmgrtypes.TxPriority
+}
+
+func newFairQueue() *fairQueue {
+	return &fairQueue{start: make(map[string]float64)}
+}
+
+// next returns the index of the item to send next out of the heads of each
+// flow, and charges its flow for it. Ties are broken by order, so items
+// should be sorted oldest first.
+func (q *fairQueue) next(items []fairQueueItem) int {
+	if len(items) == 0 {
+		return -1
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	backlogged := make(map[string]struct{}, len(items))
+	best, bestFinish := -1, 0.0
+	for i, item := range items {
+		backlogged[item.flow] = struct{}{}
+		start, ok := q.start[item.flow]
+		if !ok {
+			start = q.vtime
+			q.start[item.flow] = start
+		}
+		finish := start + 1/float64(item.priority.Weight())
+		if best == -1 || finish < bestFinish {
+			best, bestFinish = i, finish
+		}
+	}
+
+	flow := items[best].flow
+	q.vtime = q.start[flow]
+	q.start[flow] = bestFinish
+	// Flows that went idle start over from the virtual clock when they come
+	// back, so that they cannot save up credit while they have nothing to send.
+	for f := range q.start {
+		if _, ok := backlogged[f]; !ok {
+			delete(q.start, f)
+		}
+	}
+	return best
+}
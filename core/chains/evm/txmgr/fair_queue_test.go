@@ -0,0 +1,62 @@
+package txmgr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+func TestFairQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, -1, newFairQueue().next(nil))
+	})
+
+	t.Run("round robin between flows of equal priority", func(t *testing.T) {
+		q := newFairQueue()
+		items := []fairQueueItem{
+			{flow: "a", priority: txmgrtypes.TxPriorityNormal},
+			{flow: "b", priority: txmgrtypes.TxPriorityNormal},
+			{flow: "c", priority: txmgrtypes.TxPriorityNormal},
+		}
+		var picked []string
+		for i := 0; i < 6; i++ {
+			picked = append(picked, items[q.next(items)].flow)
+		}
+		assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, picked)
+	})
+
+	t.Run("shares in proportion to priority weights", func(t *testing.T) {
+		q := newFairQueue()
+		items := []fairQueueItem{
+			{flow: "chatty", priority: txmgrtypes.TxPriorityNormal},
+			{flow: "ocr", priority: txmgrtypes.TxPriorityCritical},
+		}
+		counts := map[string]int{}
+		for i := 0; i < 100; i++ {
+			counts[items[q.next(items)].flow]++
+		}
+		assert.Equal(t, 80, counts["ocr"])
+		assert.Equal(t, 20, counts["chatty"])
+	})
+
+	t.Run("idle flows do not accumulate credit", func(t *testing.T) {
+		q := newFairQueue()
+		busy := []fairQueueItem{{flow: "a", priority: txmgrtypes.TxPriorityNormal}}
+		for i := 0; i < 10; i++ {
+			q.next(busy)
+		}
+		both := []fairQueueItem{
+			{flow: "a", priority: txmgrtypes.TxPriorityNormal},
+			{flow: "b", priority: txmgrtypes.TxPriorityNormal},
+		}
+		var picked []string
+		for i := 0; i < 4; i++ {
+			picked = append(picked, both[q.next(both)].flow)
+		}
+		assert.Equal(t, []string{"b", "a", "b", "a"}, picked)
+	})
+}
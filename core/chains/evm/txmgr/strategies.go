@@ -36,6 +36,7 @@ func (SendEveryStrategy) Subject() uuid.NullUUID { return uuid.NullUUID{} }
 func (SendEveryStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueuePruner, qopt pg.QOpt) (int64, error) {
 	return 0, nil
 }
+func (SendEveryStrategy) Priority() txmgrtypes.TxPriority { return txmgrtypes.TxPriorityNormal }
 
 var _ txmgrtypes.TxStrategy = DropOldestStrategy{}
 
@@ -57,6 +58,10 @@ func (s DropOldestStrategy) Subject() uuid.NullUUID {
 	return uuid.NullUUID{UUID: s.subject, Valid: true}
 }
 
+func (s DropOldestStrategy) Priority() txmgrtypes.TxPriority {
+	return txmgrtypes.TxPriorityNormal
+}
+
 func (s DropOldestStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueuePruner, qopt pg.QOpt) (n int64, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.queryTimeout)
 
@@ -67,3 +72,23 @@ func (s DropOldestStrategy) PruneQueue(pruneService txmgrtypes.UnstartedTxQueueP
 	}
 	return
 }
+
+var _ txmgrtypes.TxStrategy = PriorityStrategy{}
+
+// PriorityStrategy queues and prunes transactions like the wrapped strategy,
+// but assigns them the given priority class. The Broadcaster only takes
+// priorities into account if EVM.Transactions.Priority.Enabled is set.
+type PriorityStrategy struct {
+	txmgrtypes.TxStrategy
+	priority txmgrtypes.TxPriority
+}
+
+// NewPriorityTxStrategy creates a new TxStrategy that assigns priority to the
+// transactions created with strategy.
+func NewPriorityTxStrategy(strategy txmgrtypes.TxStrategy, priority txmgrtypes.TxPriority) PriorityStrategy {
+	return PriorityStrategy{strategy, priority}
+}
+
+func (s PriorityStrategy) Priority() txmgrtypes.TxPriority {
+	return s.priority
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -21,6 +22,7 @@ func Test_SendEveryStrategy(t *testing.T) {
 	s := txmgr.SendEveryStrategy{}
 
 	assert.Equal(t, uuid.NullUUID{}, s.Subject())
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, s.Priority())
 
 	n, err := s.PruneQueue(nil, nil)
 	assert.NoError(t, err)
@@ -38,6 +40,18 @@ func Test_DropOldestStrategy_Subject(t *testing.T) {
 	assert.Equal(t, subject, s.Subject().UUID)
 }
 
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+	cfg := configtest.NewGeneralConfig(t, nil)
+
+	subject := uuid.New()
+	s := txmgr.NewPriorityTxStrategy(txmgr.NewDropOldestStrategy(subject, 1, cfg.DatabaseDefaultQueryTimeout()), txmgrtypes.TxPriorityCritical)
+
+	assert.True(t, s.Subject().Valid)
+	assert.Equal(t, subject, s.Subject().UUID)
+	assert.Equal(t, txmgrtypes.TxPriorityCritical, s.Priority())
+}
+
 func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 	t.Parallel()
 
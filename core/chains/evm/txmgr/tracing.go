@@ -0,0 +1,39 @@
+package txmgr
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// tracer creates the spans of broadcasting and confirming transactions.
+// Spans are only recorded and exported when tracing is enabled, see package
+// tracing.
+var tracer = otel.Tracer("github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr")
+
+// startTxSpan starts a span continuing the trace stored in the meta of a
+// transaction, e.g. by the pipeline task which created it. Transactions
+// without a trace context get a non-recording span.
+func startTxSpan[ADDR types.Hashable, TX_HASH types.Hashable](ctx context.Context, meta *txmgrtypes.TxMeta[ADDR, TX_HASH], name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if meta == nil || len(meta.TraceContext) == 0 {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(meta.TraceContext))
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// recordError marks span as failed with err, if any.
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
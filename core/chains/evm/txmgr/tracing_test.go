@@ -0,0 +1,56 @@
+package txmgr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+func TestStartTxSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	t.Run("continues the trace of the tx", func(t *testing.T) {
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "eth tx")
+		meta := &txmgrtypes.TxMeta[common.Address, common.Hash]{TraceContext: map[string]string{}}
+		otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(meta.TraceContext))
+		parent.End()
+
+		_, span := startTxSpan(context.Background(), meta, "eth tx broadcast")
+		span.End()
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 2)
+		child := ended[1]
+		assert.Equal(t, "eth tx broadcast", child.Name())
+		assert.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), child.Parent().SpanID())
+	})
+
+	t.Run("does not record txs without trace context", func(t *testing.T) {
+		before := len(recorder.Ended())
+		_, span := startTxSpan(context.Background(), &txmgrtypes.TxMeta[common.Address, common.Hash]{}, "eth tx broadcast")
+		assert.False(t, span.IsRecording())
+		span.End()
+		_, span = startTxSpan[common.Address, common.Hash](context.Background(), nil, "eth tx broadcast")
+		assert.False(t, span.IsRecording())
+		span.End()
+		assert.Len(t, recorder.Ended(), before)
+	})
+}
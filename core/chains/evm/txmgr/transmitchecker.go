@@ -128,9 +128,10 @@ func (s *SimulateChecker) Check(
 	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+			reason := jErr.RevertReason()
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return errors.Errorf("transaction reverted during simulation: %s", jErr.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", reason.Message, "returnValue", b.String())
+			return errors.Errorf("transaction reverted during simulation: %s (%s)", reason, jErr.String())
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
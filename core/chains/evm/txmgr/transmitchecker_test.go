@@ -136,10 +136,24 @@ func TestTransmitCheckers(t *testing.T) {
 				}), "latest").Return(&jerr).Once()
 
 			err := checker.Check(ctx, log, tx, attempt)
-			expErrMsg := "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }"
+			expErrMsg := "transaction reverted during simulation: reverted without a reason (json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' })"
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("revert with reason", func(t *testing.T) {
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted: not enough LINK",
+				Data:    "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f6e6f7420656e6f756768204c494e4b0000000000000000000000000000000000",
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "latest").Return(&jerr).Once()
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.ErrorContains(t, err, `transaction reverted during simulation: Error("not enough LINK")`)
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
 			client.On("CallContext", mock.Anything,
 				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
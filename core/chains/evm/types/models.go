@@ -40,6 +40,9 @@ type Head struct {
 	StateRoot        common.Hash
 	Difficulty       *utils.Big
 	TotalDifficulty  *utils.Big
+	// IsFinalized is set by the head tracker when the RPC reports this block
+	// (or one of its descendants) as finalized. It is not persisted.
+	IsFinalized bool
 }
 
 var _ commontypes.Head[common.Hash] = &Head{}
@@ -114,6 +117,17 @@ func (h *Head) HashAtHeight(blockNum int64) common.Hash {
 	return common.Hash{}
 }
 
+// LatestFinalizedHead returns the latest head in the chain that is marked as finalized, or nil
+func (h *Head) LatestFinalizedHead() commontypes.Head[common.Hash] {
+	for h != nil {
+		if h.IsFinalized {
+			return h
+		}
+		h = h.Parent
+	}
+	return nil
+}
+
 // ChainLength returns the length of the chain followed by recursively looking up parents
 func (h *Head) ChainLength() uint32 {
 	if h == nil {
@@ -226,6 +226,28 @@ func TestHead_EarliestInChain(t *testing.T) {
 	assert.Equal(t, int64(1), head.EarliestInChain().BlockNumber())
 }
 
+func TestHead_LatestFinalizedHead(t *testing.T) {
+	head := evmtypes.Head{
+		Number: 3,
+		Parent: &evmtypes.Head{
+			Number:      2,
+			IsFinalized: true,
+			Parent: &evmtypes.Head{
+				Number:      1,
+				IsFinalized: true,
+			},
+		},
+	}
+
+	finalized := head.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	assert.Equal(t, int64(2), finalized.BlockNumber())
+
+	head.Parent.IsFinalized = false
+	head.Parent.Parent.IsFinalized = false
+	assert.Nil(t, head.LatestFinalizedHead())
+}
+
 func TestHead_IsInChain(t *testing.T) {
 	hash1 := utils.NewHash()
 	hash2 := utils.NewHash()
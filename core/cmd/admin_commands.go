@@ -17,6 +17,8 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -92,7 +94,7 @@ func initAdminSubCmds(client *Client) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "role",
-							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "Role of new user. Options: 'admin', 'edit', 'run', 'view', or a custom role.",
 							Required: true,
 						},
 					},
@@ -109,7 +111,7 @@ func initAdminSubCmds(client *Client) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "new-role, newrole",
-							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "new role to set for user. Options: 'admin', 'edit', 'run', 'view', or a custom role.",
 							Required: true,
 						},
 					},
@@ -128,6 +130,116 @@ func initAdminSubCmds(client *Client) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or revoke scoped API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists scoped API tokens. Admins see the tokens of all users",
+					Action: client.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a scoped API token for the current user",
+					Action: client.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "name of the token, describing its use",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "scopes",
+							Usage:    "comma separated resource:access scopes, e.g. 'jobs:read,bridges:write'. Access is one of 'none', 'read' or 'write', and resource '*' matches all other resources",
+							Required: true,
+						},
+						cli.DurationFlag{
+							Name:  "ttl",
+							Usage: "time until the token expires, or 0 for never",
+							Value: 30 * 24 * time.Hour,
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke a scoped API token",
+					Action: client.RevokeAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "access-key",
+							Usage:    "access key of the token to revoke",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "roles",
+			Usage: "Create, update, list, or delete custom roles and the permissions they grant",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists the built-in and custom roles, and their permissions",
+					Action: client.ListRoles,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a custom role",
+					Action: client.CreateRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "name of the role, e.g. 'key-custodian'",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "description",
+							Usage: "description of the role",
+						},
+						cli.StringFlag{
+							Name:     "permissions",
+							Usage:    "comma separated permissions, e.g. 'jobs.read,keys.*'. See 'admin roles list' for the permissions of the built-in roles",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "update",
+					Usage:  "Replace the description and permissions of a custom role",
+					Action: client.UpdateRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "name of the role to update",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "description",
+							Usage: "description of the role",
+						},
+						cli.StringFlag{
+							Name:     "permissions",
+							Usage:    "comma separated permissions, e.g. 'jobs.read,keys.*'",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a custom role, which must not be assigned to any user",
+					Action: client.DeleteRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "name of the role to delete",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -294,6 +406,247 @@ func (cli *Client) DeleteUser(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"Access key", "Name", "User", "Scopes", "Expires at", "Last used", "Revoked at", "Created at"}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+func (p *APITokenPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.Name,
+		p.UserEmail,
+		p.Scopes,
+		formatOptionalTime(p.ExpiresAt),
+		formatOptionalTime(p.LastUsed),
+		formatOptionalTime(p.RevokedAt),
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	renderList(apiTokensTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	if p.Secret != "" {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nSecret: %s\nThe secret can not be shown again.\n", p.Secret))); err != nil {
+			return err
+		}
+	}
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API Tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders scoped API tokens
+func (cli *Client) ListAPITokens(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/api_tokens", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a scoped API token for the current user, after
+// prompting for their password
+func (cli *Client) CreateAPIToken(c *cli.Context) (err error) {
+	ttl, err := models.MakeDuration(c.Duration("ttl"))
+	if err != nil {
+		return cli.errorOut(errors.New("ttl must not be negative"))
+	}
+
+	fmt.Println("Password:")
+	pwd := cli.PasswordPrompter.Prompt()
+
+	request := sessions.CreateAPITokenRequest{
+		Password: pwd,
+		Name:     c.String("name"),
+		Scopes:   c.String("scopes"),
+		TTL:      ttl,
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Post("/v2/api_tokens", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &APITokenPresenter{}, "Successfully created API token")
+}
+
+// RevokeAPIToken revokes a scoped API token by access key
+func (cli *Client) RevokeAPIToken(c *cli.Context) (err error) {
+	response, err := cli.HTTP.Delete(fmt.Sprintf("/v2/api_tokens/%s", c.String("access-key")))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &APITokenPresenter{}, "Successfully revoked API token")
+}
+
+type RolePresenter struct {
+	JAID
+	presenters.RoleResource
+}
+
+var rolesTableHeaders = []string{"Name", "Built-in", "Description", "Permissions", "Updated at"}
+
+func (p *RolePresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		fmt.Sprintf("%t", p.BuiltIn),
+		p.Description,
+		strings.Join(p.Permissions, ","),
+		p.UpdatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *RolePresenter) RenderTable(rt RendererTable) error {
+	renderList(rolesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type RolePresenters []RolePresenter
+
+// RenderTable implements TableRenderer
+func (ps RolePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Roles\n")); err != nil {
+		return err
+	}
+	renderList(rolesTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRoles renders the built-in and custom roles
+func (cli *Client) ListRoles(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/roles", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &RolePresenters{})
+}
+
+// CreateRole creates a custom role
+func (cli *Client) CreateRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(sessions.RoleRequest{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Permissions: c.String("permissions"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	response, err := cli.HTTP.Post("/v2/roles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &RolePresenter{}, "Successfully created role")
+}
+
+// UpdateRole replaces the description and permissions of a custom role
+func (cli *Client) UpdateRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(sessions.RoleRequest{
+		Description: c.String("description"),
+		Permissions: c.String("permissions"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	response, err := cli.HTTP.Patch(fmt.Sprintf("/v2/roles/%s", c.String("name")), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &RolePresenter{}, "Successfully updated role")
+}
+
+// DeleteRole deletes a custom role by name
+func (cli *Client) DeleteRole(c *cli.Context) (err error) {
+	response, err := cli.HTTP.Delete(fmt.Sprintf("/v2/roles/%s", c.String("name")))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &RolePresenter{}, "Successfully deleted role")
+}
+
 // Status will display the health of various services
 func (cli *Client) Status(c *cli.Context) error {
 	resp, err := cli.HTTP.Get("/health?full=1", nil)
@@ -187,3 +187,114 @@ func TestAdminUsersPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, user.CreatedAt.String())
 	assert.Contains(t, output, user.UpdatedAt.String())
 }
+
+func TestClient_APITokens(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{
+		Password: cltest.Password,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.CreateAPIToken, set, "")
+	require.NoError(t, set.Set("name", "automation"))
+	require.NoError(t, set.Set("scopes", "widgets:read"))
+	assert.ErrorContains(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)), "unknown resource")
+
+	require.NoError(t, set.Set("scopes", "jobs:read,bridges:write"))
+	require.NoError(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)))
+
+	tokens, err := app.SessionORM().ListAPITokens(cltest.APIEmailAdmin)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "automation", tokens[0].Name)
+	assert.Equal(t, "bridges:write,jobs:read", tokens[0].Scopes.String())
+	require.NotNil(t, tokens[0].ExpiresAt)
+
+	buffer := bytes.NewBufferString("")
+	client.Renderer = cmd.RendererTable{Writer: buffer}
+	set = flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.ListAPITokens, set, "")
+	require.NoError(t, client.ListAPITokens(cli.NewContext(nil, set, nil)))
+	assert.Contains(t, buffer.String(), tokens[0].AccessKey)
+	assert.Contains(t, buffer.String(), "bridges:write,jobs:read")
+
+	set = flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.RevokeAPIToken, set, "")
+	require.NoError(t, set.Set("access-key", tokens[0].AccessKey))
+	require.NoError(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+	assert.ErrorContains(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)), "already revoked")
+
+	tokens, err = app.SessionORM().ListAPITokens(cltest.APIEmailAdmin)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].RevokedAt)
+}
+
+func TestClient_Roles(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.CreateRole, set, "")
+	require.NoError(t, set.Set("name", "key-custodian"))
+	require.NoError(t, set.Set("permissions", "keys.fly"))
+	assert.ErrorContains(t, client.CreateRole(cli.NewContext(nil, set, nil)), "unknown permission")
+
+	require.NoError(t, set.Set("permissions", "keys.*"))
+	require.NoError(t, client.CreateRole(cli.NewContext(nil, set, nil)))
+	role, err := app.SessionORM().FindRole("key-custodian")
+	require.NoError(t, err)
+	assert.True(t, role.Permissions.Has(sessions.PermissionKeysExport))
+
+	set = flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.UpdateRole, set, "")
+	require.NoError(t, set.Set("name", "key-custodian"))
+	require.NoError(t, set.Set("permissions", "keys.read"))
+	require.NoError(t, client.UpdateRole(cli.NewContext(nil, set, nil)))
+	require.NoError(t, set.Set("name", "admin"))
+	assert.ErrorContains(t, client.UpdateRole(cli.NewContext(nil, set, nil)), "built-in roles can not be changed")
+
+	buffer := bytes.NewBufferString("")
+	client.Renderer = cmd.RendererTable{Writer: buffer}
+	set = flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.ListRoles, set, "")
+	require.NoError(t, client.ListRoles(cli.NewContext(nil, set, nil)))
+	assert.Contains(t, buffer.String(), "key-custodian")
+	assert.Contains(t, buffer.String(), "keys.read")
+
+	set = flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.DeleteRole, set, "")
+	require.NoError(t, set.Set("name", "key-custodian"))
+	require.NoError(t, client.DeleteRole(cli.NewContext(nil, set, nil)))
+	assert.ErrorContains(t, client.DeleteRole(cli.NewContext(nil, set, nil)), "role not found")
+}
+
+func TestAPITokenPresenter_RenderTable(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	presenter := cmd.APITokenPresenter{
+		JAID: cmd.JAID{ID: "accessKey"},
+		APITokenResource: presenters.APITokenResource{
+			JAID:      presenters.JAID{ID: "accessKey"},
+			Name:      "automation",
+			UserEmail: "foo@bar.com",
+			Scopes:    "jobs:read",
+			Secret:    "secret",
+			ExpiresAt: &expiresAt,
+			CreatedAt: time.Now(),
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	require.NoError(t, presenter.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "accessKey")
+	assert.Contains(t, output, "automation")
+	assert.Contains(t, output, "foo@bar.com")
+	assert.Contains(t, output, "jobs:read")
+	assert.Contains(t, output, "Secret: secret")
+	assert.Contains(t, output, expiresAt.String())
+}
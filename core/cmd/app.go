@@ -125,6 +125,75 @@ func NewApp(client *Client) *cli.App {
 		}
 		return nil
 	}
+
+	// nodeFlags and beforeNode load the node configuration, for the commands
+	// which must be run locally.
+	nodeFlags := []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "config, c",
+			Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+		},
+		cli.StringFlag{
+			Name:  "secrets, s",
+			Usage: "TOML configuration file for secrets. Must be set if and only if config is set.",
+		},
+	}
+	beforeNode := func(c *cli.Context) error {
+		errNoDuplicateFlags := fmt.Errorf("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
+		if c.IsSet("config") {
+			if client.configFilesIsSet || client.secretsFileIsSet {
+				return errNoDuplicateFlags
+			} else {
+				client.configFiles = c.StringSlice("config")
+			}
+		}
+
+		if c.IsSet("secrets") {
+			if client.configFilesIsSet || client.secretsFileIsSet {
+				return errNoDuplicateFlags
+			} else {
+				client.secretsFile = c.String("secrets")
+			}
+		}
+
+		// flags here, or ENV VAR only
+		cfg, err := initServerConfig(&opts, client.configFiles, client.secretsFile)
+		if err != nil {
+			return err
+		}
+		client.Config = cfg
+
+		logFileMaxSizeMB := client.Config.LogFileMaxSize() / utils.MB
+		if logFileMaxSizeMB > 0 {
+			err = utils.EnsureDirAndMaxPerms(client.Config.LogFileDir(), os.FileMode(0700))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Swap out the logger, replacing the old one.
+		err = client.CloseLogger()
+		if err != nil {
+			return err
+		}
+
+		lggrCfg := logger.Config{
+			LogLevel:       client.Config.LogLevel(),
+			Dir:            client.Config.LogFileDir(),
+			JsonConsole:    client.Config.JSONConsole(),
+			UnixTS:         client.Config.LogUnixTimestamps(),
+			FileMaxSizeMB:  int(logFileMaxSizeMB),
+			FileMaxAgeDays: int(client.Config.LogFileMaxAge()),
+			FileMaxBackups: int(client.Config.LogFileMaxBackups()),
+		}
+		l, closeFn := lggrCfg.New()
+
+		client.Logger = l
+		client.CloseLogger = closeFn
+
+		return nil
+	}
+
 	app.Commands = removeHidden([]cli.Command{
 		{
 			Name:        "admin",
@@ -177,6 +246,11 @@ func NewApp(client *Client) *cli.App {
 				keysCommand("DKGEncrypt", NewDKGEncryptKeysClient(client)),
 
 				initVRFKeysSubCmd(client),
+
+				initKeyRotationSubCmd(client),
+
+				initKeysBackupSubCmd(client, nodeFlags, beforeNode),
+				initKeysRestoreSubCmd(client, nodeFlags, beforeNode),
 			},
 		},
 		{
@@ -185,71 +259,8 @@ func NewApp(client *Client) *cli.App {
 			Usage:       "Commands for admin actions that must be run locally",
 			Description: "Commands can only be run from on the same machine as the Chainlink node.",
 			Subcommands: initLocalSubCmds(client, build.IsProd()),
-			Flags: []cli.Flag{
-				cli.StringSliceFlag{
-					Name:  "config, c",
-					Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
-				},
-				cli.StringFlag{
-					Name:  "secrets, s",
-					Usage: "TOML configuration file for secrets. Must be set if and only if config is set.",
-				},
-			},
-			Before: func(c *cli.Context) error {
-				errNoDuplicateFlags := fmt.Errorf("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
-				if c.IsSet("config") {
-					if client.configFilesIsSet || client.secretsFileIsSet {
-						return errNoDuplicateFlags
-					} else {
-						client.configFiles = c.StringSlice("config")
-					}
-				}
-
-				if c.IsSet("secrets") {
-					if client.configFilesIsSet || client.secretsFileIsSet {
-						return errNoDuplicateFlags
-					} else {
-						client.secretsFile = c.String("secrets")
-					}
-				}
-
-				// flags here, or ENV VAR only
-				cfg, err := initServerConfig(&opts, client.configFiles, client.secretsFile)
-				if err != nil {
-					return err
-				}
-				client.Config = cfg
-
-				logFileMaxSizeMB := client.Config.LogFileMaxSize() / utils.MB
-				if logFileMaxSizeMB > 0 {
-					err = utils.EnsureDirAndMaxPerms(client.Config.LogFileDir(), os.FileMode(0700))
-					if err != nil {
-						return err
-					}
-				}
-
-				// Swap out the logger, replacing the old one.
-				err = client.CloseLogger()
-				if err != nil {
-					return err
-				}
-
-				lggrCfg := logger.Config{
-					LogLevel:       client.Config.LogLevel(),
-					Dir:            client.Config.LogFileDir(),
-					JsonConsole:    client.Config.JSONConsole(),
-					UnixTS:         client.Config.LogUnixTimestamps(),
-					FileMaxSizeMB:  int(logFileMaxSizeMB),
-					FileMaxAgeDays: int(client.Config.LogFileMaxAge()),
-					FileMaxBackups: int(client.Config.LogFileMaxBackups()),
-				}
-				l, closeFn := lggrCfg.New()
-
-				client.Logger = l
-				client.CloseLogger = closeFn
-
-				return nil
-			},
+			Flags:       nodeFlags,
+			Before:      beforeNode,
 		},
 		{
 			Name:        "initiators",
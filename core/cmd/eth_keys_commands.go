@@ -37,6 +37,10 @@ func initEthKeysSubCmd(client *Client) cli.Command {
 						Name:  "max-gas-price-gwei, maxGasPriceGWei",
 						Usage: "Optional maximum gas price (GWei) for the creating key.",
 					},
+					cli.StringFlag{
+						Name:  "remote-address",
+						Usage: "Register the key with this address held by the remote signer, instead of creating a new one.",
+					},
 				},
 			},
 			{
@@ -195,6 +199,9 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	if c.IsSet("max-gas-price-gwei") {
 		query.Set("maxGasPriceGWei", c.String("max-gas-price-gwei"))
 	}
+	if c.IsSet("remote-address") {
+		query.Set("remoteAddress", c.String("remote-address"))
+	}
 
 	createUrl.RawQuery = query.Encode()
 	resp, err := cli.HTTP.Post(createUrl.String(), nil)
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -9,16 +11,24 @@ type EVMNodePresenter struct {
 	presenters.EVMNodeResource
 }
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Latency", "Error Rate", "Score", "Config"}
+
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.GetID(), p.Name, p.ChainID, p.State, p.Config}
+	latency, errorRate, score := "N/A", "N/A", "N/A"
+	if p.Stats != nil {
+		latency = p.Stats.Latency
+		errorRate = fmt.Sprintf("%.2f%%", p.Stats.ErrorRate*100)
+		score = p.Stats.Score
+	}
+	return []string{p.Name, p.ChainID, p.State, latency, errorRate, score, p.Config}
 }
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +44,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -72,12 +72,13 @@ type EthTxPresenter struct {
 
 // RenderTable implements TableRenderer
 func (p *EthTxPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"From", "Nonce", "To", "State"})
+	table := rt.newTable([]string{"From", "Nonce", "To", "State", "Revert Reason"})
 	table.Append([]string{
 		p.From.Hex(),
 		p.Nonce,
 		p.To.Hex(),
 		fmt.Sprint(p.State),
+		p.RevertReason,
 	})
 
 	render(fmt.Sprintf("Ethereum Transaction %v", p.Hash.Hex()), table)
@@ -50,6 +50,17 @@ func initJobsSubCmds(client *Client) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: client.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Run the pipeline of a job spec without saving the job or sending transactions",
+			Action: client.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "jobrun",
+					Usage: "JSON object of $(jobRun) variables to run the pipeline with, or a path to a JSON file",
+				},
+			},
+		},
 	}
 }
 
@@ -197,6 +208,58 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// PipelineSimulationPresenter wraps the JSONAPI pipeline simulation resource
+// and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Inputs", "Output", "Error", "Duration"})
+	for _, tr := range p.Trace {
+		inputs := make([]string, len(tr.Inputs))
+		for i, input := range tr.Inputs {
+			if input.Error != nil {
+				inputs[i] = "error: " + *input.Error
+			} else {
+				inputs[i] = stringOrNA(input.Output)
+			}
+		}
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			strings.Join(inputs, "\n"),
+			stringOrNA(tr.Output),
+			stringOrNA(tr.Error),
+			tr.Duration,
+		})
+	}
+	render("Simulated Tasks", table)
+
+	table = rt.newTable([]string{"Output", "Error"})
+	for i := 0; i < len(p.Outputs) || i < len(p.FatalErrors); i++ {
+		var output, fatalErr *string
+		if i < len(p.Outputs) {
+			output = p.Outputs[i]
+		}
+		if i < len(p.FatalErrors) {
+			fatalErr = p.FatalErrors[i]
+		}
+		table.Append([]string{stringOrNA(output), stringOrNA(fatalErr)})
+	}
+	render("Simulated Run", table)
+	return nil
+}
+
+func stringOrNA(s *string) string {
+	if s == nil {
+		return "N/A"
+	}
+	return *s
+}
+
 // ListJobs lists all jobs
 func (cli *Client) ListJobs(c *cli.Context) (err error) {
 	return cli.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -264,6 +327,51 @@ func (cli *Client) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// SimulateJob runs the pipeline of a job spec once, without saving the job,
+// sending transactions or caching bridge responses.
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var jobRun map[string]interface{}
+	if c.IsSet("jobrun") {
+		buf, err := getBufferFromJSON(c.String("jobrun"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid jobrun"))
+		}
+		if err = json.Unmarshal(buf.Bytes(), &jobRun); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid jobrun"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML:   tomlString,
+		JobRun: jobRun,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
+
 // DeleteJob deletes a job
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -82,6 +82,58 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestPipelineSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		output = `"42"`
+		input  = `"21"`
+		errMsg = "boom"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.PipelineSimulationPresenter{
+		PipelineSimulationResource: presenters.PipelineSimulationResource{
+			JAID:        presenters.NewJAID("1"),
+			Outputs:     []*string{&output, nil},
+			FatalErrors: []*string{nil, &errMsg},
+			Trace: []presenters.PipelineTaskTraceResource{
+				{
+					DotID:    "ds1_parse",
+					Type:     "jsonparse",
+					Output:   &input,
+					Duration: "1ms",
+				},
+				{
+					DotID:    "ds1_multiply",
+					Type:     "multiply",
+					Inputs:   []presenters.PipelineTaskResult{{Output: &input}},
+					Output:   &output,
+					Duration: "2ms",
+				},
+				{
+					DotID:    "ds2",
+					Type:     "http",
+					Error:    &errMsg,
+					Duration: "3ms",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "ds1_parse")
+	assert.Contains(t, rendered, "ds1_multiply")
+	assert.Contains(t, rendered, "jsonparse")
+	assert.Contains(t, rendered, output)
+	assert.Contains(t, rendered, input)
+	assert.Contains(t, rendered, errMsg)
+	assert.Contains(t, rendered, "2ms")
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -383,6 +435,39 @@ func TestClient_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestClient_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	spec := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    parse    [type=jsonparse path="data" data="$(jobRun.requestBody)"]
+    multiply [type=multiply times=2]
+
+    parse -> multiply
+"""
+`
+	set := flag.NewFlagSet("test", 0)
+	cltest.FlagSetApplyFromAction(client.SimulateJob, set, "")
+
+	require.NoError(t, set.Set("jobrun", `{"requestBody": "{\"data\": 21}"}`))
+	require.NoError(t, set.Parse([]string{spec}))
+
+	err := client.SimulateJob(cli.NewContext(nil, set, nil))
+	require.NoError(t, err)
+
+	requireJobsCount(t, app.JobORM(), 0)
+
+	output := *r.Renders[0].(*cmd.PipelineSimulationPresenter)
+	require.Len(t, output.Outputs, 1)
+	assert.Equal(t, "42", *output.Outputs[0])
+	assert.Len(t, output.Trace, 2)
+}
+
 func TestClient_DeleteJob(t *testing.T) {
 	t.Parallel()
 
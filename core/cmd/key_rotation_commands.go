@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeyRotationSubCmd(client *Client) cli.Command {
+	rotateCmd := func(keyType keyrotation.KeyType, keyName string) cli.Command {
+		return cli.Command{
+			Name: string(keyType),
+			Usage: format(fmt.Sprintf(`Replaces the %s with the given ID by a new one, and switches the job specs which reference it to the new key.
+			The old key is kept until the rotation is confirmed.`, keyName)),
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only list the references of the key",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return client.RotateKey(c, keyType)
+			},
+		}
+	}
+	return cli.Command{
+		Name:  "rotate",
+		Usage: "Remote commands for rotating the node's OCR2, EVM and P2P keys",
+		Subcommands: cli.Commands{
+			rotateCmd(keyrotation.KeyTypeOCR2, "OCR2 key bundle"),
+			rotateCmd(keyrotation.KeyTypeEth, "EVM key"),
+			rotateCmd(keyrotation.KeyTypeP2P, "P2P key"),
+			{
+				Name:   "list",
+				Usage:  format(`List key rotations`),
+				Action: client.ListKeyRotations,
+			},
+			{
+				Name:  "confirm",
+				Usage: format(`Deletes the old key of the rotation with the given ID, once nothing references it`),
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "skip the confirmation prompt",
+					},
+				},
+				Action: client.ConfirmKeyRotation,
+			},
+		},
+	}
+}
+
+var keyReferencesTableHeaders = []string{"Kind", "Job ID", "Job name", "Field", "Chain ID", "Address", "Switched", "Action"}
+
+func keyReferenceRow(ref keyrotation.Reference) []string {
+	row := []string{string(ref.Kind), "", ref.JobName, ref.Field, "", "", strconv.FormatBool(ref.Switched), ref.Action}
+	if ref.JobID != 0 {
+		row[1] = strconv.Itoa(int(ref.JobID))
+	}
+	if ref.ChainID != nil {
+		row[4] = ref.ChainID.String()
+	}
+	if ref.Address != nil {
+		row[5] = ref.Address.Hex()
+	}
+	return row
+}
+
+type KeyReferencePresenters []presenters.KeyReferenceResource
+
+// RenderTable implements TableRenderer
+func (ps KeyReferencePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, keyReferenceRow(p.Reference))
+	}
+
+	if _, err := rt.Write([]byte("References\n")); err != nil {
+		return err
+	}
+	renderList(keyReferencesTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type KeyRotationPresenter struct {
+	JAID // Include this to overwrite the presenter JAID so it can correctly render the ID in JSON
+	presenters.KeyRotationResource
+}
+
+var keyRotationsTableHeaders = []string{"ID", "Type", "Old key", "New key", "Status", "Created at", "Removed at"}
+
+func (p *KeyRotationPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		string(p.KeyType),
+		p.OldKeyID,
+		p.NewKeyID,
+		string(p.Status),
+		p.CreatedAt.String(),
+		formatOptionalTime(p.RemovedAt),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *KeyRotationPresenter) RenderTable(rt RendererTable) error {
+	if _, err := rt.Write([]byte("Key Rotation\n")); err != nil {
+		return err
+	}
+	renderList(keyRotationsTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	if _, err := rt.Write([]byte("\n")); err != nil {
+		return err
+	}
+	if len(p.References) == 0 {
+		return nil
+	}
+
+	rows := [][]string{}
+	for _, ref := range p.References {
+		rows = append(rows, keyReferenceRow(ref))
+	}
+	if _, err := rt.Write([]byte("References of the old key\n")); err != nil {
+		return err
+	}
+	renderList(keyReferencesTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type KeyRotationPresenters []KeyRotationPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeyRotationPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Key Rotations\n")); err != nil {
+		return err
+	}
+	renderList(keyRotationsTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// RotateKey rotates the key with the ID given as argument, or lists its
+// references with --dry-run.
+func (cli *Client) RotateKey(c *cli.Context, keyType keyrotation.KeyType) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the key to be rotated"))
+	}
+	keyID := strings.TrimSpace(c.Args().First())
+
+	if c.Bool("dry-run") {
+		resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/keys/rotations/references/%s/%s", keyType, url.PathEscape(keyID)))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		defer func() {
+			if cerr := resp.Body.Close(); cerr != nil {
+				err = multierr.Append(err, cerr)
+			}
+		}()
+		return cli.renderAPIResponse(resp, &KeyReferencePresenters{})
+	}
+
+	request, err := json.Marshal(web.CreateKeyRotationRequest{KeyType: string(keyType), KeyID: keyID})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/keys/rotations", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyRotationPresenter{}, "Key rotated, confirm the rotation to delete the old key once the actions listed below are done")
+}
+
+// ListKeyRotations lists key rotations
+func (cli *Client) ListKeyRotations(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/keys/rotations", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyRotationPresenters{})
+}
+
+// ConfirmKeyRotation deletes the old key of a retiring rotation
+func (cli *Client) ConfirmKeyRotation(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the rotation to be confirmed"))
+	}
+	id, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid rotation ID"))
+	}
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/rotations/%d/confirm", id), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyRotationPresenter{}, "Old key deleted")
+}
@@ -0,0 +1,101 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyRotationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+		fwd      = testutils.NewAddress()
+		oldKeyID = testutils.NewAddress().Hex()
+		newKeyID = testutils.NewAddress().Hex()
+	)
+
+	p := cmd.KeyRotationPresenter{
+		JAID: cmd.JAID{ID: "1"},
+		KeyRotationResource: presenters.KeyRotationResource{
+			JAID:     presenters.NewJAID("1"),
+			KeyType:  keyrotation.KeyTypeEth,
+			OldKeyID: oldKeyID,
+			NewKeyID: newKeyID,
+			Status:   keyrotation.StatusRetiring,
+			References: []keyrotation.Reference{
+				{Kind: keyrotation.ReferenceKindJob, JobID: 12, JobName: "keeper", Field: "fromAddress", Switched: true},
+				{Kind: keyrotation.ReferenceKindForwarder, Address: &fwd, Action: "authorize the new key"},
+			},
+			CreatedAt: time.Now(),
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, oldKeyID)
+	assert.Contains(t, output, newKeyID)
+	assert.Contains(t, output, "retiring")
+	assert.Contains(t, output, "fromAddress")
+	assert.Contains(t, output, fwd.Hex())
+	assert.Contains(t, output, "authorize the new key")
+
+	buffer.Reset()
+	ps := cmd.KeyRotationPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, oldKeyID)
+	assert.NotContains(t, output, "fromAddress")
+}
+
+func TestClient_RotateOCR2Key(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	key, err := app.GetKeyStore().OCR2().Create("evm")
+	require.NoError(t, err)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("dry-run", true, "")
+	require.NoError(t, set.Parse([]string{key.ID()}))
+	require.NoError(t, client.RotateKey(cli.NewContext(nil, set, nil), keyrotation.KeyTypeOCR2))
+	require.Len(t, r.Renders, 1)
+	refs := *r.Renders[0].(*cmd.KeyReferencePresenters)
+	assert.Empty(t, refs)
+	requireOCR2KeyCount(t, app, 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("dry-run", false, "")
+	require.NoError(t, set.Parse([]string{key.ID()}))
+	require.NoError(t, client.RotateKey(cli.NewContext(nil, set, nil), keyrotation.KeyTypeOCR2))
+	require.Len(t, r.Renders, 2)
+	rotation := *r.Renders[1].(*cmd.KeyRotationPresenter)
+	assert.Equal(t, key.ID(), rotation.OldKeyID)
+	requireOCR2KeyCount(t, app, 2)
+
+	require.NoError(t, client.ListKeyRotations(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 3)
+	rotations := *r.Renders[2].(*cmd.KeyRotationPresenters)
+	require.Len(t, rotations, 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("yes", false, "")
+	require.NoError(t, set.Set("yes", "true"))
+	require.NoError(t, set.Parse([]string{rotation.ID}))
+	require.NoError(t, client.ConfirmKeyRotation(cli.NewContext(nil, set, nil)))
+	requireOCR2KeyCount(t, app, 1)
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initKeysBackupSubCmd(client *Client, nodeFlags []cli.Flag, beforeNode cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name: "backup",
+		Usage: format(`Local command which exports every key of the node, and the EVM key states of each chain, into a single archive encrypted with the given password.
+		The keys in the archive remain encrypted with the keystore password of the node.`),
+		Flags: append([]cli.Flag{
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "`FILE` containing the password to encrypt the archive with",
+			},
+			cli.StringFlag{
+				Name:  "output, o",
+				Usage: "`FILE` where the archive is written",
+			},
+		}, nodeFlags...),
+		Before: beforeNode,
+		Action: client.BackupKeys,
+	}
+}
+
+func initKeysRestoreSubCmd(client *Client, nodeFlags []cli.Flag, beforeNode cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name: "restore",
+		Usage: format(`Local command which imports an archive written by "keys backup" into the database of a node which has no keys yet.
+		The node must be stopped, and keeps using the keystore password of the node the archive was taken from.`),
+		Flags: append([]cli.Flag{
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "`FILE` containing the password the archive was encrypted with",
+			},
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only decrypt the archive and check it for conflicts with the database",
+			},
+		}, nodeFlags...),
+		Before: beforeNode,
+		Action: client.RestoreKeys,
+	}
+}
+
+type KeysRestorePresenter struct {
+	keystore.RestoreSummary
+	DryRun bool
+}
+
+// RenderTable implements TableRenderer
+func (p *KeysRestorePresenter) RenderTable(rt RendererTable) error {
+	createdAt := ""
+	if !p.CreatedAt.IsZero() {
+		createdAt = p.CreatedAt.String()
+	}
+	if _, err := rt.Write([]byte("Backup\n")); err != nil {
+		return err
+	}
+	renderList(
+		[]string{"Version", "Created at", "Key ring", "EVM key states", "Dry run"},
+		[][]string{{strconv.Itoa(p.Version), createdAt, strconv.FormatBool(p.HasKeyRing), strconv.Itoa(len(p.EVMKeyStates)), strconv.FormatBool(p.DryRun)}},
+		rt.Writer,
+	)
+	if _, err := rt.Write([]byte("\n")); err != nil {
+		return err
+	}
+
+	if len(p.EVMKeyStates) > 0 {
+		rows := [][]string{}
+		for _, state := range p.EVMKeyStates {
+			rows = append(rows, []string{state.Address.Hex(), state.EVMChainID.String(), strconv.FormatInt(state.NextNonce, 10), strconv.FormatBool(state.Disabled)})
+		}
+		if _, err := rt.Write([]byte("EVM Key States\n")); err != nil {
+			return err
+		}
+		renderList([]string{"Address", "EVM Chain ID", "Next Nonce", "Disabled"}, rows, rt.Writer)
+		if _, err := rt.Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+
+	if len(p.Conflicts) > 0 {
+		rows := [][]string{}
+		for _, conflict := range p.Conflicts {
+			rows = append(rows, []string{conflict})
+		}
+		if _, err := rt.Write([]byte("Conflicts\n")); err != nil {
+			return err
+		}
+		renderList([]string{"Conflict"}, rows, rt.Writer)
+	}
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func readPasswordFile(c *cli.Context, flag string) (string, error) {
+	passwordFile := c.String(flag)
+	if len(passwordFile) == 0 {
+		return "", errors.Errorf("Must specify --%s flag", flag)
+	}
+	password, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", errors.Wrap(err, "Could not read password file")
+	}
+	return strings.TrimSpace(string(password)), nil
+}
+
+// BackupKeys writes the encrypted key ring and the EVM key states of the
+// node into a password encrypted archive.
+func (cli *Client) BackupKeys(c *cli.Context) (err error) {
+	password, err := readPasswordFile(c, "password")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	output := c.String("output")
+	if len(output) == 0 {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	lggr := logger.Sugared(cli.Logger.Named("BackupKeys"))
+	db, err := pg.OpenUnlockedDB(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "opening DB"))
+	}
+	defer lggr.ErrorIfFn(db.Close, "Error closing db")
+
+	orm := keystore.NewORM(db, lggr, cli.Config)
+	archive, err := orm.Backup(password, utils.GetScryptParams(cli.Config))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to back up keys"))
+	}
+	if err = utils.WriteFileWithMaxPerms(output, archive, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "failed to write %s", output))
+	}
+
+	lggr.Infow("Backed up keys", "output", output)
+	return nil
+}
+
+// RestoreKeys imports an archive written by BackupKeys into the database,
+// or only checks it with --dry-run.
+func (cli *Client) RestoreKeys(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the archive to be restored"))
+	}
+	archive, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read archive"))
+	}
+	password, err := readPasswordFile(c, "password")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	dryRun := c.Bool("dry-run")
+
+	lggr := logger.Sugared(cli.Logger.Named("RestoreKeys"))
+	db, err := pg.OpenUnlockedDB(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "opening DB"))
+	}
+	defer lggr.ErrorIfFn(db.Close, "Error closing db")
+
+	orm := keystore.NewORM(db, lggr, cli.Config)
+	summary, err := orm.Restore(archive, password, dryRun)
+	if err == nil || len(summary.Conflicts) > 0 {
+		if rerr := cli.Render(&KeysRestorePresenter{RestoreSummary: summary, DryRun: dryRun}); rerr != nil {
+			return cli.errorOut(rerr)
+		}
+	}
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to restore keys"))
+	}
+	return nil
+}
@@ -275,7 +275,7 @@ func (cli *Client) parseResponse(resp *http.Response) ([]byte, error) {
 	}
 
 	if errors.Is(err, errForbidden) {
-		return nil, cli.errorOut(multierr.Append(err, fmt.Errorf("this action requires %s privileges. The current user %s has '%s' role and cannot perform this action, login with a user that has '%s' role via 'chainlink admin login'", resp.Header.Get("forbidden-required-role"), resp.Header.Get("forbidden-provided-email"), resp.Header.Get("forbidden-provided-role"), resp.Header.Get("forbidden-required-role"))))
+		return nil, cli.errorOut(multierr.Append(err, fmt.Errorf("this action requires the '%s' permission. The current user %s has '%s' role which does not grant it, login with a user whose role grants it via 'chainlink admin login'", resp.Header.Get("forbidden-required-permission"), resp.Header.Get("forbidden-provided-email"), resp.Header.Get("forbidden-provided-role"))))
 	}
 	if err != nil {
 		return nil, cli.errorOut(err)
@@ -48,9 +48,11 @@ type AppConfig interface {
 	P2PV2Networking
 	Prometheus
 	Pyroscope
+	RemoteSigner
 	Secrets
 	Sentry
 	TelemetryIngress
+	Tracing
 	Web
 	audit.Config
 }
@@ -9,6 +9,10 @@ import (
 type JobPipeline interface {
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() models.Duration
+	JobPipelineCircuitBreakerEnabled() bool
+	JobPipelineCircuitBreakerFailureThreshold() uint32
+	JobPipelineCircuitBreakerOpenTimeout() time.Duration
+	JobPipelineHTTPHedgingPercentile() uint16
 	JobPipelineMaxRunDuration() time.Duration
 	JobPipelineMaxSuccessfulRuns() uint64
 	JobPipelineReaperInterval() time.Duration
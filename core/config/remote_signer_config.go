@@ -0,0 +1,12 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type RemoteSigner interface {
+	RemoteSignerEnabled() bool
+	RemoteSignerURL() *url.URL
+	RemoteSignerTimeout() time.Duration
+}
@@ -0,0 +1,153 @@
+// Package secretref resolves references to secrets which are stored outside of
+// the secrets TOML, in files or in a HashiCorp Vault compatible KV store.
+package secretref
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// SchemeFile references the contents of a file, for example
+	// file:///run/secrets/db. A trailing newline is removed.
+	SchemeFile = "file"
+	// SchemeVault references a key of a KV secret, for example
+	// vault://secret/chainlink/db#url for the key url of the secret
+	// chainlink/db in the KV engine mounted at secret.
+	SchemeVault = "vault"
+)
+
+// DefaultTimeout limits each request to the KV store.
+const DefaultTimeout = 10 * time.Second
+
+// IsReference returns true if s is a file:// or vault:// reference.
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, SchemeFile+"://") || strings.HasPrefix(s, SchemeVault+"://")
+}
+
+// Resolver resolves references. The zero value only resolves file:// references.
+type Resolver struct {
+	vaultURL   *url.URL
+	vaultToken string
+	client     *http.Client
+}
+
+// NewResolver returns a Resolver which reads vault:// references from the KV
+// store at vaultURL, authenticated by vaultToken. A nil vaultURL disables
+// vault:// references.
+func NewResolver(vaultURL *url.URL, vaultToken string) *Resolver {
+	return &Resolver{
+		vaultURL:   vaultURL,
+		vaultToken: vaultToken,
+		client:     &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Resolve returns the secret referenced by ref.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	u, err := url.Parse(ref)
+	if err != nil {
+		// The reference may contain credentials by mistake, so it is not echoed.
+		return "", errors.New("invalid secret reference")
+	}
+	switch u.Scheme {
+	case SchemeFile:
+		return readFile(u)
+	case SchemeVault:
+		return r.readVault(ctx, u)
+	default:
+		return "", errors.Errorf("unsupported secret reference scheme %q", u.Scheme)
+	}
+}
+
+func readFile(u *url.URL) (string, error) {
+	if u.Host != "" && u.Host != "localhost" {
+		return "", errors.Errorf("file reference must be absolute, like file:///path, not file://%s%s", u.Host, u.Path)
+	}
+	b, err := os.ReadFile(u.Path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read secret file")
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// readVault reads the key named by the fragment of u from the secret at
+// <mount>/<path>. Both version 2 and version 1 of the KV engine are supported.
+func (r *Resolver) readVault(ctx context.Context, u *url.URL) (string, error) {
+	mount, path := u.Host, strings.Trim(u.Path, "/")
+	key := u.Fragment
+	if mount == "" || path == "" || key == "" {
+		return "", errors.Errorf("vault reference must be of the form vault://<mount>/<path>#<key>, not vault://%s/%s#%s", mount, path, key)
+	}
+	if r == nil || r.vaultURL == nil {
+		return "", errors.Errorf("vault reference %s/%s#%s requires References.Vault.URL", mount, path, key)
+	}
+
+	// KV version 2 serves secrets under /data/, and wraps them in a second data object.
+	v, err := r.getVault(ctx, mount+"/data/"+path)
+	if errors.Is(err, errVaultNotFound) {
+		v, err = r.getVault(ctx, mount+"/"+path)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read vault secret %s/%s", mount, path)
+	}
+	if inner, ok := v["data"].(map[string]interface{}); ok {
+		if _, isV2 := v["metadata"]; isV2 {
+			v = inner
+		}
+	}
+	s, ok := v[key].(string)
+	if !ok {
+		return "", errors.Errorf("vault secret %s/%s has no string key %q", mount, path, key)
+	}
+	return s, nil
+}
+
+var errVaultNotFound = errors.New("not found")
+
+func (r *Resolver) getVault(ctx context.Context, path string) (map[string]interface{}, error) {
+	endpoint := r.vaultURL.JoinPath("v1", path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if r.vaultToken != "" {
+		req.Header.Set("X-Vault-Token", r.vaultToken)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errVaultNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err = json.Unmarshal(body, &secret); err != nil {
+		return nil, errors.Wrap(err, "failed to decode response")
+	}
+	if secret.Data == nil {
+		return nil, fmt.Errorf("response has no data")
+	}
+	return secret.Data, nil
+}
@@ -1,8 +1,18 @@
 package config
 
-import ocr2models "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/models"
+import (
+	"context"
+	"time"
+
+	ocr2models "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/models"
+)
 
 type Secrets interface {
 	MercuryCredentials(credName string) *ocr2models.MercuryCredentials
 	VRFPassword() string
+
+	// SecretsRefreshInterval is how often RefreshSecrets should be called, or zero if never.
+	SecretsRefreshInterval() time.Duration
+	// RefreshSecrets resolves secret references again, to pick up rotated secrets.
+	RefreshSecrets(ctx context.Context) error
 }
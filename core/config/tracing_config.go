@@ -0,0 +1,8 @@
+package config
+
+type Tracing interface {
+	TracingEnabled() bool
+	TracingCollectorTarget() string
+	TracingSamplingRatio() float64
+	TracingTLSCertPath() string
+}
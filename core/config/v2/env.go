@@ -21,6 +21,8 @@ var (
 	EnvPasswordVRF                  = EnvSecret("CL_PASSWORD_VRF")
 	EnvPyroscopeAuthToken           = EnvSecret("CL_PYROSCOPE_AUTH_TOKEN")
 	EnvPrometheusAuthToken          = EnvSecret("CL_PROMETHEUS_AUTH_TOKEN")
+	EnvWebServerOIDCClientSecret    = EnvSecret("CL_WEBSERVER_OIDC_CLIENT_SECRET")
+	EnvReferencesVaultToken         = EnvSecret("CL_REFERENCES_VAULT_TOKEN")
 )
 
 type Env string
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink/cfgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -54,6 +56,8 @@ type Core struct {
 	AutoPprof        AutoPprof               `toml:",omitempty"`
 	Pyroscope        Pyroscope               `toml:",omitempty"`
 	Sentry           Sentry                  `toml:",omitempty"`
+	Tracing          Tracing                 `toml:",omitempty"`
+	RemoteSigner     RemoteSigner            `toml:",omitempty"`
 	Insecure         Insecure                `toml:",omitempty"`
 }
 
@@ -67,6 +71,8 @@ func init() {
 	if err := cfgtest.DocDefaultsOnly(strings.NewReader(defaultsTOML), &defaults, DecodeTOML); err != nil {
 		log.Fatalf("Failed to initialize defaults from docs: %v", err)
 	}
+	// The custom roles of the docs are only an example.
+	defaults.WebServer.OIDC.CustomRoles = nil
 }
 
 func CoreDefaults() (c Core) {
@@ -108,6 +114,8 @@ func (c *Core) SetFrom(f *Core) {
 	c.AutoPprof.setFrom(&f.AutoPprof)
 	c.Pyroscope.setFrom(&f.Pyroscope)
 	c.Sentry.setFrom(&f.Sentry)
+	c.Tracing.setFrom(&f.Tracing)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
 	c.Insecure.setFrom(&f.Insecure)
 }
 
@@ -127,6 +135,8 @@ type Secrets struct {
 	Pyroscope  PyroscopeSecrets  `toml:",omitempty"`
 	Prometheus PrometheusSecrets `toml:",omitempty"`
 	Mercury    MercurySecrets    `toml:",omitempty"`
+	WebServer  WebServerSecrets  `toml:",omitempty"`
+	References ReferencesSecrets `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -207,6 +217,38 @@ type PyroscopeSecrets struct {
 type PrometheusSecrets struct {
 	AuthToken *models.Secret
 }
+
+type WebServerSecrets struct {
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
+}
+
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+type ReferencesSecrets struct {
+	RefreshInterval *models.Duration
+	Vault           VaultSecrets `toml:",omitempty"`
+}
+
+type VaultSecrets struct {
+	URL   *models.URL
+	Token *models.Secret
+}
+
+func (v *VaultSecrets) ValidateConfig() (err error) {
+	if v.URL == nil {
+		if v.Token != nil {
+			err = multierr.Append(err, ErrMissing{Name: "URL", Msg: "must be set with Token"})
+		}
+		return err
+	}
+	if s := v.URL.URL().Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, ErrInvalid{Name: "URL", Value: v.URL.String(), Msg: "must be an http or https URL"})
+	}
+	return err
+}
+
 type Feature struct {
 	FeedsManager *bool
 	LogPoller    *bool
@@ -472,6 +514,7 @@ type WebServer struct {
 	SessionReaperExpiration *models.Duration
 
 	MFA       WebServerMFA       `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
 }
@@ -503,6 +546,7 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.MFA.setFrom(&f.MFA)
+	w.OIDC.setFrom(&f.OIDC)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
@@ -521,6 +565,112 @@ func (w *WebServerMFA) setFrom(f *WebServerMFA) {
 	}
 }
 
+type WebServerOIDC struct {
+	Enabled     *bool
+	IssuerURL   *models.URL
+	ClientID    *string
+	RedirectURL *models.URL
+	RolesClaim  *string
+	AdminRole   *string
+	EditRole    *string
+	RunRole     *string
+	ViewRole    *string
+	CustomRoles []WebServerOIDCCustomRole `toml:",omitempty"`
+}
+
+// WebServerOIDCCustomRole maps a RolesClaim value to a custom role.
+type WebServerOIDCCustomRole struct {
+	Value *string
+	Role  *string
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.Enabled; v != nil {
+		w.Enabled = v
+	}
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.RolesClaim; v != nil {
+		w.RolesClaim = v
+	}
+	if v := f.AdminRole; v != nil {
+		w.AdminRole = v
+	}
+	if v := f.EditRole; v != nil {
+		w.EditRole = v
+	}
+	if v := f.RunRole; v != nil {
+		w.RunRole = v
+	}
+	if v := f.ViewRole; v != nil {
+		w.ViewRole = v
+	}
+	if v := f.CustomRoles; v != nil {
+		w.CustomRoles = v
+	}
+}
+
+func (w *WebServerOIDC) ValidateConfig() (err error) {
+	if w.Enabled == nil || !*w.Enabled {
+		return
+	}
+	if w.IssuerURL == nil || w.IssuerURL.IsZero() {
+		err = multierr.Append(err, ErrMissing{Name: "IssuerURL", Msg: "required when OIDC is enabled"})
+	} else if s := w.IssuerURL.URL().Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, ErrInvalid{Name: "IssuerURL", Value: w.IssuerURL.String(), Msg: "must be an http or https URL"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, ErrMissing{Name: "ClientID", Msg: "required when OIDC is enabled"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.IsZero() {
+		err = multierr.Append(err, ErrMissing{Name: "RedirectURL", Msg: "required when OIDC is enabled"})
+	} else if s := w.RedirectURL.URL().Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, ErrInvalid{Name: "RedirectURL", Value: w.RedirectURL.String(), Msg: "must be an http or https URL"})
+	}
+	if w.RolesClaim == nil || *w.RolesClaim == "" {
+		err = multierr.Append(err, ErrMissing{Name: "RolesClaim", Msg: "required when OIDC is enabled"})
+	}
+	roles := map[string]string{}
+	for _, r := range []struct {
+		name  string
+		value *string
+	}{{"AdminRole", w.AdminRole}, {"EditRole", w.EditRole}, {"RunRole", w.RunRole}, {"ViewRole", w.ViewRole}} {
+		if r.value == nil || *r.value == "" {
+			continue
+		}
+		if other, ok := roles[*r.value]; ok {
+			err = multierr.Append(err, ErrInvalid{Name: r.name, Value: *r.value, Msg: fmt.Sprintf("duplicate - already used by %s", other)})
+			continue
+		}
+		roles[*r.value] = r.name
+	}
+	for i, r := range w.CustomRoles {
+		if r.Value == nil || *r.Value == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("CustomRoles.%d.Value", i), Msg: "required for a custom role"})
+		} else if other, ok := roles[*r.Value]; ok {
+			err = multierr.Append(err, ErrInvalid{Name: fmt.Sprintf("CustomRoles.%d.Value", i), Value: *r.Value, Msg: fmt.Sprintf("duplicate - already used by %s", other)})
+		} else {
+			roles[*r.Value] = fmt.Sprintf("CustomRoles.%d", i)
+		}
+		if r.Role == nil || *r.Role == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("CustomRoles.%d.Role", i), Msg: "required for a custom role"})
+		} else if verr := sessions.ValidateRoleName(*r.Role); verr != nil {
+			err = multierr.Append(err, ErrInvalid{Name: fmt.Sprintf("CustomRoles.%d.Role", i), Value: *r.Role, Msg: verr.Error()})
+		}
+	}
+	if len(roles) == 0 {
+		err = multierr.Append(err, ErrMissing{Name: "AdminRole", Msg: "at least one role must be mapped when OIDC is enabled"})
+	}
+	return
+}
+
 type WebServerRateLimit struct {
 	Authenticated         *int64
 	AuthenticatedPeriod   *models.Duration
@@ -577,7 +727,8 @@ type JobPipeline struct {
 	ReaperThreshold           *models.Duration
 	ResultWriteQueueDepth     *uint32
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest    JobPipelineHTTPRequest    `toml:",omitempty"`
+	CircuitBreaker JobPipelineCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -600,12 +751,14 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.ResultWriteQueueDepth = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.CircuitBreaker.setFrom(&f.CircuitBreaker)
 
 }
 
 type JobPipelineHTTPRequest struct {
-	DefaultTimeout *models.Duration
-	MaxSize        *utils.FileSize
+	DefaultTimeout    *models.Duration
+	MaxSize           *utils.FileSize
+	HedgingPercentile *uint16
 }
 
 func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
@@ -615,6 +768,47 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	if v := f.MaxSize; v != nil {
 		j.MaxSize = v
 	}
+	if v := f.HedgingPercentile; v != nil {
+		j.HedgingPercentile = v
+	}
+}
+
+func (j *JobPipelineHTTPRequest) ValidateConfig() (err error) {
+	if j.HedgingPercentile != nil && *j.HedgingPercentile > 100 {
+		err = multierr.Append(err, ErrInvalid{Name: "HedgingPercentile", Value: *j.HedgingPercentile, Msg: "must be between 0 and 100"})
+	}
+	return
+}
+
+type JobPipelineCircuitBreaker struct {
+	Enabled          *bool
+	FailureThreshold *uint32
+	OpenTimeout      *models.Duration
+}
+
+func (j *JobPipelineCircuitBreaker) setFrom(f *JobPipelineCircuitBreaker) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		j.OpenTimeout = v
+	}
+}
+
+func (j *JobPipelineCircuitBreaker) ValidateConfig() (err error) {
+	if j.Enabled == nil || !*j.Enabled {
+		return
+	}
+	if j.FailureThreshold != nil && *j.FailureThreshold == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "FailureThreshold", Value: *j.FailureThreshold, Msg: "must be greater than zero"})
+	}
+	if j.OpenTimeout != nil && j.OpenTimeout.Duration() <= 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "OpenTimeout", Value: j.OpenTimeout.String(), Msg: "must be greater than zero"})
+	}
+	return
 }
 
 type FluxMonitor struct {
@@ -1000,6 +1194,74 @@ func (s *Sentry) setFrom(f *Sentry) {
 	}
 }
 
+type Tracing struct {
+	Enabled         *bool
+	CollectorTarget *string
+	SamplingRatio   *decimal.Decimal
+	TLSCertPath     *string
+}
+
+func (t *Tracing) setFrom(f *Tracing) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.CollectorTarget; v != nil {
+		t.CollectorTarget = v
+	}
+	if v := f.SamplingRatio; v != nil {
+		t.SamplingRatio = v
+	}
+	if v := f.TLSCertPath; v != nil {
+		t.TLSCertPath = v
+	}
+}
+
+func (t *Tracing) ValidateConfig() (err error) {
+	if t.SamplingRatio != nil {
+		if t.SamplingRatio.IsNegative() || t.SamplingRatio.GreaterThan(decimal.NewFromInt(1)) {
+			err = multierr.Append(err, ErrInvalid{Name: "SamplingRatio", Value: *t.SamplingRatio, Msg: "must be between 0 and 1"})
+		}
+	}
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+	if t.CollectorTarget == nil || *t.CollectorTarget == "" {
+		err = multierr.Append(err, ErrMissing{Name: "CollectorTarget", Msg: "required when tracing is enabled"})
+	}
+	return
+}
+
+type RemoteSigner struct {
+	Enabled *bool
+	URL     *models.URL
+	Timeout *models.Duration
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+	if r.URL == nil || r.URL.IsZero() {
+		err = multierr.Append(err, ErrMissing{Name: "URL", Msg: "required when the remote signer is enabled"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "Timeout", Value: *r.Timeout, Msg: "must be positive"})
+	}
+	return
+}
+
 type Insecure struct {
 	DevWebServer         *bool
 	OCRDevelopmentMode   *bool
@@ -18,6 +18,14 @@ type Web interface {
 	CertFile() string
 	HTTPServerWriteTimeout() time.Duration
 	KeyFile() string
+	OIDCEnabled() bool
+	OIDCIssuerURL() *url.URL
+	OIDCClientID() string
+	OIDCClientSecret() string
+	OIDCRedirectURL() *url.URL
+	OIDCRolesClaim() string
+	// OIDCRoles maps the values of the OIDCRolesClaim to the names of the roles they grant.
+	OIDCRoles() map[string]string
 	Port() uint16
 	RPID() string
 	RPOrigin() string
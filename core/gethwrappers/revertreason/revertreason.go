@@ -0,0 +1,205 @@
+// Package revertreason decodes the revert data of failed calls and
+// transactions, including the custom errors of the contracts we have wrappers
+// for.
+package revertreason
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registrar_wrapper1_2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registrar_wrapper2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_logic1_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_logic2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper1_2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper1_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/llo_feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/mercury_verifier"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/mercury_verifier_proxy"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/ocr2dr_oracle"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/ocr2dr_registry"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/upkeep_transcoder"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrfv2_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ocr2vrf/generated/dkg"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ocr2vrf/generated/vrf_beacon"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ocr2vrf/generated/vrf_coordinator"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ocr2vrf/generated/vrf_router"
+)
+
+const (
+	// NameError is the name of reasons given to require or revert as a string.
+	NameError = "Error"
+	// NamePanic is the name of reasons for failed assertions, arithmetic
+	// errors and the like.
+	NamePanic = "Panic"
+	// NameUnknown is the name of reasons that could not be decoded.
+	NameUnknown = "unknown"
+)
+
+// contracts are the wrappers whose custom errors are decoded.
+var contracts = []*bind.MetaData{
+	dkg.DKGMetaData,
+	keeper_registrar_wrapper1_2.KeeperRegistrarMetaData,
+	keeper_registrar_wrapper2_0.KeeperRegistrarMetaData,
+	keeper_registry_logic1_3.KeeperRegistryLogicMetaData,
+	keeper_registry_logic2_0.KeeperRegistryLogicMetaData,
+	keeper_registry_wrapper1_2.KeeperRegistryMetaData,
+	keeper_registry_wrapper1_3.KeeperRegistryMetaData,
+	keeper_registry_wrapper2_0.KeeperRegistryMetaData,
+	llo_feeds.LLOVerifierProxyMetaData,
+	mercury_verifier.MercuryVerifierMetaData,
+	mercury_verifier_proxy.MercuryVerifierProxyMetaData,
+	ocr2dr_oracle.OCR2DROracleMetaData,
+	ocr2dr_registry.OCR2DRRegistryMetaData,
+	upkeep_transcoder.UpkeepTranscoderMetaData,
+	vrf_beacon.VRFBeaconMetaData,
+	vrf_coordinator.VRFCoordinatorMetaData,
+	vrf_coordinator_v2.VRFCoordinatorV2MetaData,
+	vrf_router.VRFRouterMetaData,
+	vrfv2_wrapper.VRFV2WrapperMetaData,
+}
+
+var (
+	errorSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector = [4]byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+
+	stringArgs  = mustArguments("string")
+	uint256Args = mustArguments("uint256")
+
+	customErrorsOnce sync.Once
+	customErrors     map[[4]byte]abi.Error
+)
+
+// panicCodes describe the codes of Panic(uint256), see
+// https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicCodes = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to invalid internal function",
+}
+
+// Reason is why a call reverted.
+type Reason struct {
+	// Name identifies the kind of error: NameError, NamePanic, the name of a
+	// custom error, or NameUnknown.
+	Name string
+	// Message describes the error with its arguments, e.g.
+	// InsufficientBalance() or Error("not enough LINK").
+	Message string
+}
+
+func (r Reason) String() string {
+	return r.Message
+}
+
+// Decode decodes revert data, which is the 4 byte selector of the error
+// followed by its ABI encoded arguments.
+func Decode(data []byte) Reason {
+	if len(data) < 4 {
+		if len(data) == 0 {
+			return Reason{Name: NameUnknown, Message: "reverted without a reason"}
+		}
+		return unknown(data)
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	args := data[4:]
+
+	switch selector {
+	case errorSelector:
+		vals, err := stringArgs.Unpack(args)
+		if err != nil {
+			return unknown(data)
+		}
+		return Reason{Name: NameError, Message: fmt.Sprintf("Error(%q)", vals[0])}
+	case panicSelector:
+		vals, err := uint256Args.Unpack(args)
+		if err != nil {
+			return unknown(data)
+		}
+		code := vals[0].(*big.Int)
+		msg := fmt.Sprintf("Panic(0x%x)", code)
+		if desc, ok := panicCodes[code.Uint64()]; code.IsUint64() && ok {
+			msg += ": " + desc
+		}
+		return Reason{Name: NamePanic, Message: msg}
+	}
+
+	customErrorsOnce.Do(loadCustomErrors)
+	abiErr, ok := customErrors[selector]
+	if !ok {
+		return unknown(data)
+	}
+	vals, err := abiErr.Inputs.Unpack(args)
+	if err != nil {
+		return unknown(data)
+	}
+	fields := make([]string, len(vals))
+	for i, v := range vals {
+		if name := abiErr.Inputs[i].Name; name != "" {
+			fields[i] = fmt.Sprintf("%s=%v", name, formatArg(v))
+		} else {
+			fields[i] = fmt.Sprint(formatArg(v))
+		}
+	}
+	return Reason{Name: abiErr.Name, Message: fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(fields, ", "))}
+}
+
+func unknown(data []byte) Reason {
+	return Reason{Name: NameUnknown, Message: fmt.Sprintf("unknown error %s", hexutil.Encode(data))}
+}
+
+func formatArg(v interface{}) interface{} {
+	switch t := v.(type) {
+	case []byte:
+		return hexutil.Encode(t)
+	case [32]byte:
+		return hexutil.Encode(t[:])
+	case string:
+		return fmt.Sprintf("%q", t)
+	}
+	return v
+}
+
+func loadCustomErrors() {
+	customErrors = make(map[[4]byte]abi.Error)
+	for _, md := range contracts {
+		parsed, err := md.GetAbi()
+		if err != nil {
+			// The ABIs of generated wrappers are always valid
+			panic(err)
+		}
+		for _, abiErr := range parsed.Errors {
+			var selector [4]byte
+			copy(selector[:], abiErr.ID[:4])
+			customErrors[selector] = abiErr
+		}
+	}
+}
+
+func mustArguments(types ...string) abi.Arguments {
+	var args abi.Arguments
+	for _, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args
+}
@@ -0,0 +1,63 @@
+package revertreason_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/revertreason"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	coordinatorABI, err := vrf_coordinator_v2.VRFCoordinatorV2MetaData.GetAbi()
+	require.NoError(t, err)
+	customError := func(name string, args ...interface{}) []byte {
+		abiErr := coordinatorABI.Errors[name]
+		packed, err := abiErr.Inputs.Pack(args...)
+		require.NoError(t, err)
+		return utils.ConcatBytes(abiErr.ID[:4], packed)
+	}
+	builtinError := func(sig string, typ string, arg interface{}) []byte {
+		packed, err := utils.ABIEncode(`[{"type":"`+typ+`"}]`, arg)
+		require.NoError(t, err)
+		selector := utils.Keccak256Fixed([]byte(sig))
+		return utils.ConcatBytes(selector[:4], packed)
+	}
+
+	consumer := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	tests := []struct {
+		name     string
+		data     []byte
+		wantName string
+		wantMsg  string
+	}{
+		{"error string", builtinError("Error(string)", "string", "not enough LINK"), revertreason.NameError, `Error("not enough LINK")`},
+		{"panic", builtinError("Panic(uint256)", "uint256", big.NewInt(0x11)), revertreason.NamePanic, "Panic(0x11): arithmetic underflow or overflow"},
+		{"panic with unknown code", builtinError("Panic(uint256)", "uint256", big.NewInt(0x99)), revertreason.NamePanic, "Panic(0x99)"},
+		{"custom error", customError("InsufficientBalance"), "InsufficientBalance", "InsufficientBalance()"},
+		{"custom error with arguments", customError("InvalidConsumer", uint64(42), consumer), "InvalidConsumer", "InvalidConsumer(subId=42, consumer=" + consumer.Hex() + ")"},
+		{"unknown custom error", hexutil.MustDecode("0xdeadbeef"), revertreason.NameUnknown, "unknown error 0xdeadbeef"},
+		{"malformed error string", hexutil.MustDecode("0x08c379a0ff"), revertreason.NameUnknown, "unknown error 0x08c379a0ff"},
+		{"too short", hexutil.MustDecode("0x01"), revertreason.NameUnknown, "unknown error 0x01"},
+		{"empty", nil, revertreason.NameUnknown, "reverted without a reason"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := revertreason.Decode(test.data)
+			assert.Equal(t, test.wantName, reason.Name)
+			assert.Equal(t, test.wantMsg, reason.Message)
+			assert.Equal(t, test.wantMsg, reason.String())
+		})
+	}
+}
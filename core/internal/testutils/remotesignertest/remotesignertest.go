@@ -0,0 +1,92 @@
+package remotesignertest
+
+import (
+	"crypto/ecdsa"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+// NewServer starts a stand-in for Web3Signer that serves eth_signTransaction
+// with the given keys, and returns its URL.
+func NewServer(t *testing.T, keys ...*ecdsa.PrivateKey) *url.URL {
+	svc := &signerService{keys: make(map[common.Address]*ecdsa.PrivateKey)}
+	for _, k := range keys {
+		svc.keys[crypto.PubkeyToAddress(k.PublicKey)] = k
+	}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", svc))
+	ts := httptest.NewServer(server)
+	t.Cleanup(func() {
+		ts.Close()
+		server.Stop()
+	})
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return u
+}
+
+type signerService struct {
+	keys map[common.Address]*ecdsa.PrivateKey
+}
+
+// SignTransaction serves eth_signTransaction.
+func (s *signerService) SignTransaction(args remotesigner.SignTxArgs) (hexutil.Bytes, error) {
+	key, ok := s.keys[args.From]
+	if !ok {
+		return nil, errors.Errorf("unknown account %s", args.From)
+	}
+	var data types.TxData
+	switch {
+	case args.MaxFeePerGas != nil:
+		tx := &types.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		}
+		if args.AccessList != nil {
+			tx.AccessList = *args.AccessList
+		}
+		data = tx
+	case args.AccessList != nil:
+		data = &types.AccessListTx{
+			ChainID:    args.ChainID.ToInt(),
+			Nonce:      uint64(args.Nonce),
+			GasPrice:   args.GasPrice.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      args.Value.ToInt(),
+			Data:       args.Data,
+			AccessList: *args.AccessList,
+		}
+	default:
+		data = &types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		}
+	}
+	signed, err := types.SignNewTx(key, types.LatestSignerForChainID(args.ChainID.ToInt()), data)
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
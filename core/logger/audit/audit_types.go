@@ -9,6 +9,8 @@ const (
 	AuthLoginFailed2FA      EventID = "AUTH_LOGIN_FAILED_2FA"
 	AuthLoginSuccessWith2FA EventID = "AUTH_LOGIN_SUCCESS_WITH_2FA"
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
+	AuthLoginFailedSSO      EventID = "AUTH_LOGIN_FAILED_SSO"
+	AuthLoginSuccessSSO     EventID = "AUTH_LOGIN_SUCCESS_SSO"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
 
@@ -19,6 +21,11 @@ const (
 	APITokenCreated                       EventID = "API_TOKEN_CREATED"
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
+	APITokenRevoked                       EventID = "API_TOKEN_REVOKED"
+
+	RoleCreated EventID = "ROLE_CREATED"
+	RoleUpdated EventID = "ROLE_UPDATED"
+	RoleDeleted EventID = "ROLE_DELETED"
 
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
@@ -48,6 +55,9 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeyRotated           EventID = "KEY_ROTATED"
+	KeyRotationConfirmed EventID = "KEY_ROTATION_CONFIRMED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
@@ -4,9 +4,14 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"net/url"
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 )
 
@@ -14,46 +19,61 @@ import (
 //
 // Usage without TLS:
 //
-//	go run run_connector.go --url ws://localhost:8081/node
+//	go run run_connector.go --url ws://localhost:8081/node --gateway_id example_gateway --don_id example --private_key <hex>
 //
 // Usage with TLS:
 //
-//	go run run_connector.go --url wss://localhost:8089/node
-type initiator struct {
+//	go run run_connector.go --url wss://localhost:8089/node --gateway_id example_gateway --don_id example --private_key <hex>
+type logHandler struct {
+	lggr logger.Logger
 }
 
-func (*initiator) NewAuthHeader(url *url.URL) []byte {
-	fmt.Println("generating new auth header:", url.String())
-	return []byte{}
-}
+func (h *logHandler) Start(context.Context) error { return nil }
+func (h *logHandler) Close() error                { return nil }
 
-func (*initiator) ChallengeResponse(challenge []byte) ([]byte, error) {
-	fmt.Println("generating challenge response:", string(challenge))
-	return []byte{}, nil
+func (h *logHandler) HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message) {
+	h.lggr.Infow("received message", "gatewayId", gatewayId, "messageId", msg.Body.MessageId, "method", msg.Body.Method)
 }
 
 func main() {
 	urlStr := flag.String("url", "", "Gateway URL")
+	gatewayId := flag.String("gateway_id", "", "Gateway ID")
+	donId := flag.String("don_id", "", "DON ID")
+	privateKey := flag.String("private_key", "", "Node private key in hex")
+	method := flag.String("method", "add_ints", "Method to log messages of")
 	flag.Parse()
-	url, err := url.Parse(*urlStr)
+
+	key, err := crypto.HexToECDSA(*privateKey)
 	if err != nil {
-		fmt.Println("error parsing url:", err)
+		fmt.Println("error parsing private key:", err)
+		return
 	}
-
 	lggr, _ := logger.NewLogger()
 
-	config := network.WebSocketClientConfig{
-		HandshakeTimeoutMillis: 1000,
+	config := &connector.ConnectorConfig{
+		NodeAddress:    crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		DonId:          *donId,
+		Gateways:       []connector.ConnectorGatewayConfig{{Id: *gatewayId, URL: *urlStr}},
+		WsClientConfig: network.WebSocketClientConfig{HandshakeTimeoutMillis: 1000},
 	}
-	client := network.NewWebSocketClient(config, &initiator{}, lggr)
-	conn, err := client.Connect(context.Background(), url)
-	if err != nil || conn == nil {
-		fmt.Println("connection error:", err)
+	conn, err := connector.NewGatewayConnector(config, connector.NewPrivateKeySigner(key), lggr)
+	if err != nil {
+		fmt.Println("error creating connector:", err)
+		return
+	}
+	if err = conn.AddHandler([]string{*method}, &logHandler{lggr: lggr}); err != nil {
+		fmt.Println("error adding handler:", err)
 		return
 	}
 
-	fmt.Println("connected successfully!")
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	if err = conn.Start(ctx); err != nil {
+		fmt.Println("error starting connector:", err)
+		return
+	}
+	<-ctx.Done()
 	if err = conn.Close(); err != nil {
-		fmt.Println("error closing connection", err)
+		fmt.Println("error closing connector:", err)
 	}
 }
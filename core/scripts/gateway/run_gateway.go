@@ -7,10 +7,13 @@ import (
 	"os"
 	"os/signal"
 
+	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/pelletier/go-toml/v2"
+	"github.com/smartcontractkit/sqlx"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 // Script to run Gateway outside of the core node. It works only with simple handlers.
@@ -28,8 +31,14 @@ import (
 //	go run run_gateway.go --config sample_config_tls.toml
 //
 //	curl -X POST -d  '{"jsonrpc":"2.0","method":"test","id":"abcd","params":{"body":{"don_id":"wrong"}}}' https://localhost:8088/user -k
+//
+// Requests are persisted, and replayed messages rejected, with a database
+// migrated by a Chainlink node:
+//
+//	go run run_gateway.go --config sample_config.toml --database_url postgresql://localhost:5432/chainlink
 func main() {
 	configFile := flag.String("config", "", "Path to TOML config file")
+	databaseURL := flag.String("database_url", "", "Optional Postgres URL of the message store")
 	flag.Parse()
 
 	rawConfig, err := os.ReadFile(*configFile)
@@ -47,7 +56,18 @@ func main() {
 
 	lggr, _ := logger.NewLogger()
 
-	gw, err := gateway.NewGatewayFromConfig(&cfg, lggr)
+	var store gateway.MessageStore
+	if *databaseURL != "" {
+		db, err2 := sqlx.Open("pgx", *databaseURL)
+		if err2 != nil {
+			fmt.Println("error opening database:", err2)
+			return
+		}
+		defer db.Close()
+		store = gateway.NewMessageStore(db, lggr, pg.NewQConfig(false))
+	}
+
+	gw, err := gateway.NewGatewayFromConfig(&cfg, gateway.NewHandlerFactory(lggr), store, lggr)
 	if err != nil {
 		fmt.Println("error creating Gateway object:", err)
 		return
@@ -14,8 +14,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -26,6 +28,7 @@ var _ BHS = &BulletproofBHS{}
 type bpBHSConfig interface {
 	EvmGasLimitDefault() uint32
 	DatabaseDefaultQueryTimeout() time.Duration
+	EvmTxPriority(jobType string) txmgrtypes.TxPriority
 }
 
 // BulletproofBHS is an implementation of BHS that writes "store" transactions to a bulletproof
@@ -87,7 +90,10 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 
 		// Set a queue size of 256. At most we store the blockhash of every block, and only the
 		// latest 256 can possibly be stored.
-		Strategy: txmgr.NewQueueingTxStrategy(c.jobID, 256, c.config.DatabaseDefaultQueryTimeout()),
+		Strategy: txmgr.NewPriorityTxStrategy(
+			txmgr.NewQueueingTxStrategy(c.jobID, 256, c.config.DatabaseDefaultQueryTimeout()),
+			c.config.EvmTxPriority(string(job.BlockhashStore)),
+		),
 	}, pg.WithParentCtx(ctx))
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -132,7 +138,7 @@ func (c *BulletproofBHS) StoreEarliest(ctx context.Context) error {
 		ToAddress:      c.bhs.Address(),
 		EncodedPayload: payload,
 		FeeLimit:       c.config.EvmGasLimitDefault(),
-		Strategy:       txmgr.NewSendEveryStrategy(),
+		Strategy:       txmgr.NewPriorityTxStrategy(txmgr.NewSendEveryStrategy(), c.config.EvmTxPriority(string(job.BlockhashStore))),
 	}, pg.WithParentCtx(ctx))
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
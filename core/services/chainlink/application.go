@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/pyroscope-io/client/pyroscope"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
@@ -53,6 +54,7 @@ import (
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/v2/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -94,6 +96,8 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 runs the pipeline of an unsaved job without side effects, using the given jobRun variables.
+	SimulateJobV2(ctx context.Context, jb job.Job, jobRun map[string]interface{}) (pipeline.Run, []pipeline.TaskRunTrace, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -140,6 +144,7 @@ type ChainlinkApplication struct {
 	sqlxDB                   *sqlx.DB
 	secretGenerator          SecretGenerator
 	profiler                 *pyroscope.Profiler
+	tracerProvider           *sdktrace.TracerProvider
 	loopRegistry             *plugins.LoopRegistry
 
 	started     bool
@@ -233,6 +238,18 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is disabled")
 	}
 
+	var tracerProvider *sdktrace.TracerProvider
+	if cfg.TracingEnabled() {
+		globalLogger.Infow("Tracing is enabled", "collectorTarget", cfg.TracingCollectorTarget())
+		var err error
+		tracerProvider, err = tracing.Start(context.Background(), cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "starting tracing failed")
+		}
+	} else {
+		globalLogger.Debug("Tracing is disabled")
+	}
+
 	var nurse *services.Nurse
 	if cfg.AutoPprofEnabled() {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
@@ -288,6 +305,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set Database.Backup.Mode=lite or Database.Backup.Mode=full")
 	}
 
+	if interval := cfg.SecretsRefreshInterval(); interval > 0 {
+		globalLogger.Infow("Secrets: references will be refreshed periodically", "interval", interval)
+		srvcs = append(srvcs, newSecretsRefresher(cfg, globalLogger))
+	}
+
 	srvcs = append(srvcs, eventBroadcaster, mailMon)
 	srvcs = append(srvcs, chains.services()...)
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
@@ -502,6 +524,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		closeLogger:              opts.CloseLogger,
 		secretGenerator:          opts.SecretGenerator,
 		profiler:                 profiler,
+		tracerProvider:           tracerProvider,
 		loopRegistry:             loopRegistry,
 
 		sqlxDB: opts.SqlxDB,
@@ -640,6 +663,11 @@ func (app *ChainlinkApplication) stop() (err error) {
 			err = multierr.Append(err, app.profiler.Stop())
 		}
 
+		if app.tracerProvider != nil {
+			app.logger.Debug("Flushing traces...")
+			err = multierr.Append(err, app.tracerProvider.Shutdown(context.Background()))
+		}
+
 		app.logger.Info("Exited all services")
 
 		app.started = false
@@ -802,6 +830,41 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 executes the pipeline of jb in-memory without saving the run,
+// sending transactions or caching bridge responses. jobRun is made available to
+// the pipeline as the $(jobRun) variable, so that callers can provide the inputs
+// that the job's trigger would.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	jobRun map[string]interface{},
+) (pipeline.Run, []pipeline.TaskRunTrace, error) {
+	if jb.Pipeline.Source == "" {
+		return pipeline.Run{}, nil, errors.Errorf("%s jobs without an observation source cannot be simulated", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+		ForwardingAllowed: jb.ForwardingAllowed,
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	if jobRun == nil {
+		jobRun = map[string]interface{}{}
+	}
+	vars := map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		},
+		"jobRun": jobRun,
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -1,19 +1,23 @@
 package chainlink
 
 import (
-	"fmt"
-
+	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/pelletier/go-toml/v2"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/cosmos"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/starknet"
 
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/solana"
+	"github.com/smartcontractkit/chainlink/v2/core/config/secretref"
 	config "github.com/smartcontractkit/chainlink/v2/core/config/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Config is the root type used for TOML configuration.
@@ -178,5 +182,92 @@ func (s *Secrets) setEnv() error {
 	if prometheusAuthToken := config.EnvPrometheusAuthToken.Get(); prometheusAuthToken != "" {
 		s.Prometheus.AuthToken = &prometheusAuthToken
 	}
+	if oidcClientSecret := config.EnvWebServerOIDCClientSecret.Get(); oidcClientSecret != "" {
+		s.WebServer.OIDC.ClientSecret = &oidcClientSecret
+	}
+	if vaultToken := config.EnvReferencesVaultToken.Get(); vaultToken != "" {
+		s.References.Vault.Token = &vaultToken
+	}
 	return nil
 }
+
+// resolveReferences returns a copy of s with every secret which is a file:// or vault:// reference replaced by the
+// secret it refers to. The Vault token itself may only be a file:// reference.
+func (s *Secrets) resolveReferences(ctx context.Context) (*Secrets, error) {
+	r := *s
+	var err error
+	if t := s.References.Vault.Token; t != nil && strings.HasPrefix(string(*t), secretref.SchemeVault+"://") {
+		return nil, fmt.Errorf("%w: References.Vault.Token: must not be a vault:// reference", ErrInvalidSecrets)
+	}
+	r.References.Vault.Token, err = resolveSecret(ctx, new(secretref.Resolver), "References.Vault.Token", s.References.Vault.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSecrets, err)
+	}
+	var vaultToken string
+	if t := r.References.Vault.Token; t != nil {
+		vaultToken = string(*t)
+	}
+	resolver := secretref.NewResolver(s.References.Vault.URL.URL(), vaultToken)
+
+	resolve := func(name string, secret **models.Secret) {
+		v, rerr := resolveSecret(ctx, resolver, name, *secret)
+		err = multierr.Append(err, rerr)
+		*secret = v
+	}
+	resolveURL := func(name string, secret **models.SecretURL) {
+		v, rerr := resolveSecretURL(ctx, resolver, name, *secret)
+		err = multierr.Append(err, rerr)
+		*secret = v
+	}
+	resolveURL("Database.URL", &r.Database.URL)
+	resolveURL("Database.BackupURL", &r.Database.BackupURL)
+	resolve("Explorer.AccessKey", &r.Explorer.AccessKey)
+	resolve("Explorer.Secret", &r.Explorer.Secret)
+	resolve("Password.Keystore", &r.Password.Keystore)
+	resolve("Password.VRF", &r.Password.VRF)
+	resolve("Pyroscope.AuthToken", &r.Pyroscope.AuthToken)
+	resolve("Prometheus.AuthToken", &r.Prometheus.AuthToken)
+	resolve("WebServer.OIDC.ClientSecret", &r.WebServer.OIDC.ClientSecret)
+	if s.Mercury.Credentials != nil {
+		r.Mercury.Credentials = make(map[string]config.MercuryCredentials, len(s.Mercury.Credentials))
+		for name, creds := range s.Mercury.Credentials {
+			prefix := "Mercury.Credentials." + name + "."
+			resolveURL(prefix+"URL", &creds.URL)
+			resolve(prefix+"Username", &creds.Username)
+			resolve(prefix+"Password", &creds.Password)
+			r.Mercury.Credentials[name] = creds
+		}
+	}
+	if err != nil {
+		_, errList := utils.MultiErrorList(err)
+		return nil, fmt.Errorf("%w: failed to resolve references: %s", ErrInvalidSecrets, errList)
+	}
+	return &r, nil
+}
+
+func resolveSecret(ctx context.Context, r *secretref.Resolver, name string, secret *models.Secret) (*models.Secret, error) {
+	if secret == nil || !secretref.IsReference(string(*secret)) {
+		return secret, nil
+	}
+	v, err := r.Resolve(ctx, string(*secret))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return models.NewSecret(v), nil
+}
+
+func resolveSecretURL(ctx context.Context, r *secretref.Resolver, name string, secret *models.SecretURL) (*models.SecretURL, error) {
+	if secret == nil || !secretref.IsReference(secret.URL().String()) {
+		return secret, nil
+	}
+	v, err := r.Resolve(ctx, secret.URL().String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	u := new(models.SecretURL)
+	if err = u.UnmarshalText([]byte(v)); err != nil {
+		// The error may contain the secret URL, so it is not wrapped.
+		return nil, fmt.Errorf("%s: referenced secret is not a valid URL", name)
+	}
+	return u, nil
+}
@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"math/big"
@@ -44,8 +45,9 @@ type generalConfig struct {
 	effectiveTOML string // with default values included
 	secretsTOML   string // with env overdies includes, redacted
 
-	c       *Config // all fields non-nil (unless the legacy method signature return a pointer)
-	secrets *Secrets
+	c          *Config  // all fields non-nil (unless the legacy method signature return a pointer)
+	secrets    *Secrets // with references resolved
+	rawSecrets *Secrets // with references unresolved, for RefreshSecrets
 
 	logLevelDefault zapcore.Level
 
@@ -53,7 +55,7 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
-	passwordMu sync.RWMutex // passwords are set after initialization
+	secretsMu sync.RWMutex // secrets are refreshed, and passwords are set, after initialization
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -133,12 +135,18 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		return nil, err
 	}
 
+	resolved, err := o.Secrets.resolveReferences(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &generalConfig{
 		inputTOML:     input,
 		effectiveTOML: effective,
 		secretsTOML:   secrets,
 		c:             &o.Config,
-		secrets:       &o.Secrets,
+		secrets:       resolved,
+		rawSecrets:    &o.Secrets,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -164,6 +172,8 @@ func (g *generalConfig) StarknetConfigs() starknet.StarknetConfigs {
 }
 
 func (g *generalConfig) Validate() error {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return g.validate(g.secrets.Validate)
 }
 
@@ -179,6 +189,8 @@ func (g *generalConfig) validate(secretsValidationFn func() error) error {
 }
 
 func (g *generalConfig) ValidateDB() error {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return g.validate(g.secrets.ValidateDB)
 }
 
@@ -545,6 +557,22 @@ func (g *generalConfig) JSONConsole() bool {
 	return *g.c.Log.JSONConsole
 }
 
+func (g *generalConfig) JobPipelineHTTPHedgingPercentile() uint16 {
+	return *g.c.JobPipeline.HTTPRequest.HedgingPercentile
+}
+
+func (g *generalConfig) JobPipelineCircuitBreakerEnabled() bool {
+	return *g.c.JobPipeline.CircuitBreaker.Enabled
+}
+
+func (g *generalConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	return *g.c.JobPipeline.CircuitBreaker.FailureThreshold
+}
+
+func (g *generalConfig) JobPipelineCircuitBreakerOpenTimeout() time.Duration {
+	return g.c.JobPipeline.CircuitBreaker.OpenTimeout.Duration()
+}
+
 func (g *generalConfig) JobPipelineMaxRunDuration() time.Duration {
 	return g.c.JobPipeline.MaxRunDuration.Duration()
 }
@@ -877,6 +905,52 @@ func (g *generalConfig) Port() uint16 {
 	return *g.c.WebServer.HTTPPort
 }
 
+func (g *generalConfig) OIDCEnabled() bool {
+	return *g.c.WebServer.OIDC.Enabled
+}
+
+func (g *generalConfig) OIDCIssuerURL() *url.URL {
+	if g.c.WebServer.OIDC.IssuerURL.IsZero() {
+		return nil
+	}
+	return g.c.WebServer.OIDC.IssuerURL.URL()
+}
+
+func (g *generalConfig) OIDCClientID() string {
+	return *g.c.WebServer.OIDC.ClientID
+}
+
+func (g *generalConfig) OIDCRedirectURL() *url.URL {
+	if g.c.WebServer.OIDC.RedirectURL.IsZero() {
+		return nil
+	}
+	return g.c.WebServer.OIDC.RedirectURL.URL()
+}
+
+func (g *generalConfig) OIDCRolesClaim() string {
+	return *g.c.WebServer.OIDC.RolesClaim
+}
+
+func (g *generalConfig) OIDCRoles() map[string]string {
+	roles := make(map[string]string)
+	for role, value := range map[string]*string{
+		"admin": g.c.WebServer.OIDC.AdminRole,
+		"edit":  g.c.WebServer.OIDC.EditRole,
+		"run":   g.c.WebServer.OIDC.RunRole,
+		"view":  g.c.WebServer.OIDC.ViewRole,
+	} {
+		if value != nil && *value != "" {
+			roles[*value] = role
+		}
+	}
+	for _, r := range g.c.WebServer.OIDC.CustomRoles {
+		if r.Value != nil && r.Role != nil {
+			roles[*r.Value] = *r.Role
+		}
+	}
+	return roles
+}
+
 func (g *generalConfig) RPID() string {
 	return *g.c.WebServer.MFA.RPID
 }
@@ -931,6 +1005,37 @@ func (g *generalConfig) SentryRelease() string {
 	return *g.c.Sentry.Release
 }
 
+func (g *generalConfig) TracingEnabled() bool {
+	return *g.c.Tracing.Enabled
+}
+
+func (g *generalConfig) TracingCollectorTarget() string {
+	return *g.c.Tracing.CollectorTarget
+}
+
+func (g *generalConfig) TracingSamplingRatio() float64 {
+	return g.c.Tracing.SamplingRatio.InexactFloat64()
+}
+
+func (g *generalConfig) TracingTLSCertPath() string {
+	return *g.c.Tracing.TLSCertPath
+}
+
+func (g *generalConfig) RemoteSignerEnabled() bool {
+	return *g.c.RemoteSigner.Enabled
+}
+
+func (g *generalConfig) RemoteSignerURL() *url.URL {
+	if g.c.RemoteSigner.URL.IsZero() {
+		return nil
+	}
+	return g.c.RemoteSigner.URL.URL()
+}
+
+func (g *generalConfig) RemoteSignerTimeout() time.Duration {
+	return g.c.RemoteSigner.Timeout.Duration()
+}
+
 func (g *generalConfig) TLSCertPath() string {
 	return *g.c.WebServer.TLS.CertPath
 }
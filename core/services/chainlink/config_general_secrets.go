@@ -1,12 +1,16 @@
 package chainlink
 
 import (
+	"context"
 	"net/url"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/models"
 )
 
 func (g *generalConfig) DatabaseURL() url.URL {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Database.URL == nil {
 		return url.URL{}
 	}
@@ -14,10 +18,14 @@ func (g *generalConfig) DatabaseURL() url.URL {
 }
 
 func (g *generalConfig) DatabaseBackupURL() *url.URL {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	return g.secrets.Database.BackupURL.URL()
 }
 
 func (g *generalConfig) ExplorerAccessKey() string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Explorer.AccessKey == nil {
 		return ""
 	}
@@ -25,12 +33,16 @@ func (g *generalConfig) ExplorerAccessKey() string {
 }
 
 func (g *generalConfig) ExplorerSecret() string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Explorer.Secret == nil {
 		return ""
 	}
 	return string(*g.secrets.Explorer.Secret)
 }
 func (g *generalConfig) PyroscopeAuthToken() string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Pyroscope.AuthToken == nil {
 		return ""
 	}
@@ -38,13 +50,26 @@ func (g *generalConfig) PyroscopeAuthToken() string {
 }
 
 func (g *generalConfig) PrometheusAuthToken() string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Prometheus.AuthToken == nil {
 		return ""
 	}
 	return string(*g.secrets.Prometheus.AuthToken)
 }
 
+func (g *generalConfig) OIDCClientSecret() string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	if g.secrets.WebServer.OIDC.ClientSecret == nil {
+		return ""
+	}
+	return string(*g.secrets.WebServer.OIDC.ClientSecret)
+}
+
 func (g *generalConfig) MercuryCredentials(credName string) *models.MercuryCredentials {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if mc, ok := g.secrets.Mercury.Credentials[credName]; ok {
 		return &models.MercuryCredentials{
 			URL:      mc.URL.URL().String(),
@@ -54,3 +79,37 @@ func (g *generalConfig) MercuryCredentials(credName string) *models.MercuryCrede
 	}
 	return nil
 }
+
+func (g *generalConfig) SecretsRefreshInterval() time.Duration {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	if g.rawSecrets.References.RefreshInterval == nil {
+		return 0
+	}
+	return g.rawSecrets.References.RefreshInterval.Duration()
+}
+
+// RefreshSecrets resolves the file:// and vault:// references of the secrets again. The previous secrets are kept if
+// any reference fails to resolve, or if the new secrets are invalid.
+func (g *generalConfig) RefreshSecrets(ctx context.Context) error {
+	g.secretsMu.RLock()
+	raw := *g.rawSecrets
+	g.secretsMu.RUnlock()
+
+	resolved, err := raw.resolveReferences(ctx)
+	if err != nil {
+		return err
+	}
+	if err = resolved.Validate(); err != nil {
+		return err
+	}
+
+	g.secretsMu.Lock()
+	defer g.secretsMu.Unlock()
+	// Passwords set by SetPasswords in the meantime take precedence.
+	if g.rawSecrets.Password != raw.Password {
+		resolved.Password = g.secrets.Password
+	}
+	g.secrets = resolved
+	return nil
+}
@@ -50,19 +50,22 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 }
 
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
-	g.passwordMu.Lock()
-	defer g.passwordMu.Unlock()
+	g.secretsMu.Lock()
+	defer g.secretsMu.Unlock()
+	// Set on the raw secrets as well, so that they are kept by RefreshSecrets.
 	if keystore != nil {
 		g.secrets.Password.Keystore = (*models.Secret)(keystore)
+		g.rawSecrets.Password.Keystore = (*models.Secret)(keystore)
 	}
 	if vrf != nil {
 		g.secrets.Password.VRF = (*models.Secret)(vrf)
+		g.rawSecrets.Password.VRF = (*models.Secret)(vrf)
 	}
 }
 
 func (g *generalConfig) KeystorePassword() string {
-	g.passwordMu.RLock()
-	defer g.passwordMu.RUnlock()
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Password.Keystore == nil {
 		return ""
 	}
@@ -70,8 +73,8 @@ func (g *generalConfig) KeystorePassword() string {
 }
 
 func (g *generalConfig) VRFPassword() string {
-	g.passwordMu.RLock()
-	defer g.passwordMu.RUnlock()
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Password.VRF == nil {
 		return ""
 	}
@@ -11,6 +11,8 @@ import (
 
 	config "github.com/smartcontractkit/chainlink/v2/core/config"
 
+	context "context"
+
 	cosmos "github.com/smartcontractkit/chainlink/v2/core/chains/cosmos"
 
 	dialects "github.com/smartcontractkit/chainlink/v2/core/store/dialects"
@@ -1041,6 +1043,62 @@ func (_m *GeneralConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineCircuitBreakerEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineCircuitBreakerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineCircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineHTTPHedgingPercentile provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineHTTPHedgingPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -1764,6 +1822,110 @@ func (_m *GeneralConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error) {
 	return r0, r1
 }
 
+// OIDCClientID provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCClientSecret provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OIDCIssuerURL provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCIssuerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRedirectURL provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRedirectURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRoles provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRoles() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRolesClaim provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRolesClaim() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // ORMMaxIdleConns provides a mock function with given fields:
 func (_m *GeneralConfig) ORMMaxIdleConns() int {
 	ret := _m.Called()
@@ -2264,6 +2426,64 @@ func (_m *GeneralConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RefreshSecrets provides a mock function with given fields: ctx
+func (_m *GeneralConfig) RefreshSecrets(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoteSignerEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSignerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RemoteSignerTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// RemoteSignerURL provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -2278,6 +2498,20 @@ func (_m *GeneralConfig) RootDir() string {
 	return r0
 }
 
+// SecretsRefreshInterval provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsRefreshInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *GeneralConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -2686,6 +2920,62 @@ func (_m *GeneralConfig) TelemetryIngressUseBatchSend() bool {
 	return r0
 }
 
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *GeneralConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingSamplingRatio provides a mock function with given fields:
+func (_m *GeneralConfig) TracingSamplingRatio() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// TracingTLSCertPath provides a mock function with given fields:
+func (_m *GeneralConfig) TracingTLSCertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,99 @@
+package chainlink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type secretsRefresherConfig interface {
+	SecretsRefreshInterval() time.Duration
+	RefreshSecrets(ctx context.Context) error
+}
+
+// secretsRefresher periodically resolves the references of the secrets again,
+// so that rotated secrets are picked up without a restart.
+type secretsRefresher struct {
+	utils.StartStopOnce
+	cfg      secretsRefresherConfig
+	lggr     logger.Logger
+	interval time.Duration
+
+	chStop utils.StopChan
+	wgDone sync.WaitGroup
+
+	mu      sync.RWMutex
+	lastErr error
+}
+
+func newSecretsRefresher(cfg secretsRefresherConfig, lggr logger.Logger) *secretsRefresher {
+	return &secretsRefresher{
+		cfg:      cfg,
+		lggr:     lggr.Named("SecretsRefresher"),
+		interval: cfg.SecretsRefreshInterval(),
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (r *secretsRefresher) Start(context.Context) error {
+	return r.StartOnce("SecretsRefresher", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *secretsRefresher) Close() error {
+	return r.StopOnce("SecretsRefresher", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *secretsRefresher) Name() string {
+	return r.lggr.Name()
+}
+
+// HealthReport includes the error of the last refresh, if it failed.
+func (r *secretsRefresher) HealthReport() map[string]error {
+	err := r.StartStopOnce.Healthy()
+	if err == nil {
+		r.mu.RLock()
+		err = r.lastErr
+		r.mu.RUnlock()
+	}
+	return map[string]error{r.Name(): err}
+}
+
+func (r *secretsRefresher) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.refresh(ctx)
+		case <-r.chStop:
+			return
+		}
+	}
+}
+
+func (r *secretsRefresher) refresh(ctx context.Context) {
+	err := r.cfg.RefreshSecrets(ctx)
+	if err != nil && ctx.Err() == nil {
+		r.lggr.Errorw("Failed to refresh secrets, keeping the previous ones", "err", err)
+	} else if err == nil {
+		r.lggr.Debug("Refreshed secrets")
+	}
+	r.mu.Lock()
+	r.lastErr = err
+	r.mu.Unlock()
+}
@@ -0,0 +1,133 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/ocr2dr_oracle"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Allowlist holds the addresses of users which are allowed to send requests.
+type Allowlist interface {
+	job.ServiceCtx
+
+	Allow(address common.Address) bool
+}
+
+type onchainAllowlist struct {
+	utils.StartStopOnce
+
+	contract        *ocr2dr_oracle.OCR2DROracleCaller
+	updateFrequency time.Duration
+	allowed         atomic.Pointer[map[common.Address]struct{}]
+	lggr            logger.Logger
+	closeClient     func() // nil if the client is not owned by the allowlist
+	chStop          utils.StopChan
+	wgDone          sync.WaitGroup
+}
+
+// NewOnchainAllowlist returns an Allowlist of the authorized senders of the
+// Functions oracle contract at address. The list is read every
+// updateFrequency, and is empty until it is first read.
+func NewOnchainAllowlist(caller bind.ContractCaller, address common.Address, updateFrequency time.Duration, lggr logger.Logger) (Allowlist, error) {
+	if updateFrequency <= 0 {
+		return nil, errors.New("allowlist update frequency must be positive")
+	}
+	contract, err := ocr2dr_oracle.NewOCR2DROracleCaller(address, caller)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create oracle contract caller")
+	}
+	a := &onchainAllowlist{
+		contract:        contract,
+		updateFrequency: updateFrequency,
+		lggr:            lggr.Named("OnchainAllowlist"),
+		chStop:          make(chan struct{}),
+	}
+	a.allowed.Store(&map[common.Address]struct{}{})
+	return a, nil
+}
+
+// NewOnchainAllowlistFromURL returns an Allowlist like NewOnchainAllowlist,
+// which reads the contract from the RPC node at rpcURL.
+func NewOnchainAllowlistFromURL(rpcURL string, address common.Address, updateFrequency time.Duration, lggr logger.Logger) (Allowlist, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial allowlist RPC URL")
+	}
+	allowlist, err := NewOnchainAllowlist(client, address, updateFrequency, lggr)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	allowlist.(*onchainAllowlist).closeClient = client.Close
+	return allowlist, nil
+}
+
+func (a *onchainAllowlist) Start(context.Context) error {
+	return a.StartOnce("OnchainAllowlist", func() error {
+		a.wgDone.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+func (a *onchainAllowlist) Close() error {
+	return a.StopOnce("OnchainAllowlist", func() error {
+		close(a.chStop)
+		a.wgDone.Wait()
+		if a.closeClient != nil {
+			a.closeClient()
+		}
+		return nil
+	})
+}
+
+func (a *onchainAllowlist) Allow(address common.Address) bool {
+	_, ok := (*a.allowed.Load())[address]
+	return ok
+}
+
+func (a *onchainAllowlist) run() {
+	defer a.wgDone.Done()
+	ctx, cancel := a.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(a.updateFrequency)
+	defer ticker.Stop()
+	for {
+		if err := a.update(ctx); err != nil && ctx.Err() == nil {
+			a.lggr.Errorw("Failed to update allowlist, keeping the previous one", "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-a.chStop:
+			return
+		}
+	}
+}
+
+func (a *onchainAllowlist) update(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, a.updateFrequency)
+	defer cancel()
+	senders, err := a.contract.GetAuthorizedSenders(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return errors.Wrap(err, "failed to get authorized senders")
+	}
+	allowed := make(map[common.Address]struct{}, len(senders))
+	for _, sender := range senders {
+		allowed[sender] = struct{}{}
+	}
+	a.allowed.Store(&allowed)
+	a.lggr.Debugw("Updated allowlist", "size", len(allowed))
+	return nil
+}
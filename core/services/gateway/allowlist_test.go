@@ -0,0 +1,89 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/ocr2dr_oracle"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+func TestOnchainAllowlist_UpdatesFromContract(t *testing.T) {
+	t.Parallel()
+
+	oracleABI, err := abi.JSON(strings.NewReader(ocr2dr_oracle.OCR2DROracleABI))
+	require.NoError(t, err)
+	sender1, sender2 := testutils.NewAddress(), testutils.NewAddress()
+	encoded, err := oracleABI.Methods["getAuthorizedSenders"].Outputs.Pack([]common.Address{sender1, sender2})
+	require.NoError(t, err)
+
+	client := evmclimocks.NewClient(t)
+	client.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(encoded, nil)
+
+	allowlist, err := gateway.NewOnchainAllowlist(client, testutils.NewAddress(), time.Hour, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.False(t, allowlist.Allow(sender1))
+
+	require.NoError(t, allowlist.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, allowlist.Close()) })
+
+	require.Eventually(t, func() bool { return allowlist.Allow(sender1) }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.True(t, allowlist.Allow(sender2))
+	require.False(t, allowlist.Allow(testutils.NewAddress()))
+}
+
+func TestOnchainAllowlist_InvalidUpdateFrequency(t *testing.T) {
+	t.Parallel()
+
+	_, err := gateway.NewOnchainAllowlist(evmclimocks.NewClient(t), testutils.NewAddress(), 0, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func TestOnchainAllowlist_FromURL(t *testing.T) {
+	t.Parallel()
+
+	oracleABI, err := abi.JSON(strings.NewReader(ocr2dr_oracle.OCR2DROracleABI))
+	require.NoError(t, err)
+	sender := testutils.NewAddress()
+	encoded, err := oracleABI.Methods["getAuthorizedSenders"].Outputs.Pack([]common.Address{sender})
+	require.NoError(t, err)
+
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) || !assert.Equal(t, "eth_call", req.Method) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%q}`, req.ID, hexutil.Encode(encoded))
+	}))
+	t.Cleanup(rpc.Close)
+
+	allowlist, err := gateway.NewOnchainAllowlistFromURL(rpc.URL, testutils.NewAddress(), time.Hour, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, allowlist.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, allowlist.Close()) })
+
+	require.Eventually(t, func() bool { return allowlist.Allow(sender) }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.False(t, allowlist.Allow(testutils.NewAddress()))
+
+	_, err = gateway.NewOnchainAllowlistFromURL("foo://bar", testutils.NewAddress(), time.Hour, logger.TestLogger(t))
+	require.Error(t, err)
+}
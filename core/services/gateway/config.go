@@ -7,9 +7,30 @@ import (
 )
 
 type GatewayConfig struct {
-	UserServerConfig gw_net.HTTPServerConfig
-	NodeServerConfig gw_net.WebSocketServerConfig
-	Dons             []DONConfig
+	UserServerConfig        gw_net.HTTPServerConfig
+	NodeServerConfig        gw_net.WebSocketServerConfig
+	ConnectionManagerConfig ConnectionManagerConfig
+	MessageStoreConfig      MessageStoreConfig
+	Dons                    []DONConfig
+}
+
+// MessageStoreConfig applies to Gateways with a MessageStore. Zero values are
+// replaced with defaults.
+type MessageStoreConfig struct {
+	// MaxExpirationSec limits how far in the future messages may expire.
+	MaxExpirationSec uint32
+	// RetentionSec is how long requests are kept after they expire, for
+	// users to poll for their status.
+	RetentionSec uint32
+}
+
+// ConnectionManagerConfig configures the authentication of nodes. Zero
+// values are replaced with defaults.
+type ConnectionManagerConfig struct {
+	// AuthGatewayId is the ID of this Gateway, which nodes sign when they connect.
+	AuthGatewayId             string
+	AuthTimestampToleranceSec uint32
+	AuthChallengeLen          uint32
 }
 
 type DONConfig struct {
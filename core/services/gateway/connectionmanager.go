@@ -2,10 +2,15 @@ package gateway
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/websocket"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -15,6 +20,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+const (
+	defaultAuthTimestampToleranceSec = 30
+	defaultAuthChallengeLen          = 32
+)
+
 // ConnectionManager holds all connections between Gateway and Nodes.
 type ConnectionManager interface {
 	job.ServiceCtx
@@ -31,34 +41,70 @@ type DONConnectionManager interface {
 type connectionManager struct {
 	utils.StartStopOnce
 
+	config   ConnectionManagerConfig
 	dons     map[string]*donConnectionManager
 	wsServer gw_net.WebSocketServer
 	lggr     logger.Logger
+
+	attemptsMu sync.Mutex
+	attempts   map[string]*handshakeAttempt
+
+	shutdownCh utils.StopChan
+	wgDone     sync.WaitGroup
+}
+
+type handshakeAttempt struct {
+	don       *donConnectionManager
+	member    NodeConfig
+	signer    []byte
+	challenge []byte
 }
 
 type donConnectionManager struct {
 	donConfig *DONConfig
 	handler   Handler
 	codec     Codec
+	nodes     map[string]*nodeConnection // keyed by lowercase member address
+	parent    *connectionManager
 	mu        sync.Mutex
 }
 
+type nodeConnection struct {
+	member  NodeConfig
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+}
+
 func NewConnectionManager(config *GatewayConfig, codec Codec, lggr logger.Logger) (ConnectionManager, error) {
-	dons := make(map[string]*donConnectionManager)
+	cmConfig := config.ConnectionManagerConfig
+	if cmConfig.AuthTimestampToleranceSec == 0 {
+		cmConfig.AuthTimestampToleranceSec = defaultAuthTimestampToleranceSec
+	}
+	if cmConfig.AuthChallengeLen == 0 {
+		cmConfig.AuthChallengeLen = defaultAuthChallengeLen
+	}
+	connMgr := &connectionManager{
+		config:     cmConfig,
+		dons:       make(map[string]*donConnectionManager),
+		attempts:   make(map[string]*handshakeAttempt),
+		lggr:       lggr.Named("ConnectionManager"),
+		shutdownCh: make(chan struct{}),
+	}
 	for _, donConfig := range config.Dons {
 		donConfig := donConfig
 		if donConfig.DonId == "" {
 			return nil, errors.New("empty DON ID")
 		}
-		_, ok := dons[donConfig.DonId]
+		_, ok := connMgr.dons[donConfig.DonId]
 		if ok {
 			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
 		}
-		dons[donConfig.DonId] = &donConnectionManager{donConfig: &donConfig, codec: codec}
-	}
-	connMgr := &connectionManager{
-		dons: dons,
-		lggr: lggr.Named("ConnectionManager"),
+		connMgr.dons[donConfig.DonId] = &donConnectionManager{
+			donConfig: &donConfig,
+			codec:     codec,
+			nodes:     make(map[string]*nodeConnection),
+			parent:    connMgr,
+		}
 	}
 	wsServer := gw_net.NewWebSocketServer(&config.NodeServerConfig, connMgr, lggr)
 	connMgr.wsServer = wsServer
@@ -79,25 +125,76 @@ func (m *connectionManager) Start(ctx context.Context) error {
 func (m *connectionManager) Close() error {
 	return m.StopOnce("ConnectionManager", func() (err error) {
 		m.lggr.Info("closing connection manager")
-		return m.wsServer.Close()
+		err = m.wsServer.Close()
+		close(m.shutdownCh)
+		for _, don := range m.dons {
+			don.closeConnections()
+		}
+		m.wgDone.Wait()
+		return err
 	})
 }
 
 func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string, challenge []byte, err error) {
-	m.lggr.Debug("StartHandshake")
-	// TODO (FUN-469): implement the handshake (extract node address, validate signature, create a new attempt, generate challenge)
-	return "", []byte{}, nil
+	elems, signer, err := UnpackSignedAuthHeader(authHeader)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid auth header: %w", err)
+	}
+	now := uint32(time.Now().Unix())
+	if elems.Timestamp+m.config.AuthTimestampToleranceSec < now || elems.Timestamp > now+m.config.AuthTimestampToleranceSec {
+		return "", nil, errors.New("auth header timestamp is out of range")
+	}
+	if elems.GatewayId != m.config.AuthGatewayId {
+		return "", nil, fmt.Errorf("auth header is for gateway %s", elems.GatewayId)
+	}
+	don, ok := m.dons[elems.DonId]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown DON ID %s", elems.DonId)
+	}
+	member, ok := don.member(signer)
+	if !ok {
+		return "", nil, fmt.Errorf("node %s is not a member of DON %s", common.BytesToAddress(signer), elems.DonId)
+	}
+
+	challengeBytes := make([]byte, m.config.AuthChallengeLen)
+	if _, err = rand.Read(challengeBytes); err != nil {
+		return "", nil, err
+	}
+	challenge = PackChallenge(&ChallengeElems{Timestamp: now, GatewayId: m.config.AuthGatewayId, ChallengeBytes: challengeBytes})
+	attemptId = hex.EncodeToString(challengeBytes)
+
+	m.attemptsMu.Lock()
+	defer m.attemptsMu.Unlock()
+	m.attempts[attemptId] = &handshakeAttempt{don: don, member: member, signer: signer, challenge: challenge}
+	m.lggr.Debugw("started handshake", "donId", elems.DonId, "node", member.Name)
+	return attemptId, challenge, nil
 }
 
 func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte, conn *websocket.Conn) error {
-	m.lggr.Debug("FinalizeHandshake", attemptId, response)
-	// TODO (FUN-469): implement the handshake (validate signature, add a new connection)
+	m.attemptsMu.Lock()
+	attempt, ok := m.attempts[attemptId]
+	delete(m.attempts, attemptId)
+	m.attemptsMu.Unlock()
+	if !ok {
+		return errors.New("unknown handshake attempt")
+	}
+
+	signer, err := ExtractSigner([][]byte{attempt.challenge}, response)
+	if err != nil {
+		return fmt.Errorf("invalid challenge response: %w", err)
+	}
+	if common.BytesToAddress(signer) != common.BytesToAddress(attempt.signer) {
+		return errors.New("challenge response is signed by another node")
+	}
+	attempt.don.addConnection(attempt.member, conn)
+	m.lggr.Infow("node connected", "donId", attempt.don.donConfig.DonId, "node", attempt.member.Name)
 	return nil
 }
 
 func (m *connectionManager) AbortHandshake(attemptId string) {
-	m.lggr.Debug("AbortHandshake", attemptId)
-	// TODO (FUN-469): implement the handshake (clear cached attempt)
+	m.attemptsMu.Lock()
+	defer m.attemptsMu.Unlock()
+	delete(m.attempts, attemptId)
 }
 
 func (m *donConnectionManager) SetHandler(handler Handler) {
@@ -107,5 +204,96 @@ func (m *donConnectionManager) SetHandler(handler Handler) {
 }
 
 func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *Message) error {
-	return nil
+	m.mu.Lock()
+	node, ok := m.nodes[strings.ToLower(nodeAddress)]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("node %s is not connected", nodeAddress)
+	}
+	data, err := m.codec.EncodeRequest(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+	node.writeMu.Lock()
+	defer node.writeMu.Unlock()
+	// A zero deadline clears the one of a previous write.
+	deadline, _ := ctx.Deadline()
+	if err = node.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return node.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (m *donConnectionManager) member(signer []byte) (NodeConfig, bool) {
+	address := common.BytesToAddress(signer)
+	for _, member := range m.donConfig.Members {
+		if common.IsHexAddress(member.Address) && common.HexToAddress(member.Address) == address {
+			return member, true
+		}
+	}
+	return NodeConfig{}, false
+}
+
+// addConnection replaces any previous connection of the node.
+func (m *donConnectionManager) addConnection(member NodeConfig, conn *websocket.Conn) {
+	node := &nodeConnection{member: member, conn: conn}
+	m.mu.Lock()
+	old := m.nodes[strings.ToLower(member.Address)]
+	m.nodes[strings.ToLower(member.Address)] = node
+	m.mu.Unlock()
+	if old != nil {
+		_ = old.conn.Close()
+	}
+
+	m.parent.wgDone.Add(1)
+	go m.readLoop(node)
+}
+
+func (m *donConnectionManager) removeConnection(node *nodeConnection) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.nodes[strings.ToLower(node.member.Address)] == node {
+		delete(m.nodes, strings.ToLower(node.member.Address))
+	}
+}
+
+func (m *donConnectionManager) closeConnections() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, node := range m.nodes {
+		_ = node.conn.Close()
+	}
+}
+
+func (m *donConnectionManager) readLoop(node *nodeConnection) {
+	defer m.parent.wgDone.Done()
+	defer m.removeConnection(node)
+	defer node.conn.Close()
+	ctx, cancel := m.parent.shutdownCh.NewCtx()
+	defer cancel()
+	lggr := m.parent.lggr.With("donId", m.donConfig.DonId, "node", node.member.Name)
+
+	for {
+		_, data, err := node.conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() == nil {
+				lggr.Infow("node disconnected", "err", err)
+			}
+			return
+		}
+		msg, err := m.codec.DecodeResponse(data)
+		if err != nil || msg == nil {
+			lggr.Errorw("failed to decode node message", "err", err)
+			continue
+		}
+		m.mu.Lock()
+		handler := m.handler
+		m.mu.Unlock()
+		if handler == nil {
+			continue
+		}
+		if err = handler.HandleNodeMessage(ctx, msg, node.member.Address); err != nil {
+			lggr.Errorw("failed to handle node message", "messageId", msg.Body.MessageId, "err", err)
+		}
+	}
 }
@@ -0,0 +1,362 @@
+package connector
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/websocket"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	defaultAuthMinChallengeLen       = 20
+	defaultAuthTimestampToleranceSec = 30
+)
+
+// GatewayConnector is the node-side client of Gateways. It keeps a connection
+// open to every configured Gateway, dispatches messages received from them to
+// the handler registered for their method and sends replies back.
+type GatewayConnector interface {
+	job.ServiceCtx
+	network.ConnectionInitiator
+
+	// AddHandler registers handler for methods. Not thread-safe, should be
+	// called before Start().
+	AddHandler(methods []string, handler GatewayConnectorHandler) error
+	// SendToGateway signs msg with the node's key and sends it to gatewayId.
+	SendToGateway(ctx context.Context, gatewayId string, msg *gateway.Message) error
+}
+
+// GatewayConnectorHandler handles messages of the methods it was registered
+// for. Handlers are started and closed together with the GatewayConnector.
+type GatewayConnectorHandler interface {
+	job.ServiceCtx
+
+	HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message)
+}
+
+// Signer signs data with the node's key.
+type Signer interface {
+	Sign(data ...[]byte) ([]byte, error)
+}
+
+type ConnectorConfig struct {
+	NodeAddress               string
+	DonId                     string
+	Gateways                  []ConnectorGatewayConfig
+	WsClientConfig            network.WebSocketClientConfig
+	AuthMinChallengeLen       int
+	AuthTimestampToleranceSec uint32
+}
+
+type ConnectorGatewayConfig struct {
+	Id  string
+	URL string
+}
+
+type gatewayConnector struct {
+	utils.StartStopOnce
+
+	config      *ConnectorConfig
+	codec       gateway.Codec
+	nodeAddress []byte
+	signer      Signer
+	handlers    map[string]GatewayConnectorHandler
+	gateways    map[string]*gatewayState
+	urlToId     map[string]string
+	lggr        logger.Logger
+
+	shutdownCh utils.StopChan
+	wg         sync.WaitGroup
+}
+
+type gatewayState struct {
+	id      string
+	url     *url.URL
+	wsCli   network.WebSocketClient
+	mu      sync.Mutex
+	conn    *websocket.Conn // nil while disconnected
+	writeMu sync.Mutex
+}
+
+var _ GatewayConnector = (*gatewayConnector)(nil)
+
+func NewGatewayConnector(config *ConnectorConfig, signer Signer, lggr logger.Logger) (GatewayConnector, error) {
+	if config == nil || signer == nil || lggr == nil {
+		return nil, errors.New("nil dependency")
+	}
+	if len(config.DonId) == 0 || len(config.DonId) > gateway.MessageDonIdMaxLen {
+		return nil, errors.New("invalid DON ID")
+	}
+	if !common.IsHexAddress(config.NodeAddress) {
+		return nil, fmt.Errorf("invalid node address %s", config.NodeAddress)
+	}
+	if config.AuthMinChallengeLen == 0 {
+		config.AuthMinChallengeLen = defaultAuthMinChallengeLen
+	}
+	if config.AuthTimestampToleranceSec == 0 {
+		config.AuthTimestampToleranceSec = defaultAuthTimestampToleranceSec
+	}
+	connector := &gatewayConnector{
+		config:      config,
+		codec:       &gateway.JsonRPCCodec{},
+		nodeAddress: common.HexToAddress(config.NodeAddress).Bytes(),
+		signer:      signer,
+		handlers:    make(map[string]GatewayConnectorHandler),
+		gateways:    make(map[string]*gatewayState),
+		urlToId:     make(map[string]string),
+		lggr:        lggr.Named("GatewayConnector"),
+		shutdownCh:  make(chan struct{}),
+	}
+	for _, gw := range config.Gateways {
+		if len(gw.Id) == 0 || len(gw.Id) > gateway.HandshakeGatewayIdLen {
+			return nil, fmt.Errorf("invalid gateway ID %q", gw.Id)
+		}
+		if _, ok := connector.gateways[gw.Id]; ok {
+			return nil, fmt.Errorf("duplicate gateway ID %s", gw.Id)
+		}
+		parsedURL, err := url.Parse(gw.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL of gateway %s: %w", gw.Id, err)
+		}
+		if _, ok := connector.urlToId[parsedURL.String()]; ok {
+			return nil, fmt.Errorf("duplicate gateway URL %s", gw.URL)
+		}
+		connector.urlToId[parsedURL.String()] = gw.Id
+		connector.gateways[gw.Id] = &gatewayState{
+			id:    gw.Id,
+			url:   parsedURL,
+			wsCli: network.NewWebSocketClient(config.WsClientConfig, connector, lggr),
+		}
+	}
+	return connector, nil
+}
+
+func (c *gatewayConnector) AddHandler(methods []string, handler GatewayConnectorHandler) error {
+	if handler == nil {
+		return errors.New("cannot add a nil handler")
+	}
+	for _, method := range methods {
+		if _, exists := c.handlers[method]; exists {
+			return fmt.Errorf("handler for method %s already exists", method)
+		}
+	}
+	for _, method := range methods {
+		c.handlers[method] = handler
+	}
+	return nil
+}
+
+func (c *gatewayConnector) SendToGateway(ctx context.Context, gatewayId string, msg *gateway.Message) error {
+	gw, ok := c.gateways[gatewayId]
+	if !ok {
+		return fmt.Errorf("unknown gateway %s", gatewayId)
+	}
+	msg.Body.Sender = common.BytesToAddress(c.nodeAddress).Hex()
+	rawBody, err := gateway.GetRawMessageBody(&msg.Body)
+	if err != nil {
+		return err
+	}
+	signature, err := c.signer.Sign(rawBody...)
+	if err != nil {
+		return fmt.Errorf("failed to sign message: %w", err)
+	}
+	msg.Signature = utils.StringToHex(string(signature))
+	data, err := c.codec.EncodeResponse(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	gw.mu.Lock()
+	conn := gw.conn
+	gw.mu.Unlock()
+	if conn == nil {
+		return fmt.Errorf("gateway %s is not connected", gatewayId)
+	}
+	gw.writeMu.Lock()
+	defer gw.writeMu.Unlock()
+	// A zero deadline clears the one of a previous write.
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (c *gatewayConnector) Start(ctx context.Context) error {
+	return c.StartOnce("GatewayConnector", func() error {
+		c.lggr.Info("starting gateway connector")
+		for _, handler := range uniqueHandlers(c.handlers) {
+			if err := handler.Start(ctx); err != nil {
+				return err
+			}
+		}
+		for _, gw := range c.gateways {
+			c.wg.Add(1)
+			go c.reconnectLoop(gw)
+		}
+		return nil
+	})
+}
+
+func (c *gatewayConnector) Close() error {
+	return c.StopOnce("GatewayConnector", func() (err error) {
+		c.lggr.Info("closing gateway connector")
+		close(c.shutdownCh)
+		for _, gw := range c.gateways {
+			gw.mu.Lock()
+			if gw.conn != nil {
+				_ = gw.conn.Close()
+			}
+			gw.mu.Unlock()
+		}
+		c.wg.Wait()
+		for _, handler := range uniqueHandlers(c.handlers) {
+			err = multierr.Append(err, handler.Close())
+		}
+		return err
+	})
+}
+
+func (c *gatewayConnector) NewAuthHeader(url *url.URL) []byte {
+	gatewayId := c.urlToId[url.String()]
+	packed := gateway.PackAuthHeader(&gateway.AuthHeaderElems{
+		Timestamp: uint32(time.Now().Unix()),
+		DonId:     c.config.DonId,
+		GatewayId: gatewayId,
+	})
+	signature, err := c.signer.Sign(packed)
+	if err != nil {
+		c.lggr.Errorw("failed to sign auth header", "gatewayId", gatewayId, "err", err)
+		return nil
+	}
+	return append(packed, signature...)
+}
+
+func (c *gatewayConnector) ChallengeResponse(challenge []byte) ([]byte, error) {
+	elems, err := gateway.UnpackChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if len(elems.ChallengeBytes) < c.config.AuthMinChallengeLen {
+		return nil, errors.New("challenge is too short")
+	}
+	if _, ok := c.gateways[elems.GatewayId]; !ok {
+		return nil, fmt.Errorf("challenge is from unknown gateway %s", elems.GatewayId)
+	}
+	now := uint32(time.Now().Unix())
+	tolerance := c.config.AuthTimestampToleranceSec
+	if elems.Timestamp+tolerance < now || elems.Timestamp > now+tolerance {
+		return nil, errors.New("challenge timestamp is out of range")
+	}
+	return c.signer.Sign(challenge)
+}
+
+// reconnectLoop connects to gw, reads from the connection until it breaks and
+// reconnects with backoff, until the connector is closed.
+func (c *gatewayConnector) reconnectLoop(gw *gatewayState) {
+	defer c.wg.Done()
+	ctx, cancel := c.shutdownCh.NewCtx()
+	defer cancel()
+	lggr := c.lggr.With("gatewayId", gw.id)
+
+	redialBackoff := utils.NewRedialBackoff()
+	for {
+		conn, err := gw.wsCli.Connect(ctx, gw.url)
+		if err != nil {
+			lggr.Errorw("failed to connect to gateway", "url", gw.url.String(), "err", err)
+		} else {
+			lggr.Infow("connected to gateway", "url", gw.url.String())
+			redialBackoff.Reset()
+			c.readLoop(ctx, gw, conn)
+			lggr.Info("disconnected from gateway")
+		}
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-time.After(redialBackoff.Duration()):
+		}
+	}
+}
+
+func (c *gatewayConnector) readLoop(ctx context.Context, gw *gatewayState, conn *websocket.Conn) {
+	gw.mu.Lock()
+	select {
+	case <-c.shutdownCh:
+		// closed while connecting
+		gw.mu.Unlock()
+		_ = conn.Close()
+		return
+	default:
+	}
+	gw.conn = conn
+	gw.mu.Unlock()
+	defer func() {
+		gw.mu.Lock()
+		gw.conn = nil
+		gw.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() == nil {
+				c.lggr.Infow("failed to read from gateway", "gatewayId", gw.id, "err", err)
+			}
+			return
+		}
+		msg, err := c.codec.DecodeRequest(data)
+		if err != nil {
+			c.lggr.Errorw("failed to decode gateway message", "gatewayId", gw.id, "err", err)
+			continue
+		}
+		handler, ok := c.handlers[msg.Body.Method]
+		if !ok {
+			c.lggr.Errorw("no handler for method", "gatewayId", gw.id, "method", msg.Body.Method)
+			continue
+		}
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			handler.HandleGatewayMessage(ctx, gw.id, msg)
+		}()
+	}
+}
+
+func uniqueHandlers(handlers map[string]GatewayConnectorHandler) []GatewayConnectorHandler {
+	var unique []GatewayConnectorHandler
+	seen := make(map[GatewayConnectorHandler]bool)
+	for _, handler := range handlers {
+		if !seen[handler] {
+			seen[handler] = true
+			unique = append(unique, handler)
+		}
+	}
+	return unique
+}
+
+type privateKeySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewPrivateKeySigner returns a Signer for key.
+func NewPrivateKeySigner(key *ecdsa.PrivateKey) Signer {
+	return &privateKeySigner{key: key}
+}
+
+func (s *privateKeySigner) Sign(data ...[]byte) ([]byte, error) {
+	return gateway.SignData(data, s.key)
+}
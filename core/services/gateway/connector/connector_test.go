@@ -0,0 +1,175 @@
+package connector_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+// gatewayHandler records messages received by the Gateway from nodes.
+type gatewayHandler struct {
+	nodeMsgs chan *gateway.Message
+}
+
+func (h *gatewayHandler) Start(context.Context) error { return nil }
+func (h *gatewayHandler) Close() error                { return nil }
+func (h *gatewayHandler) HandleUserMessage(context.Context, *gateway.Message, chan<- gateway.UserCallbackPayload) error {
+	return nil
+}
+func (h *gatewayHandler) HandleNodeMessage(_ context.Context, msg *gateway.Message, _ string) error {
+	h.nodeMsgs <- msg
+	return nil
+}
+
+// echoHandler replies to every message with the same payload.
+type echoHandler struct {
+	connector connector.GatewayConnector
+}
+
+func (h *echoHandler) Start(context.Context) error { return nil }
+func (h *echoHandler) Close() error                { return nil }
+func (h *echoHandler) HandleGatewayMessage(ctx context.Context, gatewayId string, msg *gateway.Message) {
+	reply := &gateway.Message{Body: gateway.MessageBody{
+		MessageId: msg.Body.MessageId,
+		Method:    msg.Body.Method,
+		DonId:     msg.Body.DonId,
+		Payload:   msg.Body.Payload,
+	}}
+	_ = h.connector.SendToGateway(ctx, gatewayId, reply)
+}
+
+func freePort(t *testing.T) uint16 {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return uint16(listener.Addr().(*net.TCPAddr).Port)
+}
+
+// startGatewayAndConnector starts a Gateway with a single DON of a single
+// node, and the node's connector, with an echoHandler.
+func startGatewayAndConnector(t *testing.T) (nodeAddress string, donConnMgr gateway.DONConnectionManager, gwHandler *gatewayHandler, conn connector.GatewayConnector) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nodeAddress = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	port := freePort(t)
+
+	gwConfig := &gateway.GatewayConfig{
+		NodeServerConfig: gw_net.WebSocketServerConfig{
+			HTTPServerConfig:       gw_net.HTTPServerConfig{Host: "127.0.0.1", Port: port, Path: "/node"},
+			HandshakeTimeoutMillis: 1000,
+		},
+		ConnectionManagerConfig: gateway.ConnectionManagerConfig{AuthGatewayId: "gateway_1"},
+		Dons: []gateway.DONConfig{{
+			DonId:   "my_don",
+			Members: []gateway.NodeConfig{{Name: "node one", Address: nodeAddress}},
+		}},
+	}
+	connMgr, err := gateway.NewConnectionManager(gwConfig, &gateway.JsonRPCCodec{}, logger.TestLogger(t))
+	require.NoError(t, err)
+	gwHandler = &gatewayHandler{nodeMsgs: make(chan *gateway.Message, 10)}
+	donConnMgr = connMgr.DONConnectionManager("my_don")
+	donConnMgr.SetHandler(gwHandler)
+	require.NoError(t, connMgr.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, connMgr.Close()) })
+
+	connConfig := &connector.ConnectorConfig{
+		NodeAddress:    nodeAddress,
+		DonId:          "my_don",
+		Gateways:       []connector.ConnectorGatewayConfig{{Id: "gateway_1", URL: fmt.Sprintf("ws://127.0.0.1:%d/node", port)}},
+		WsClientConfig: gw_net.WebSocketClientConfig{HandshakeTimeoutMillis: 1000},
+	}
+	conn, err = connector.NewGatewayConnector(connConfig, connector.NewPrivateKeySigner(key), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, conn.AddHandler([]string{"echo"}, &echoHandler{connector: conn}))
+	require.Error(t, conn.AddHandler([]string{"echo"}, &echoHandler{connector: conn}))
+	require.NoError(t, conn.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+	return
+}
+
+func TestGatewayConnector_HandshakeAndRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	nodeAddress, donConnMgr, gwHandler, conn := startGatewayAndConnector(t)
+
+	request := &gateway.Message{Body: gateway.MessageBody{MessageId: "1", Method: "echo", DonId: "my_don", Payload: []byte(`{"hello":"world"}`)}}
+	// the node connects asynchronously
+	require.Eventually(t, func() bool {
+		return donConnMgr.SendToNode(testutils.Context(t), nodeAddress, request) == nil
+	}, testutils.WaitTimeout(t), 50*time.Millisecond)
+
+	select {
+	case reply := <-gwHandler.nodeMsgs:
+		require.Equal(t, "1", reply.Body.MessageId)
+		require.Equal(t, nodeAddress, reply.Body.Sender)
+		require.JSONEq(t, `{"hello":"world"}`, string(reply.Body.Payload))
+		require.NoError(t, gateway.ValidateMessageSignature(reply))
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the node's reply")
+	}
+
+	require.ErrorContains(t, conn.SendToGateway(testutils.Context(t), "gateway_2", request), "unknown gateway")
+}
+
+func TestGatewayConnector_WriteDeadlineIsCleared(t *testing.T) {
+	t.Parallel()
+
+	nodeAddress, donConnMgr, _, conn := startGatewayAndConnector(t)
+
+	request := &gateway.Message{Body: gateway.MessageBody{MessageId: "1", Method: "echo", DonId: "my_don", Payload: []byte(`{}`)}}
+	// the node connects asynchronously
+	require.Eventually(t, func() bool {
+		return donConnMgr.SendToNode(testutils.Context(t), nodeAddress, request) == nil
+	}, testutils.WaitTimeout(t), 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+	defer cancel()
+	require.NoError(t, donConnMgr.SendToNode(ctx, nodeAddress, request))
+	require.NoError(t, conn.SendToGateway(ctx, "gateway_1", request))
+
+	// writes without a deadline must not be bound by the expired one
+	<-ctx.Done()
+	require.NoError(t, donConnMgr.SendToNode(context.Background(), nodeAddress, request))
+	require.NoError(t, conn.SendToGateway(context.Background(), "gateway_1", request))
+}
+
+func TestGatewayConnector_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := connector.NewPrivateKeySigner(key)
+	nodeAddress := crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	tests := []struct {
+		name   string
+		config connector.ConnectorConfig
+	}{
+		{"no DON ID", connector.ConnectorConfig{NodeAddress: nodeAddress}},
+		{"invalid node address", connector.ConnectorConfig{NodeAddress: "0x123", DonId: "my_don"}},
+		{"duplicate gateway ID", connector.ConnectorConfig{NodeAddress: nodeAddress, DonId: "my_don", Gateways: []connector.ConnectorGatewayConfig{
+			{Id: "gateway_1", URL: "ws://localhost:8081/node"},
+			{Id: "gateway_1", URL: "ws://localhost:8082/node"},
+		}}},
+		{"duplicate gateway URL", connector.ConnectorConfig{NodeAddress: nodeAddress, DonId: "my_don", Gateways: []connector.ConnectorGatewayConfig{
+			{Id: "gateway_1", URL: "ws://localhost:8081/node"},
+			{Id: "gateway_2", URL: "ws://localhost:8081/node"},
+		}}},
+	}
+	for _, test := range tests {
+		test := test
+		_, err := connector.NewGatewayConnector(&test.config, signer, logger.TestLogger(t))
+		require.Error(t, err, test.name)
+	}
+}
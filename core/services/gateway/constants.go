@@ -10,6 +10,13 @@ const (
 	RequestTimeoutError
 	NodeReponseEncodingError
 	FatalError
+	UnauthorizedError
+	LimitExceededError
+	UnsupportedMethodError
+	InvalidPayloadError
+	DuplicateMessageError
+	RequestInProgressError
+	RequestNotFoundError
 )
 
 // See https://www.jsonrpc.org/specification#error_object
@@ -22,6 +29,13 @@ func ToJsonRPCErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      -32000, // Server Error
 		NodeReponseEncodingError: -32603, // Internal Error
 		FatalError:               -32000, // Server Error
+		UnauthorizedError:        -32600, // Invalid Request
+		LimitExceededError:       -32000, // Server Error
+		UnsupportedMethodError:   -32601, // Method not found
+		InvalidPayloadError:      -32602, // Invalid Params
+		DuplicateMessageError:    -32600, // Invalid Request
+		RequestInProgressError:   -32000, // Server Error
+		RequestNotFoundError:     -32602, // Invalid Params
 	}
 
 	code, ok := gatewayErrorToJsonRPCError[errorCode]
@@ -41,6 +55,13 @@ func ToHttpErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      504, // Gateway Timeout
 		NodeReponseEncodingError: 500, // Internal Server Error
 		FatalError:               500, // Internal Server Error
+		UnauthorizedError:        401, // Unauthorized
+		LimitExceededError:       429, // Too Many Requests
+		UnsupportedMethodError:   400, // Bad Request
+		InvalidPayloadError:      400, // Bad Request
+		DuplicateMessageError:    409, // Conflict
+		RequestInProgressError:   202, // Accepted
+		RequestNotFoundError:     404, // Not Found
 	}
 
 	code, ok := gatewayErrorToHttpError[errorCode]
@@ -2,8 +2,15 @@ package gateway
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -17,6 +24,17 @@ type Gateway interface {
 	gw_net.HTTPRequestHandler
 }
 
+// MethodRequestStatus is handled by Gateways with a MessageStore. It returns
+// the RequestStatusPayload of the earlier request of the sender with the same
+// message ID, for users whose request timed out.
+const MethodRequestStatus = "gateway_request_status"
+
+const (
+	defaultMaxExpirationSec = 300
+	defaultRetentionSec     = 3600
+	pruneInterval           = time.Minute
+)
+
 type gateway struct {
 	utils.StartStopOnce
 
@@ -25,9 +43,25 @@ type gateway struct {
 	handlers   map[string]Handler
 	connMgr    ConnectionManager
 	lggr       logger.Logger
+
+	store         MessageStore // nil if requests are not persisted
+	maxExpiration time.Duration
+	retention     time.Duration
+	inflightMu    sync.Mutex
+	inflight      map[requestKey]struct{}
+
+	chStop utils.StopChan
+	wg     sync.WaitGroup
 }
 
-func NewGatewayFromConfig(config *GatewayConfig, lggr logger.Logger) (Gateway, error) {
+type requestKey struct {
+	sender    common.Address
+	messageId string
+}
+
+// NewGatewayFromConfig returns a Gateway for config. Store may be nil, in
+// which case requests are only held in memory.
+func NewGatewayFromConfig(config *GatewayConfig, handlerFactory HandlerFactory, store MessageStore, lggr logger.Logger) (Gateway, error) {
 	codec := &JsonRPCCodec{}
 	httpServer := gw_net.NewHttpServer(&config.UserServerConfig, lggr)
 	connMgr, err := NewConnectionManager(config, codec, lggr)
@@ -46,23 +80,34 @@ func NewGatewayFromConfig(config *GatewayConfig, lggr logger.Logger) (Gateway, e
 		if donConnMgr == nil {
 			return nil, fmt.Errorf("connection manager ID %s not found", donConfig.DonId)
 		}
-		handler, err := NewHandler(donConfig.HandlerName, &donConfig, donConnMgr)
+		handler, err := handlerFactory.NewHandler(donConfig.HandlerName, &donConfig, donConnMgr)
 		if err != nil {
 			return nil, err
 		}
 		handlers[donConfig.DonId] = handler
 		donConnMgr.SetHandler(handler)
 	}
-	return NewGateway(codec, httpServer, handlers, connMgr, lggr), nil
+	return NewGateway(codec, httpServer, handlers, connMgr, store, config.MessageStoreConfig, lggr), nil
 }
 
-func NewGateway(codec Codec, httpServer gw_net.HttpServer, handlers map[string]Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+func NewGateway(codec Codec, httpServer gw_net.HttpServer, handlers map[string]Handler, connMgr ConnectionManager, store MessageStore, storeConfig MessageStoreConfig, lggr logger.Logger) Gateway {
+	if storeConfig.MaxExpirationSec == 0 {
+		storeConfig.MaxExpirationSec = defaultMaxExpirationSec
+	}
+	if storeConfig.RetentionSec == 0 {
+		storeConfig.RetentionSec = defaultRetentionSec
+	}
 	gw := &gateway{
-		codec:      codec,
-		httpServer: httpServer,
-		handlers:   handlers,
-		connMgr:    connMgr,
-		lggr:       lggr.Named("gateway"),
+		codec:         codec,
+		httpServer:    httpServer,
+		handlers:      handlers,
+		connMgr:       connMgr,
+		lggr:          lggr.Named("gateway"),
+		store:         store,
+		maxExpiration: time.Duration(storeConfig.MaxExpirationSec) * time.Second,
+		retention:     time.Duration(storeConfig.RetentionSec) * time.Second,
+		inflight:      make(map[requestKey]struct{}),
+		chStop:        make(chan struct{}),
 	}
 	httpServer.SetHTTPRequestHandler(gw)
 	return gw
@@ -79,6 +124,10 @@ func (g *gateway) Start(ctx context.Context) error {
 		if err := g.connMgr.Start(ctx); err != nil {
 			return err
 		}
+		if g.store != nil {
+			g.wg.Add(1)
+			go g.pruneLoop()
+		}
 		return g.httpServer.Start(ctx)
 	})
 }
@@ -87,6 +136,8 @@ func (g *gateway) Close() error {
 	return g.StopOnce("Gateway", func() (err error) {
 		g.lggr.Info("closing gateway")
 		err = multierr.Combine(err, g.httpServer.Close())
+		close(g.chStop)
+		g.wg.Wait()
 		err = multierr.Combine(err, g.connMgr.Close())
 		for _, handler := range g.handlers {
 			err = multierr.Combine(err, handler.Close())
@@ -102,11 +153,17 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	if err != nil {
 		return newError(g.codec, "", UserMessageParseError, err.Error())
 	}
+	if g.store != nil && msg.Body.Method == MethodRequestStatus {
+		return g.requestStatus(ctx, msg)
+	}
 	// find correct handler
 	handler, ok := g.handlers[msg.Body.DonId]
 	if !ok {
 		return newError(g.codec, msg.Body.MessageId, UnsupportedDONIdError, "unsupported DON ID")
 	}
+	if g.store != nil {
+		return g.processStoredRequest(ctx, msg, handler)
+	}
 	// send to the handler
 	responseCh := make(chan UserCallbackPayload, 1)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
@@ -121,17 +178,221 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	case response = <-responseCh:
 		break
 	}
+	return g.encodeResponse(msg.Body.MessageId, response)
+}
+
+func (g *gateway) encodeResponse(messageId string, response UserCallbackPayload) (rawResponse []byte, httpStatusCode int) {
 	if response.ErrCode != NoError {
-		return newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
+		return newError(g.codec, messageId, response.ErrCode, response.ErrMsg)
 	}
 	// encode
-	rawResponse, err = g.codec.EncodeResponse(response.Msg)
+	rawResponse, err := g.codec.EncodeResponse(response.Msg)
 	if err != nil {
-		return newError(g.codec, msg.Body.MessageId, NodeReponseEncodingError, "")
+		return newError(g.codec, messageId, NodeReponseEncodingError, "")
 	}
 	return rawResponse, ToHttpErrorCode(NoError)
 }
 
+// RequestStatusPayload is the payload of responses to MethodRequestStatus.
+type RequestStatusPayload struct {
+	Status RequestStatus `json:"status"`
+	// Response is the Message sent back to the user, for completed requests.
+	Response     json.RawMessage `json:"response,omitempty"`
+	ErrorCode    int             `json:"error_code,omitempty"`
+	ErrorMessage string          `json:"error_message,omitempty"`
+}
+
+// processStoredRequest checks that msg is neither expired nor replayed, and
+// persists its response. Retries of a request are answered from the store, or
+// dispatched again if the request was lost, e.g. by a restart of the Gateway.
+func (g *gateway) processStoredRequest(ctx context.Context, msg *Message, handler Handler) (rawResponse []byte, httpStatusCode int) {
+	messageId := msg.Body.MessageId
+	if err := msg.Validate(); err != nil {
+		return newError(g.codec, messageId, UserMessageParseError, err.Error())
+	}
+	if err := ValidateMessageSignature(msg); err != nil {
+		return newError(g.codec, messageId, UnauthorizedError, "invalid message signature")
+	}
+	now := time.Now()
+	expiresAt := time.Unix(msg.Body.Expiration, 0)
+	if msg.Body.Expiration == 0 || !expiresAt.After(now) {
+		return newError(g.codec, messageId, UnauthorizedError, "message is expired")
+	}
+	if expiresAt.After(now.Add(g.maxExpiration)) {
+		return newError(g.codec, messageId, UnauthorizedError, fmt.Sprintf("message must expire within %s", g.maxExpiration))
+	}
+	rawBody, err := GetRawMessageBody(&msg.Body)
+	if err != nil {
+		return newError(g.codec, messageId, UserMessageParseError, err.Error())
+	}
+	key := requestKey{sender: common.HexToAddress(msg.Body.Sender), messageId: messageId}
+	bodyHash := crypto.Keccak256Hash(rawBody...)
+
+	existing, err := g.store.InsertRequest(ctx, &StoredRequest{
+		Sender:    key.sender,
+		MessageId: messageId,
+		DonId:     msg.Body.DonId,
+		Method:    msg.Body.Method,
+		BodyHash:  bodyHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		g.lggr.Errorw("failed to store request", "messageId", messageId, "err", err)
+		return newError(g.codec, messageId, InternalHandlerError, "failed to store request")
+	}
+	if existing != nil {
+		if existing.BodyHash != bodyHash {
+			return newError(g.codec, messageId, DuplicateMessageError, "message ID was already used by the sender")
+		}
+		switch existing.Status {
+		case RequestStatusCompleted:
+			var response Message
+			if err = json.Unmarshal(existing.Response, &response); err != nil {
+				return newError(g.codec, messageId, NodeReponseEncodingError, "")
+			}
+			return g.encodeResponse(messageId, UserCallbackPayload{Msg: &response, ErrCode: NoError})
+		case RequestStatusFailed:
+			return newError(g.codec, messageId, existing.ErrCode, existing.ErrMsg)
+		}
+	}
+
+	if !g.startInflight(key) {
+		return newError(g.codec, messageId, RequestInProgressError, "request is in progress")
+	}
+	// The handler may answer after the user's context is done, until the
+	// message expires, so that the response can be polled for.
+	handlerCtx, cancel := g.chStop.CtxCancel(context.WithDeadline(context.Background(), expiresAt))
+	responseCh := make(chan UserCallbackPayload, 1)
+	if err = handler.HandleUserMessage(handlerCtx, msg, responseCh); err != nil {
+		cancel()
+		response := UserCallbackPayload{ErrCode: InternalHandlerError, ErrMsg: err.Error()}
+		g.storeResponse(key, response)
+		return g.encodeResponse(messageId, response)
+	}
+
+	resultCh := make(chan UserCallbackPayload, 1)
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer cancel()
+		var response UserCallbackPayload
+		select {
+		case response = <-responseCh:
+		case <-handlerCtx.Done():
+			select {
+			case <-g.chStop:
+				// left pending, to be dispatched again by a retry after a restart
+				g.endInflight(key)
+				return
+			default:
+			}
+			response = UserCallbackPayload{ErrCode: RequestTimeoutError, ErrMsg: "request expired"}
+		}
+		g.storeResponse(key, response)
+		resultCh <- response
+	}()
+
+	select {
+	case <-ctx.Done():
+		return newError(g.codec, messageId, RequestTimeoutError, "handler timeout, the response can be polled for with "+MethodRequestStatus)
+	case response := <-resultCh:
+		return g.encodeResponse(messageId, response)
+	}
+}
+
+func (g *gateway) storeResponse(key requestKey, response UserCallbackPayload) {
+	defer g.endInflight(key)
+	var encoded []byte
+	if response.ErrCode == NoError {
+		var err error
+		if encoded, err = json.Marshal(response.Msg); err != nil {
+			response = UserCallbackPayload{ErrCode: NodeReponseEncodingError, ErrMsg: err.Error()}
+		}
+	}
+	ctx, cancel := g.chStop.NewCtx()
+	defer cancel()
+	if err := g.store.SetResponse(ctx, key.sender, key.messageId, encoded, response.ErrCode, response.ErrMsg); err != nil {
+		g.lggr.Errorw("failed to store response", "messageId", key.messageId, "sender", key.sender, "err", err)
+	}
+}
+
+func (g *gateway) startInflight(key requestKey) bool {
+	g.inflightMu.Lock()
+	defer g.inflightMu.Unlock()
+	if _, ok := g.inflight[key]; ok {
+		return false
+	}
+	g.inflight[key] = struct{}{}
+	return true
+}
+
+func (g *gateway) endInflight(key requestKey) {
+	g.inflightMu.Lock()
+	defer g.inflightMu.Unlock()
+	delete(g.inflight, key)
+}
+
+// requestStatus answers MethodRequestStatus. Its message is signed by the
+// sender of the request it asks for.
+func (g *gateway) requestStatus(ctx context.Context, msg *Message) (rawResponse []byte, httpStatusCode int) {
+	messageId := msg.Body.MessageId
+	if err := msg.Validate(); err != nil {
+		return newError(g.codec, messageId, UserMessageParseError, err.Error())
+	}
+	if err := ValidateMessageSignature(msg); err != nil {
+		return newError(g.codec, messageId, UnauthorizedError, "invalid message signature")
+	}
+	req, err := g.store.GetRequest(ctx, common.HexToAddress(msg.Body.Sender), messageId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return newError(g.codec, messageId, RequestNotFoundError, "request not found")
+	} else if err != nil {
+		g.lggr.Errorw("failed to get request", "messageId", messageId, "err", err)
+		return newError(g.codec, messageId, InternalHandlerError, "failed to get request")
+	}
+
+	status := RequestStatusPayload{Status: req.Status, Response: req.Response}
+	if req.Status == RequestStatusPending && !req.ExpiresAt.After(time.Now()) {
+		req.ErrCode, req.ErrMsg = RequestTimeoutError, "request expired"
+		status.Status = RequestStatusFailed
+	}
+	if req.ErrCode != NoError {
+		status.ErrorCode = ToJsonRPCErrorCode(req.ErrCode)
+		status.ErrorMessage = req.ErrMsg
+	}
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return newError(g.codec, messageId, NodeReponseEncodingError, "")
+	}
+	return g.encodeResponse(messageId, UserCallbackPayload{Msg: &Message{Body: MessageBody{
+		MessageId: messageId,
+		Method:    MethodRequestStatus,
+		DonId:     msg.Body.DonId,
+		Sender:    msg.Body.Sender,
+		Payload:   payload,
+	}}, ErrCode: NoError})
+}
+
+func (g *gateway) pruneLoop() {
+	defer g.wg.Done()
+	ctx, cancel := g.chStop.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.chStop:
+			return
+		case <-ticker.C:
+			deleted, err := g.store.DeleteExpired(ctx, time.Now().Add(-g.retention))
+			if err != nil {
+				g.lggr.Errorw("failed to prune requests", "err", err)
+			} else if deleted > 0 {
+				g.lggr.Debugw("pruned expired requests", "count", deleted)
+			}
+		}
+	}
+}
+
 func newError(codec Codec, id string, errCode ErrorCode, errMsg string) ([]byte, int) {
 	rawResponse, err := codec.EncodeNewErrorResponse(id, ToJsonRPCErrorCode(errCode), errMsg, nil)
 	if err != nil {
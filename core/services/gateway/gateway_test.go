@@ -2,11 +2,14 @@ package gateway_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -15,7 +18,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	gw_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/mocks"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func parseTOMLConfig(t *testing.T, tomlConfig string) *gateway.GatewayConfig {
@@ -47,7 +52,7 @@ DonId = "my_don_2"
 HandlerName = "dummy"
 `)
 
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), logger.TestLogger(t))
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(logger.TestLogger(t)), nil, logger.TestLogger(t))
 	require.NoError(t, err)
 }
 
@@ -64,7 +69,7 @@ DonId = "my_don"
 HandlerName = "dummy"
 `)
 
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), logger.TestLogger(t))
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(logger.TestLogger(t)), nil, logger.TestLogger(t))
 	require.Error(t, err)
 }
 
@@ -77,7 +82,7 @@ DonId = "my_don"
 HandlerName = "no_such_handler"
 `)
 
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), logger.TestLogger(t))
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(logger.TestLogger(t)), nil, logger.TestLogger(t))
 	require.Error(t, err)
 }
 
@@ -90,7 +95,7 @@ HandlerName = "dummy"
 SomeOtherField = "abcd"
 `)
 
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), logger.TestLogger(t))
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(logger.TestLogger(t)), nil, logger.TestLogger(t))
 	require.Error(t, err)
 }
 
@@ -109,7 +114,7 @@ func newGatewayWithMockHandler(t *testing.T) (gateway.Gateway, *gw_mocks.Handler
 	handlers := map[string]gateway.Handler{
 		"testDON": handler,
 	}
-	gw := gateway.NewGateway(&gateway.JsonRPCCodec{}, httpServer, handlers, nil, logger.TestLogger(t))
+	gw := gateway.NewGateway(&gateway.JsonRPCCodec{}, httpServer, handlers, nil, nil, gateway.MessageStoreConfig{}, logger.TestLogger(t))
 	return gw, handler
 }
 
@@ -177,3 +182,168 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32000, "failure")
 	require.Equal(t, 500, statusCode)
 }
+
+func newGatewayWithMessageStore(t *testing.T) (gateway.Gateway, *gw_mocks.Handler, *gw_mocks.MessageStore) {
+	// not a mock, which would format the gateway while its goroutines store responses
+	httpServer := gw_net.NewHttpServer(&gw_net.HTTPServerConfig{Path: "/user"}, logger.TestLogger(t))
+	handler := gw_mocks.NewHandler(t)
+	store := gw_mocks.NewMessageStore(t)
+	handlers := map[string]gateway.Handler{
+		"testDON": handler,
+	}
+	gw := gateway.NewGateway(&gateway.JsonRPCCodec{}, httpServer, handlers, nil, store, gateway.MessageStoreConfig{}, logger.TestLogger(t))
+	return gw, handler, store
+}
+
+func newSignedRequest(t *testing.T, key *ecdsa.PrivateKey, id string, method string, expiration time.Time, payload string) []byte {
+	msg := &gateway.Message{Body: gateway.MessageBody{
+		MessageId:  id,
+		Method:     method,
+		DonId:      "testDON",
+		Sender:     crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Expiration: expiration.Unix(),
+		Payload:    []byte(payload),
+	}}
+	signature, err := gateway.SignMessage(&msg.Body, key)
+	require.NoError(t, err)
+	msg.Signature = utils.StringToHex(string(signature))
+	request, err := (&gateway.JsonRPCCodec{}).EncodeRequest(msg)
+	require.NoError(t, err)
+	return request
+}
+
+func TestGateway_ProcessRequest_MessageStore(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	gw, handler, store := newGatewayWithMessageStore(t)
+	expiration := time.Now().Add(time.Minute)
+
+	var stored *gateway.StoredRequest
+	store.On("InsertRequest", mock.Anything, mock.Anything).Return(nil, nil).Once().Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*gateway.StoredRequest)
+	})
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*gateway.Message)
+		msg.Body.Payload = []byte(`{"result":"OK"}`)
+		args.Get(2).(chan<- gateway.UserCallbackPayload) <- gateway.UserCallbackPayload{Msg: msg, ErrCode: gateway.NoError}
+	})
+	var storedResponse []byte
+	store.On("SetResponse", mock.Anything, sender, "abcd", mock.Anything, gateway.NoError, "").Return(nil).Once().Run(func(args mock.Arguments) {
+		storedResponse = args.Get(3).([]byte)
+	})
+
+	request := newSignedRequest(t, key, "abcd", "request", expiration, `{"value":1}`)
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), request)
+	require.Equal(t, 200, statusCode)
+	require.Equal(t, sender, stored.Sender)
+	require.Equal(t, "abcd", stored.MessageId)
+	require.Equal(t, expiration.Unix(), stored.ExpiresAt.Unix())
+	require.Contains(t, string(storedResponse), `"payload":{"result":"OK"}`)
+
+	// a retry is answered from the store
+	completed := *stored
+	completed.Status, completed.Response = gateway.RequestStatusCompleted, storedResponse
+	store.On("InsertRequest", mock.Anything, mock.Anything).Return(&completed, nil).Once()
+	retryResponse, statusCode := gw.ProcessRequest(testutils.Context(t), request)
+	require.Equal(t, 200, statusCode)
+	require.Equal(t, string(response), string(retryResponse))
+
+	// the same message ID with another body is rejected
+	store.On("InsertRequest", mock.Anything, mock.Anything).Return(&completed, nil).Once()
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, key, "abcd", "request", expiration, `{"value":2}`))
+	requireJsonRPCError(t, response, "abcd", -32600, "message ID was already used by the sender")
+	require.Equal(t, 409, statusCode)
+}
+
+func TestGateway_ProcessRequest_MessageStoreRejectsExpired(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gw, _, _ := newGatewayWithMessageStore(t)
+
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, key, "abcd", "request", time.Now().Add(-time.Second), `{}`))
+	requireJsonRPCError(t, response, "abcd", -32600, "message is expired")
+	require.Equal(t, 401, statusCode)
+
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, key, "abcd", "request", time.Now().Add(time.Hour), `{}`))
+	requireJsonRPCError(t, response, "abcd", -32600, "message must expire within 5m0s")
+	require.Equal(t, 401, statusCode)
+}
+
+func TestGateway_ProcessRequest_MessageStoreTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	gw, handler, store := newGatewayWithMessageStore(t)
+	request := newSignedRequest(t, key, "abcd", "request", time.Now().Add(time.Minute), `{}`)
+
+	callbackChs := make(chan chan<- gateway.UserCallbackPayload, 1)
+	store.On("InsertRequest", mock.Anything, mock.Anything).Return(nil, nil).Once()
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+		callbackChs <- args.Get(2).(chan<- gateway.UserCallbackPayload)
+	})
+	timeoutCtx, cancel := context.WithTimeout(testutils.Context(t), 10*time.Millisecond)
+	defer cancel()
+	response, statusCode := gw.ProcessRequest(timeoutCtx, request)
+	requireJsonRPCError(t, response, "abcd", -32000, "handler timeout, the response can be polled for with gateway_request_status")
+	require.Equal(t, 504, statusCode)
+
+	// a retry while the handler is still working is not dispatched again
+	pending := &gateway.StoredRequest{Status: gateway.RequestStatusPending}
+	store.On("InsertRequest", mock.Anything, mock.Anything).Return(pending, nil).Once().Run(func(args mock.Arguments) {
+		pending.BodyHash = args.Get(1).(*gateway.StoredRequest).BodyHash
+	})
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), request)
+	requireJsonRPCError(t, response, "abcd", -32000, "request is in progress")
+	require.Equal(t, 202, statusCode)
+
+	// the late response is still stored
+	stored := make(chan struct{})
+	store.On("SetResponse", mock.Anything, sender, "abcd", mock.Anything, gateway.NoError, "").Return(nil).Once().Run(func(mock.Arguments) {
+		close(stored)
+	})
+	(<-callbackChs) <- gateway.UserCallbackPayload{Msg: &gateway.Message{}, ErrCode: gateway.NoError}
+	select {
+	case <-stored:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the response to be stored")
+	}
+}
+
+func TestGateway_ProcessRequest_RequestStatus(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	gw, _, store := newGatewayWithMessageStore(t)
+	statusRequest := newSignedRequest(t, key, "abcd", gateway.MethodRequestStatus, time.Now(), `{}`)
+
+	store.On("GetRequest", mock.Anything, sender, "abcd").Return(nil, sql.ErrNoRows).Once()
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), statusRequest)
+	requireJsonRPCError(t, response, "abcd", -32602, "request not found")
+	require.Equal(t, 404, statusCode)
+
+	store.On("GetRequest", mock.Anything, sender, "abcd").Return(&gateway.StoredRequest{
+		Status:    gateway.RequestStatusCompleted,
+		Response:  []byte(`{"signature":"","body":{"message_id":"abcd"}}`),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil).Once()
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), statusRequest)
+	require.Equal(t, 200, statusCode)
+	require.Contains(t, string(response), `"payload":{"status":"completed","response":{"signature":"","body":{"message_id":"abcd"}}}`)
+
+	store.On("GetRequest", mock.Anything, sender, "abcd").Return(&gateway.StoredRequest{
+		Status:    gateway.RequestStatusPending,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil).Once()
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), statusRequest)
+	require.Equal(t, 200, statusCode)
+	require.Contains(t, string(response), `"payload":{"status":"failed","error_code":-32000,"error_message":"request expired"}`)
+}
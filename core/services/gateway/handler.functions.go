@@ -0,0 +1,321 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Methods supported by the Functions handler.
+const (
+	// MethodSecretsSet uploads encrypted secrets to the DON.
+	MethodSecretsSet = "secrets_set"
+	// MethodRequestSubmit submits a Functions request to the DON.
+	MethodRequestSubmit = "request_submit"
+)
+
+// FunctionsHandlerConfig is the HandlerConfig of DONs served by the Functions
+// handler, encoded as JSON.
+type FunctionsHandlerConfig struct {
+	// AllowlistCheckEnabled restricts senders to the authorized senders of
+	// the Functions oracle contract at AllowlistContractAddress, read from
+	// the RPC node at AllowlistRPCURL.
+	AllowlistCheckEnabled       bool
+	AllowlistRPCURL             string
+	AllowlistContractAddress    common.Address
+	AllowlistUpdateFrequencySec uint32
+	UserRateLimiter             RateLimiterConfig
+	// Quorum is the number of node responses of the same outcome to answer
+	// a request with. Defaults to a majority of the DON members.
+	Quorum int
+	// MaxSecretsSize limits the size of the payload of secrets_set requests,
+	// in bytes. Zero is unlimited.
+	MaxSecretsSize int
+}
+
+// SecretsSetPayload is the payload of a secrets_set request.
+type SecretsSetPayload struct {
+	SlotID           uint          `json:"slot_id"`
+	Version          uint64        `json:"version"`
+	Expiration       int64         `json:"expiration"`
+	EncryptedSecrets hexutil.Bytes `json:"encrypted_secrets"`
+}
+
+// NodeResponsePayload is the common part of the payloads of node responses.
+type NodeResponsePayload struct {
+	Success      bool   `json:"success"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// CombinedResponsePayload is the payload of the response to users. It holds
+// the signed responses of all nodes received before the quorum was reached.
+type CombinedResponsePayload struct {
+	Success       bool       `json:"success"`
+	NodeResponses []*Message `json:"node_responses"`
+}
+
+type functionsHandler struct {
+	utils.StartStopOnce
+
+	donConfig   *DONConfig
+	connMgr     DONConnectionManager
+	allowlist   Allowlist // nil if the allowlist check is disabled
+	rateLimiter *RateLimiter
+	quorum      int
+	maxSecrets  int
+	lggr        logger.Logger
+
+	mu      sync.Mutex
+	pending map[string]*pendingFunctionsRequest
+}
+
+type pendingFunctionsRequest struct {
+	request    *Message
+	callbackCh chan<- UserCallbackPayload
+	responses  map[string]*Message
+	successes  int
+	failures   int
+}
+
+var _ Handler = (*functionsHandler)(nil)
+
+// NewFunctionsHandlerFromConfig returns a Functions handler for the
+// FunctionsHandlerConfig of donConfig.
+func NewFunctionsHandlerFromConfig(donConfig *DONConfig, connMgr DONConnectionManager, lggr logger.Logger) (Handler, error) {
+	var cfg FunctionsHandlerConfig
+	if len(donConfig.HandlerConfig) > 0 {
+		if err := json.Unmarshal(donConfig.HandlerConfig, &cfg); err != nil {
+			return nil, errors.Wrap(err, "failed to decode functions handler config")
+		}
+	}
+	lggr = lggr.Named("FunctionsHandler").With("donId", donConfig.DonId)
+
+	var allowlist Allowlist
+	if cfg.AllowlistCheckEnabled {
+		if cfg.AllowlistRPCURL == "" {
+			return nil, errors.New("functions handler allowlist requires AllowlistRPCURL")
+		}
+		updateFrequency := time.Duration(cfg.AllowlistUpdateFrequencySec) * time.Second
+		var err error
+		allowlist, err = NewOnchainAllowlistFromURL(cfg.AllowlistRPCURL, cfg.AllowlistContractAddress, updateFrequency, lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rateLimiter, err := NewRateLimiter(cfg.UserRateLimiter)
+	if err != nil {
+		return nil, err
+	}
+	return NewFunctionsHandler(cfg, donConfig, connMgr, allowlist, rateLimiter, lggr)
+}
+
+// NewFunctionsHandler returns a Functions handler. Allowlist may be nil to
+// allow every sender.
+func NewFunctionsHandler(cfg FunctionsHandlerConfig, donConfig *DONConfig, connMgr DONConnectionManager, allowlist Allowlist, rateLimiter *RateLimiter, lggr logger.Logger) (Handler, error) {
+	quorum := cfg.Quorum
+	if quorum == 0 {
+		quorum = len(donConfig.Members)/2 + 1
+	}
+	if quorum < 1 || quorum > len(donConfig.Members) {
+		return nil, fmt.Errorf("quorum %d must be between 1 and the number of DON members %d", quorum, len(donConfig.Members))
+	}
+	if cfg.MaxSecretsSize < 0 {
+		return nil, errors.New("max secrets size must not be negative")
+	}
+	return &functionsHandler{
+		donConfig:   donConfig,
+		connMgr:     connMgr,
+		allowlist:   allowlist,
+		rateLimiter: rateLimiter,
+		quorum:      quorum,
+		maxSecrets:  cfg.MaxSecretsSize,
+		lggr:        lggr,
+		pending:     make(map[string]*pendingFunctionsRequest),
+	}, nil
+}
+
+func (h *functionsHandler) Start(ctx context.Context) error {
+	return h.StartOnce("FunctionsHandler", func() error {
+		if h.allowlist != nil {
+			return h.allowlist.Start(ctx)
+		}
+		return nil
+	})
+}
+
+func (h *functionsHandler) Close() error {
+	return h.StopOnce("FunctionsHandler", func() error {
+		if h.allowlist != nil {
+			return h.allowlist.Close()
+		}
+		return nil
+	})
+}
+
+func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *Message, callbackCh chan<- UserCallbackPayload) error {
+	if errCode, err := h.validateUserMessage(msg); err != nil {
+		h.lggr.Debugw("Rejected user message", "messageId", msg.Body.MessageId, "sender", msg.Body.Sender, "err", err)
+		callbackCh <- UserCallbackPayload{ErrCode: errCode, ErrMsg: err.Error()}
+		close(callbackCh)
+		return nil
+	}
+
+	h.mu.Lock()
+	if _, exists := h.pending[msg.Body.MessageId]; exists {
+		h.mu.Unlock()
+		return fmt.Errorf("request ID %s is already in progress", msg.Body.MessageId)
+	}
+	req := &pendingFunctionsRequest{
+		request:    msg,
+		callbackCh: callbackCh,
+		responses:  make(map[string]*Message),
+	}
+	h.pending[msg.Body.MessageId] = req
+	h.mu.Unlock()
+
+	// Requests which are not answered before the user's context is done are dropped.
+	go func() {
+		<-ctx.Done()
+		h.removePending(msg.Body.MessageId, req)
+	}()
+
+	var err error
+	var sent int
+	for _, member := range h.donConfig.Members {
+		if sendErr := h.connMgr.SendToNode(ctx, member.Address, msg); sendErr != nil {
+			err = multierr.Append(err, errors.Wrapf(sendErr, "failed to send to node %s", member.Name))
+			continue
+		}
+		sent++
+	}
+	if sent < h.quorum {
+		h.removePending(msg.Body.MessageId, req)
+		return errors.Wrapf(err, "request was sent to %d nodes, less than the quorum of %d", sent, h.quorum)
+	}
+	if err != nil {
+		h.lggr.Warnw("Failed to send request to some nodes", "messageId", msg.Body.MessageId, "err", err)
+	}
+	return nil
+}
+
+func (h *functionsHandler) validateUserMessage(msg *Message) (ErrorCode, error) {
+	if err := msg.Validate(); err != nil {
+		return UserMessageParseError, err
+	}
+	if err := ValidateMessageSignature(msg); err != nil {
+		return UnauthorizedError, errors.Wrap(err, "invalid message signature")
+	}
+	if h.allowlist != nil && !h.allowlist.Allow(common.HexToAddress(msg.Body.Sender)) {
+		return UnauthorizedError, errors.New("sender is not allowlisted")
+	}
+	if !h.rateLimiter.Allow(common.HexToAddress(msg.Body.Sender)) {
+		return LimitExceededError, errors.New("rate limit exceeded")
+	}
+
+	switch msg.Body.Method {
+	case MethodSecretsSet:
+		if h.maxSecrets > 0 && len(msg.Body.Payload) > h.maxSecrets {
+			return InvalidPayloadError, fmt.Errorf("secrets payload of %d bytes exceeds the limit of %d bytes", len(msg.Body.Payload), h.maxSecrets)
+		}
+		var payload SecretsSetPayload
+		if err := json.Unmarshal(msg.Body.Payload, &payload); err != nil {
+			return InvalidPayloadError, errors.Wrap(err, "invalid secrets_set payload")
+		}
+		if len(payload.EncryptedSecrets) == 0 {
+			return InvalidPayloadError, errors.New("encrypted_secrets must not be empty")
+		}
+	case MethodRequestSubmit:
+		if len(msg.Body.Payload) == 0 || !json.Valid(msg.Body.Payload) {
+			return InvalidPayloadError, errors.New("request_submit payload must be a JSON value")
+		}
+	default:
+		return UnsupportedMethodError, fmt.Errorf("unsupported method %s", msg.Body.Method)
+	}
+	return NoError, nil
+}
+
+func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *Message, nodeAddr string) error {
+	if !h.isMember(nodeAddr) {
+		return fmt.Errorf("node %s is not a member of DON %s", nodeAddr, h.donConfig.DonId)
+	}
+	var payload NodeResponsePayload
+	if err := json.Unmarshal(msg.Body.Payload, &payload); err != nil {
+		return errors.Wrapf(err, "invalid response payload from node %s", nodeAddr)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	req, ok := h.pending[msg.Body.MessageId]
+	if !ok {
+		// Late responses, after the quorum was reached or the user timed out.
+		return nil
+	}
+	if _, ok = req.responses[nodeAddr]; ok {
+		return fmt.Errorf("duplicate response from node %s", nodeAddr)
+	}
+	req.responses[nodeAddr] = msg
+	if payload.Success {
+		req.successes++
+	} else {
+		req.failures++
+	}
+
+	// Answer once enough nodes agree, or once too many failed for a quorum of successes.
+	success := req.successes >= h.quorum
+	if !success && req.failures < h.quorum && req.failures <= len(h.donConfig.Members)-h.quorum {
+		return nil
+	}
+	delete(h.pending, msg.Body.MessageId)
+	return h.respond(req, success)
+}
+
+func (h *functionsHandler) respond(req *pendingFunctionsRequest, success bool) error {
+	combined := CombinedResponsePayload{Success: success}
+	for _, member := range h.donConfig.Members {
+		if resp, ok := req.responses[member.Address]; ok {
+			combined.NodeResponses = append(combined.NodeResponses, resp)
+		}
+	}
+	payload, err := json.Marshal(combined)
+	if err != nil {
+		req.callbackCh <- UserCallbackPayload{ErrCode: NodeReponseEncodingError, ErrMsg: err.Error()}
+		close(req.callbackCh)
+		return err
+	}
+	req.callbackCh <- UserCallbackPayload{Msg: &Message{Body: MessageBody{
+		MessageId: req.request.Body.MessageId,
+		Method:    req.request.Body.Method,
+		DonId:     req.request.Body.DonId,
+		Sender:    req.request.Body.Sender,
+		Payload:   payload,
+	}}, ErrCode: NoError}
+	close(req.callbackCh)
+	return nil
+}
+
+func (h *functionsHandler) removePending(messageId string, req *pendingFunctionsRequest) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.pending[messageId] == req {
+		delete(h.pending, messageId)
+	}
+}
+
+func (h *functionsHandler) isMember(nodeAddr string) bool {
+	for _, member := range h.donConfig.Members {
+		if member.Address == nodeAddr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package gateway_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type testAllowlist struct {
+	allowed map[common.Address]bool
+}
+
+func (a *testAllowlist) Start(context.Context) error       { return nil }
+func (a *testAllowlist) Close() error                      { return nil }
+func (a *testAllowlist) Allow(address common.Address) bool { return a.allowed[address] }
+
+var functionsDON = gateway.DONConfig{
+	DonId: "functions_don",
+	Members: []gateway.NodeConfig{
+		{Name: "node one", Address: "addr_1"},
+		{Name: "node two", Address: "addr_2"},
+		{Name: "node three", Address: "addr_3"},
+		{Name: "node four", Address: "addr_4"},
+	},
+}
+
+func newFunctionsHandler(t *testing.T, cfg gateway.FunctionsHandlerConfig, allowlist gateway.Allowlist) (gateway.Handler, *testConnManager) {
+	rateLimiter, err := gateway.NewRateLimiter(cfg.UserRateLimiter)
+	require.NoError(t, err)
+	connMgr := &testConnManager{}
+	handler, err := gateway.NewFunctionsHandler(cfg, &functionsDON, connMgr, allowlist, rateLimiter, logger.TestLogger(t))
+	require.NoError(t, err)
+	connMgr.SetHandler(handler)
+	return handler, connMgr
+}
+
+func newSignedMessage(t *testing.T, key *ecdsa.PrivateKey, id string, method string, payload string) *gateway.Message {
+	msg := &gateway.Message{Body: gateway.MessageBody{
+		MessageId: id,
+		Method:    method,
+		DonId:     functionsDON.DonId,
+		Sender:    crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Payload:   json.RawMessage(payload),
+	}}
+	signature, err := gateway.SignMessage(&msg.Body, key)
+	require.NoError(t, err)
+	msg.Signature = utils.StringToHex(string(signature))
+	return msg
+}
+
+func nodeResponse(id string, success bool) *gateway.Message {
+	payload, _ := json.Marshal(gateway.NodeResponsePayload{Success: success})
+	return &gateway.Message{Body: gateway.MessageBody{MessageId: id, Method: gateway.MethodSecretsSet, DonId: functionsDON.DonId, Payload: payload}}
+}
+
+const secretsPayload = `{"slot_id":1,"version":2,"expiration":1700000000,"encrypted_secrets":"0xabcdef"}`
+
+func TestFunctionsHandler_QuorumOfSuccesses(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	handler, connMgr := newFunctionsHandler(t, gateway.FunctionsHandlerConfig{Quorum: 2}, nil)
+	ctx := testutils.Context(t)
+
+	callbackCh := make(chan gateway.UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(ctx, newSignedMessage(t, key, "1", gateway.MethodSecretsSet, secretsPayload), callbackCh))
+	require.Equal(t, 4, connMgr.sendCounter)
+
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", false), "addr_1"))
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", true), "addr_2"))
+	require.Empty(t, callbackCh)
+	require.Error(t, handler.HandleNodeMessage(ctx, nodeResponse("1", true), "addr_2"), "duplicate response")
+	require.Error(t, handler.HandleNodeMessage(ctx, nodeResponse("1", true), "addr_5"), "not a member")
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", true), "addr_3"))
+
+	response := <-callbackCh
+	require.Equal(t, gateway.NoError, response.ErrCode)
+	require.Equal(t, "1", response.Msg.Body.MessageId)
+	var combined gateway.CombinedResponsePayload
+	require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &combined))
+	require.True(t, combined.Success)
+	require.Len(t, combined.NodeResponses, 3)
+
+	// late responses are ignored
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", true), "addr_4"))
+}
+
+func TestFunctionsHandler_QuorumUnreachable(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	handler, _ := newFunctionsHandler(t, gateway.FunctionsHandlerConfig{Quorum: 3}, nil)
+	ctx := testutils.Context(t)
+
+	callbackCh := make(chan gateway.UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(ctx, newSignedMessage(t, key, "1", gateway.MethodRequestSubmit, `{"source":"return 1"}`), callbackCh))
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", false), "addr_1"))
+	require.Empty(t, callbackCh)
+	require.NoError(t, handler.HandleNodeMessage(ctx, nodeResponse("1", false), "addr_2"))
+
+	response := <-callbackCh
+	require.Equal(t, gateway.NoError, response.ErrCode)
+	var combined gateway.CombinedResponsePayload
+	require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &combined))
+	require.False(t, combined.Success)
+	require.Len(t, combined.NodeResponses, 2)
+}
+
+func TestFunctionsHandler_RejectedMessages(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	allowlist := &testAllowlist{allowed: map[common.Address]bool{crypto.PubkeyToAddress(key.PublicKey): true}}
+	cfg := gateway.FunctionsHandlerConfig{
+		UserRateLimiter: gateway.RateLimiterConfig{PerSenderRPS: 0.001, PerSenderBurst: 3},
+		MaxSecretsSize:  100,
+	}
+	handler, connMgr := newFunctionsHandler(t, cfg, allowlist)
+
+	badSignature := newSignedMessage(t, key, "1", gateway.MethodSecretsSet, secretsPayload)
+	badSignature.Body.Payload = json.RawMessage(`{"encrypted_secrets":"0x00"}`)
+
+	tests := []struct {
+		name    string
+		msg     *gateway.Message
+		errCode gateway.ErrorCode
+		errMsg  string
+	}{
+		{"invalid signature", badSignature, gateway.UnauthorizedError, "invalid message signature"},
+		{"not allowlisted", newSignedMessage(t, otherKey, "2", gateway.MethodSecretsSet, secretsPayload), gateway.UnauthorizedError, "sender is not allowlisted"},
+		{"unsupported method", newSignedMessage(t, key, "3", "secrets_delete", secretsPayload), gateway.UnsupportedMethodError, "unsupported method secrets_delete"},
+		{"empty secrets", newSignedMessage(t, key, "4", gateway.MethodSecretsSet, `{"slot_id":1}`), gateway.InvalidPayloadError, "encrypted_secrets must not be empty"},
+		{"secrets too large", newSignedMessage(t, key, "5", gateway.MethodSecretsSet, `{"encrypted_secrets":"0x`+string(make([]byte, 100))+`"}`), gateway.InvalidPayloadError, "exceeds the limit of 100 bytes"},
+		{"rate limited", newSignedMessage(t, key, "6", gateway.MethodRequestSubmit, `{}`), gateway.LimitExceededError, "rate limit exceeded"},
+	}
+	for _, test := range tests {
+		callbackCh := make(chan gateway.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), test.msg, callbackCh), test.name)
+		response := <-callbackCh
+		require.Equal(t, test.errCode, response.ErrCode, test.name)
+		require.Contains(t, response.ErrMsg, test.errMsg, test.name)
+	}
+	require.Equal(t, 0, connMgr.sendCounter)
+}
+
+func TestFunctionsHandler_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	rateLimiter, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{})
+	require.NoError(t, err)
+	_, err = gateway.NewFunctionsHandler(gateway.FunctionsHandlerConfig{Quorum: 5}, &functionsDON, &testConnManager{}, nil, rateLimiter, logger.TestLogger(t))
+	require.ErrorContains(t, err, "quorum 5 must be between 1 and the number of DON members 4")
+
+	factory := gateway.NewHandlerFactory(logger.TestLogger(t))
+	don := functionsDON
+	don.HandlerConfig = json.RawMessage(`{"AllowlistCheckEnabled":true}`)
+	_, err = factory.NewHandler(gateway.Functions, &don, &testConnManager{})
+	require.ErrorContains(t, err, "requires AllowlistRPCURL")
+
+	don.HandlerConfig = json.RawMessage(`{"AllowlistCheckEnabled":true,"AllowlistRPCURL":"http://localhost:8545","AllowlistUpdateFrequencySec":60}`)
+	_, err = factory.NewHandler(gateway.Functions, &don, &testConnManager{})
+	require.NoError(t, err)
+
+	don.HandlerConfig = json.RawMessage(`{"Quorum":3,"UserRateLimiter":{"GlobalRPS":10,"GlobalBurst":10}}`)
+	_, err = factory.NewHandler(gateway.Functions, &don, &testConnManager{})
+	require.NoError(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -40,13 +41,33 @@ type Handler interface {
 type HandlerType = string
 
 const (
-	Dummy HandlerType = "dummy"
+	Dummy     HandlerType = "dummy"
+	Functions HandlerType = "functions"
 )
 
-func NewHandler(handlerType HandlerType, donConfig *DONConfig, connMgr DONConnectionManager) (Handler, error) {
+// HandlerFactory creates the Handler of each DON, with access to the services
+// of the node that the handler type requires.
+type HandlerFactory interface {
+	NewHandler(handlerType HandlerType, donConfig *DONConfig, connMgr DONConnectionManager) (Handler, error)
+}
+
+type handlerFactory struct {
+	lggr logger.Logger
+}
+
+var _ HandlerFactory = (*handlerFactory)(nil)
+
+// NewHandlerFactory returns a factory for all handler types.
+func NewHandlerFactory(lggr logger.Logger) HandlerFactory {
+	return &handlerFactory{lggr}
+}
+
+func (hf *handlerFactory) NewHandler(handlerType HandlerType, donConfig *DONConfig, connMgr DONConnectionManager) (Handler, error) {
 	switch handlerType {
 	case Dummy:
 		return NewDummyHandler(donConfig, connMgr)
+	case Functions:
+		return NewFunctionsHandlerFromConfig(donConfig, connMgr, hf.lggr)
 	default:
 		return nil, fmt.Errorf("unsupported handler type %s", handlerType)
 	}
@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// Nodes authenticate with their key when they connect to a Gateway:
+//
+//  1. The node sends AuthHeaderElems, signed with its key.
+//  2. The Gateway checks that the signer is a member of the DON and replies
+//     with ChallengeElems holding random bytes.
+//  3. The node signs the challenge, and the Gateway accepts the connection if
+//     the signer is the same.
+const (
+	HandshakeTimestampLen  = 4
+	HandshakeDonIdLen      = MessageDonIdMaxLen
+	HandshakeGatewayIdLen  = 128
+	HandshakeAuthHeaderLen = HandshakeTimestampLen + HandshakeDonIdLen + HandshakeGatewayIdLen + MessageSignatureLen
+)
+
+// AuthHeaderElems are sent by a node to start a handshake. Timestamp is in
+// Unix seconds.
+type AuthHeaderElems struct {
+	Timestamp uint32
+	DonId     string
+	GatewayId string
+}
+
+// ChallengeElems are sent by a Gateway, for the node to sign.
+type ChallengeElems struct {
+	Timestamp      uint32
+	GatewayId      string
+	ChallengeBytes []byte
+}
+
+// PackAuthHeader returns the data which nodes sign and append the signature to.
+func PackAuthHeader(elems *AuthHeaderElems) []byte {
+	packed := make([]byte, HandshakeTimestampLen, HandshakeAuthHeaderLen)
+	binary.BigEndian.PutUint32(packed, elems.Timestamp)
+	packed = append(packed, alignRight(elems.DonId, HandshakeDonIdLen)...)
+	return append(packed, alignRight(elems.GatewayId, HandshakeGatewayIdLen)...)
+}
+
+// UnpackSignedAuthHeader returns the elements of a signed auth header and the
+// address of its signer.
+func UnpackSignedAuthHeader(data []byte) (elems AuthHeaderElems, signer []byte, err error) {
+	if len(data) != HandshakeAuthHeaderLen {
+		return AuthHeaderElems{}, nil, errors.New("auth header length is invalid")
+	}
+	n := HandshakeTimestampLen
+	elems.Timestamp = binary.BigEndian.Uint32(data[:n])
+	elems.DonId = trimAligned(data[n : n+HandshakeDonIdLen])
+	n += HandshakeDonIdLen
+	elems.GatewayId = trimAligned(data[n : n+HandshakeGatewayIdLen])
+	n += HandshakeGatewayIdLen
+	signer, err = ExtractSigner([][]byte{data[:n]}, data[n:])
+	if err != nil {
+		return AuthHeaderElems{}, nil, err
+	}
+	return elems, signer, nil
+}
+
+// PackChallenge returns the challenge which nodes sign.
+func PackChallenge(elems *ChallengeElems) []byte {
+	packed := make([]byte, HandshakeTimestampLen, HandshakeTimestampLen+HandshakeGatewayIdLen+len(elems.ChallengeBytes))
+	binary.BigEndian.PutUint32(packed, elems.Timestamp)
+	packed = append(packed, alignRight(elems.GatewayId, HandshakeGatewayIdLen)...)
+	return append(packed, elems.ChallengeBytes...)
+}
+
+func UnpackChallenge(data []byte) (elems ChallengeElems, err error) {
+	n := HandshakeTimestampLen + HandshakeGatewayIdLen
+	if len(data) < n {
+		return ChallengeElems{}, errors.New("challenge is too short")
+	}
+	elems.Timestamp = binary.BigEndian.Uint32(data[:HandshakeTimestampLen])
+	elems.GatewayId = trimAligned(data[HandshakeTimestampLen:n])
+	elems.ChallengeBytes = data[n:]
+	return elems, nil
+}
+
+func alignRight(s string, length int) []byte {
+	aligned := make([]byte, length)
+	copy(aligned, s)
+	return aligned
+}
+
+func trimAligned(data []byte) string {
+	return string(bytes.TrimRight(data, "\x00"))
+}
@@ -0,0 +1,109 @@
+package gateway_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+func TestHandshake_AuthHeaderRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	elems := gateway.AuthHeaderElems{Timestamp: 1234, DonId: "my_don", GatewayId: "gateway_1"}
+	packed := gateway.PackAuthHeader(&elems)
+	signature, err := gateway.SignData([][]byte{packed}, key)
+	require.NoError(t, err)
+
+	unpacked, signer, err := gateway.UnpackSignedAuthHeader(append(packed, signature...))
+	require.NoError(t, err)
+	require.Equal(t, elems, unpacked)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Bytes(), signer)
+
+	_, _, err = gateway.UnpackSignedAuthHeader(packed)
+	require.Error(t, err)
+}
+
+func TestHandshake_ChallengeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	elems := gateway.ChallengeElems{Timestamp: 1234, GatewayId: "gateway_1", ChallengeBytes: []byte("random bytes")}
+	unpacked, err := gateway.UnpackChallenge(gateway.PackChallenge(&elems))
+	require.NoError(t, err)
+	require.Equal(t, elems, unpacked)
+
+	_, err = gateway.UnpackChallenge([]byte("short"))
+	require.Error(t, err)
+}
+
+func newHandshakeConnectionManager(t *testing.T, nodeAddress string) gateway.ConnectionManager {
+	config := &gateway.GatewayConfig{
+		NodeServerConfig:        gw_net.WebSocketServerConfig{HTTPServerConfig: gw_net.HTTPServerConfig{Path: "/node"}},
+		ConnectionManagerConfig: gateway.ConnectionManagerConfig{AuthGatewayId: "gateway_1"},
+		Dons: []gateway.DONConfig{{
+			DonId:   "my_don",
+			Members: []gateway.NodeConfig{{Name: "node one", Address: nodeAddress}},
+		}},
+	}
+	connMgr, err := gateway.NewConnectionManager(config, &gateway.JsonRPCCodec{}, logger.TestLogger(t))
+	require.NoError(t, err)
+	return connMgr
+}
+
+func signedAuthHeader(t *testing.T, key *ecdsa.PrivateKey, elems gateway.AuthHeaderElems) []byte {
+	packed := gateway.PackAuthHeader(&elems)
+	signature, err := gateway.SignData([][]byte{packed}, key)
+	require.NoError(t, err)
+	return append(packed, signature...)
+}
+
+func TestConnectionManager_StartHandshake(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	connMgr := newHandshakeConnectionManager(t, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	now := uint32(time.Now().Unix())
+
+	attemptId, challenge, err := connMgr.StartHandshake(signedAuthHeader(t, key, gateway.AuthHeaderElems{Timestamp: now, DonId: "my_don", GatewayId: "gateway_1"}))
+	require.NoError(t, err)
+	elems, err := gateway.UnpackChallenge(challenge)
+	require.NoError(t, err)
+	require.Equal(t, "gateway_1", elems.GatewayId)
+	require.Len(t, elems.ChallengeBytes, 32)
+	require.Equal(t, hex.EncodeToString(elems.ChallengeBytes), attemptId)
+
+	// a response signed by another key is rejected
+	response, err := gateway.SignData([][]byte{challenge}, otherKey)
+	require.NoError(t, err)
+	require.ErrorContains(t, connMgr.FinalizeHandshake(attemptId, response, nil), "signed by another node")
+	// the attempt is consumed
+	require.ErrorContains(t, connMgr.FinalizeHandshake(attemptId, response, nil), "unknown handshake attempt")
+
+	tests := []struct {
+		name   string
+		key    *ecdsa.PrivateKey
+		elems  gateway.AuthHeaderElems
+		errMsg string
+	}{
+		{"stale timestamp", key, gateway.AuthHeaderElems{Timestamp: now - 300, DonId: "my_don", GatewayId: "gateway_1"}, "timestamp is out of range"},
+		{"other gateway", key, gateway.AuthHeaderElems{Timestamp: now, DonId: "my_don", GatewayId: "gateway_2"}, "for gateway gateway_2"},
+		{"unknown DON", key, gateway.AuthHeaderElems{Timestamp: now, DonId: "other_don", GatewayId: "gateway_1"}, "unknown DON ID other_don"},
+		{"not a member", otherKey, gateway.AuthHeaderElems{Timestamp: now, DonId: "my_don", GatewayId: "gateway_1"}, "is not a member of DON my_don"},
+	}
+	for _, test := range tests {
+		_, _, err = connMgr.StartHandshake(signedAuthHeader(t, test.key, test.elems))
+		require.ErrorContains(t, err, test.errMsg, test.name)
+	}
+}
@@ -13,6 +13,7 @@ const (
 	MessageIdMaxLen               = 128
 	MessageMethodMaxLen           = 64
 	MessageDonIdMaxLen            = 64
+	MessageExpirationLen          = 8
 	MessageSenderLen              = 20
 	MessageSenderHexEncodedLen    = 2 + 2*MessageSenderLen
 )
@@ -34,6 +35,10 @@ type MessageBody struct {
 	Method    string `json:"method"`
 	DonId     string `json:"don_id"`
 	Sender    string `json:"sender"`
+	// Expiration is the Unix time in seconds after which the message must not
+	// be processed. It is required by Gateways with a MessageStore, which use
+	// it with the per-sender MessageId to reject replayed messages.
+	Expiration int64 `json:"expiration,omitempty"`
 
 	// Service-specific payload, decoded inside the Handler.
 	Payload json.RawMessage `json:"payload,omitempty"`
@@ -58,5 +63,8 @@ func (m *Message) Validate() error {
 	if len(m.Body.Sender) != MessageSenderHexEncodedLen || !common.IsHexAddress(m.Body.Sender) {
 		return errors.New("invalid hex-encoded sender address")
 	}
+	if m.Body.Expiration < 0 {
+		return errors.New("invalid expiration")
+	}
 	return nil
 }
@@ -0,0 +1,164 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+type RequestStatus string
+
+const (
+	RequestStatusPending   RequestStatus = "pending"
+	RequestStatusCompleted RequestStatus = "completed"
+	RequestStatusFailed    RequestStatus = "failed"
+)
+
+// StoredRequest is the metadata of a user request and, once it is answered,
+// the response which was sent back.
+type StoredRequest struct {
+	Sender    common.Address
+	MessageId string
+	DonId     string
+	Method    string
+	BodyHash  common.Hash
+	ExpiresAt time.Time
+	Status    RequestStatus
+	Response  []byte // encoded Message, for completed requests
+	ErrCode   ErrorCode
+	ErrMsg    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MessageStore persists user requests, so that retries are deduplicated,
+// replayed messages are rejected and users can poll for responses.
+// Requests are identified by their sender and MessageId.
+//
+//go:generate mockery --quiet --name MessageStore --output ./mocks/ --case=underscore
+type MessageStore interface {
+	// InsertRequest stores req as pending. If a request with the same sender
+	// and MessageId exists, it is returned instead and req is not stored.
+	InsertRequest(ctx context.Context, req *StoredRequest) (existing *StoredRequest, err error)
+	// SetResponse completes a pending request. Response is the encoded
+	// Message for NoError, and nil otherwise.
+	SetResponse(ctx context.Context, sender common.Address, messageId string, response []byte, errCode ErrorCode, errMsg string) error
+	// GetRequest returns sql.ErrNoRows if the request does not exist.
+	GetRequest(ctx context.Context, sender common.Address, messageId string) (*StoredRequest, error)
+	// DeleteExpired deletes requests which expired before cutoff.
+	DeleteExpired(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+type messageStore struct {
+	q pg.Q
+}
+
+var _ MessageStore = (*messageStore)(nil)
+
+// NewMessageStore returns a MessageStore backed by the gateway_messages table.
+func NewMessageStore(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) MessageStore {
+	return &messageStore{q: pg.NewQ(db, lggr.Named("MessageStore"), cfg)}
+}
+
+type storedRequestRow struct {
+	Sender    common.Address `db:"sender"`
+	MessageId string         `db:"message_id"`
+	DonId     string         `db:"don_id"`
+	Method    string         `db:"method"`
+	BodyHash  common.Hash    `db:"body_hash"`
+	ExpiresAt time.Time      `db:"expires_at"`
+	Status    RequestStatus  `db:"status"`
+	Response  []byte         `db:"response"`
+	ErrCode   int            `db:"error_code"`
+	ErrMsg    string         `db:"error_message"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (r *storedRequestRow) toStoredRequest() *StoredRequest {
+	return &StoredRequest{
+		Sender:    r.Sender,
+		MessageId: r.MessageId,
+		DonId:     r.DonId,
+		Method:    r.Method,
+		BodyHash:  r.BodyHash,
+		ExpiresAt: r.ExpiresAt,
+		Status:    r.Status,
+		Response:  r.Response,
+		ErrCode:   ErrorCode(r.ErrCode),
+		ErrMsg:    r.ErrMsg,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+const storedRequestFields = "sender, message_id, don_id, method, body_hash, expires_at, status, response, error_code, error_message, created_at, updated_at"
+
+func (s *messageStore) InsertRequest(ctx context.Context, req *StoredRequest) (existing *StoredRequest, err error) {
+	err = s.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		stmt := `INSERT INTO gateway_messages (sender, message_id, don_id, method, body_hash, expires_at, status, error_code, error_message, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 0, '', NOW(), NOW())
+			ON CONFLICT (sender, message_id) DO NOTHING`
+		res, err2 := tx.Exec(stmt, req.Sender, req.MessageId, req.DonId, req.Method, req.BodyHash, req.ExpiresAt, RequestStatusPending)
+		if err2 != nil {
+			return err2
+		}
+		inserted, err2 := res.RowsAffected()
+		if err2 != nil || inserted > 0 {
+			return err2
+		}
+		var row storedRequestRow
+		if err2 = tx.Get(&row, `SELECT `+storedRequestFields+` FROM gateway_messages WHERE sender = $1 AND message_id = $2`, req.Sender, req.MessageId); err2 != nil {
+			return err2
+		}
+		existing = row.toStoredRequest()
+		return nil
+	})
+	return existing, errors.Wrap(err, "failed to insert gateway request")
+}
+
+func (s *messageStore) SetResponse(ctx context.Context, sender common.Address, messageId string, response []byte, errCode ErrorCode, errMsg string) error {
+	status := RequestStatusCompleted
+	if errCode != NoError {
+		status = RequestStatusFailed
+	}
+	stmt := `UPDATE gateway_messages SET status = $3, response = $4, error_code = $5, error_message = $6, updated_at = NOW()
+		WHERE sender = $1 AND message_id = $2 AND status = $7`
+	res, cancel, err := s.q.WithOpts(pg.WithParentCtx(ctx)).ExecQIter(stmt, sender, messageId, status, response, int(errCode), errMsg, RequestStatusPending)
+	defer cancel()
+	if err != nil {
+		return errors.Wrap(err, "failed to set gateway response")
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return errors.Errorf("no pending request %s from %s", messageId, sender)
+	}
+	return nil
+}
+
+func (s *messageStore) GetRequest(ctx context.Context, sender common.Address, messageId string) (*StoredRequest, error) {
+	var row storedRequestRow
+	err := s.q.WithOpts(pg.WithParentCtx(ctx)).Get(&row, `SELECT `+storedRequestFields+` FROM gateway_messages WHERE sender = $1 AND message_id = $2`, sender, messageId)
+	if err != nil {
+		return nil, err
+	}
+	return row.toStoredRequest(), nil
+}
+
+func (s *messageStore) DeleteExpired(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, cancel, err := s.q.WithOpts(pg.WithParentCtx(ctx)).ExecQIter(`DELETE FROM gateway_messages WHERE expires_at < $1`, cutoff)
+	defer cancel()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired gateway requests")
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,90 @@
+package gateway_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+func setupMessageStore(t *testing.T) gateway.MessageStore {
+	t.Helper()
+
+	db := pgtest.NewSqlxDB(t)
+	return gateway.NewMessageStore(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+}
+
+func TestMessageStore_InsertAndSetResponse(t *testing.T) {
+	t.Parallel()
+
+	store := setupMessageStore(t)
+	ctx := testutils.Context(t)
+	req := &gateway.StoredRequest{
+		Sender:    testutils.NewAddress(),
+		MessageId: "abcd",
+		DonId:     "my_don",
+		Method:    "request",
+		BodyHash:  testutils.Random32Byte(),
+		ExpiresAt: time.Now().Add(time.Minute).Round(time.Second),
+	}
+
+	existing, err := store.InsertRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	existing, err = store.InsertRequest(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	require.Equal(t, gateway.RequestStatusPending, existing.Status)
+	require.Equal(t, req.BodyHash, existing.BodyHash)
+
+	require.NoError(t, store.SetResponse(ctx, req.Sender, req.MessageId, []byte(`{"body":{}}`), gateway.NoError, ""))
+	require.Error(t, store.SetResponse(ctx, req.Sender, req.MessageId, nil, gateway.RequestTimeoutError, "request expired"), "only pending requests are answered")
+
+	stored, err := store.GetRequest(ctx, req.Sender, req.MessageId)
+	require.NoError(t, err)
+	require.Equal(t, gateway.RequestStatusCompleted, stored.Status)
+	require.Equal(t, `{"body":{}}`, string(stored.Response))
+	require.Equal(t, req.ExpiresAt.Unix(), stored.ExpiresAt.Unix())
+
+	// message IDs are per sender
+	other := *req
+	other.Sender = testutils.NewAddress()
+	existing, err = store.InsertRequest(ctx, &other)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+	require.NoError(t, store.SetResponse(ctx, other.Sender, other.MessageId, nil, gateway.InternalHandlerError, "failure"))
+	stored, err = store.GetRequest(ctx, other.Sender, other.MessageId)
+	require.NoError(t, err)
+	require.Equal(t, gateway.RequestStatusFailed, stored.Status)
+	require.Equal(t, gateway.InternalHandlerError, stored.ErrCode)
+	require.Equal(t, "failure", stored.ErrMsg)
+}
+
+func TestMessageStore_DeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	store := setupMessageStore(t)
+	ctx := testutils.Context(t)
+	expired := &gateway.StoredRequest{Sender: testutils.NewAddress(), MessageId: "1", BodyHash: testutils.Random32Byte(), ExpiresAt: time.Now().Add(-time.Hour)}
+	active := &gateway.StoredRequest{Sender: testutils.NewAddress(), MessageId: "2", BodyHash: testutils.Random32Byte(), ExpiresAt: time.Now().Add(time.Hour)}
+	for _, req := range []*gateway.StoredRequest{expired, active} {
+		_, err := store.InsertRequest(ctx, req)
+		require.NoError(t, err)
+	}
+
+	deleted, err := store.DeleteExpired(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+
+	_, err = store.GetRequest(ctx, expired.Sender, expired.MessageId)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetRequest(ctx, active.Sender, active.MessageId)
+	require.NoError(t, err)
+}
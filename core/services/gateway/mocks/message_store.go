@@ -0,0 +1,125 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	gateway "github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MessageStore is an autogenerated mock type for the MessageStore type
+type MessageStore struct {
+	mock.Mock
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, cutoff
+func (_m *MessageStore) DeleteExpired(ctx context.Context, cutoff time.Time) (int64, error) {
+	ret := _m.Called(ctx, cutoff)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, cutoff)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRequest provides a mock function with given fields: ctx, sender, messageId
+func (_m *MessageStore) GetRequest(ctx context.Context, sender common.Address, messageId string) (*gateway.StoredRequest, error) {
+	ret := _m.Called(ctx, sender, messageId)
+
+	var r0 *gateway.StoredRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string) (*gateway.StoredRequest, error)); ok {
+		return rf(ctx, sender, messageId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string) *gateway.StoredRequest); ok {
+		r0 = rf(ctx, sender, messageId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gateway.StoredRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, string) error); ok {
+		r1 = rf(ctx, sender, messageId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertRequest provides a mock function with given fields: ctx, req
+func (_m *MessageStore) InsertRequest(ctx context.Context, req *gateway.StoredRequest) (*gateway.StoredRequest, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *gateway.StoredRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gateway.StoredRequest) (*gateway.StoredRequest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gateway.StoredRequest) *gateway.StoredRequest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gateway.StoredRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gateway.StoredRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetResponse provides a mock function with given fields: ctx, sender, messageId, response, errCode, errMsg
+func (_m *MessageStore) SetResponse(ctx context.Context, sender common.Address, messageId string, response []byte, errCode gateway.ErrorCode, errMsg string) error {
+	ret := _m.Called(ctx, sender, messageId, response, errCode, errMsg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string, []byte, gateway.ErrorCode, string) error); ok {
+		r0 = rf(ctx, sender, messageId, response, errCode, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewMessageStore interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMessageStore creates a new instance of MessageStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMessageStore(t mockConstructorTestingTNewMessageStore) *MessageStore {
+	mock := &MessageStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -27,11 +27,11 @@ type webSocketClient struct {
 }
 
 func NewWebSocketClient(config WebSocketClientConfig, initiator ConnectionInitiator, lggr logger.Logger) WebSocketClient {
-	dialer := websocket.DefaultDialer
+	dialer := *websocket.DefaultDialer
 	dialer.HandshakeTimeout = time.Duration(config.HandshakeTimeoutMillis) * time.Millisecond
 	client := &webSocketClient{
 		initiator: initiator,
-		dialer:    dialer,
+		dialer:    &dialer,
 		lggr:      lggr.Named("WebSocketClient"),
 	}
 	return client
@@ -78,6 +78,7 @@ func (s *webSocketServer) handleRequest(w http.ResponseWriter, r *http.Request)
 	conn, err := s.upgrader.Upgrade(w, r, hdr)
 	if err != nil {
 		s.lggr.Error("failed websocket upgrade", err)
+		s.acceptor.AbortHandshake(attemptId)
 		return
 	}
 
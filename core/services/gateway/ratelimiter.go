@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/time/rate"
+)
+
+// RateLimiterConfig limits the rate of user requests globally and per sender.
+// A zero RPS disables the corresponding limit.
+type RateLimiterConfig struct {
+	GlobalRPS      float64
+	GlobalBurst    int
+	PerSenderRPS   float64
+	PerSenderBurst int
+}
+
+// RateLimiter is a token bucket rate limiter for all requests, with a separate
+// bucket for each sender.
+type RateLimiter struct {
+	global    *rate.Limiter
+	perSender map[common.Address]*senderLimiter
+	config    RateLimiterConfig
+	// idleTimeout is how long it takes a sender's bucket to refill, after
+	// which it is the same as a new one and can be evicted.
+	idleTimeout time.Duration
+	lastEvicted time.Time
+	mu          sync.Mutex
+}
+
+type senderLimiter struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+func NewRateLimiter(config RateLimiterConfig) (*RateLimiter, error) {
+	if config.GlobalRPS < 0 || config.PerSenderRPS < 0 {
+		return nil, errors.New("RPS values must not be negative")
+	}
+	if (config.GlobalRPS > 0 && config.GlobalBurst <= 0) || (config.PerSenderRPS > 0 && config.PerSenderBurst <= 0) {
+		return nil, errors.New("burst values must be positive when RPS is set")
+	}
+	rl := &RateLimiter{
+		global:      newLimiter(config.GlobalRPS, config.GlobalBurst),
+		perSender:   make(map[common.Address]*senderLimiter),
+		config:      config,
+		lastEvicted: time.Now(),
+	}
+	if config.PerSenderRPS > 0 {
+		rl.idleTimeout = time.Duration(float64(config.PerSenderBurst) / config.PerSenderRPS * float64(time.Second))
+	}
+	return rl, nil
+}
+
+func newLimiter(rps float64, burst int) *rate.Limiter {
+	if rps == 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// Allow returns true if a request from sender is within both limits, and
+// consumes a token from each bucket if so. No token is consumed from either
+// bucket otherwise.
+func (rl *RateLimiter) Allow(sender common.Address) bool {
+	if rl.config.PerSenderRPS == 0 {
+		return rl.global.Allow()
+	}
+	now := time.Now()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.evictIdle(now)
+	sl, ok := rl.perSender[sender]
+	if !ok {
+		sl = &senderLimiter{limiter: newLimiter(rl.config.PerSenderRPS, rl.config.PerSenderBurst)}
+		rl.perSender[sender] = sl
+	}
+	sl.lastUsed = now
+	// Check the sender first, so that a sender over its limit does not use up
+	// the global limit of others, and give its token back if the global limit
+	// is exceeded.
+	reservation := sl.limiter.ReserveN(now, 1)
+	if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+		reservation.CancelAt(now)
+		return false
+	}
+	if !rl.global.AllowN(now, 1) {
+		reservation.CancelAt(now)
+		return false
+	}
+	return true
+}
+
+// evictIdle removes the buckets of the senders idle for longer than
+// idleTimeout, at most once per idleTimeout.
+func (rl *RateLimiter) evictIdle(now time.Time) {
+	if now.Sub(rl.lastEvicted) < rl.idleTimeout {
+		return
+	}
+	rl.lastEvicted = now
+	for sender, sl := range rl.perSender {
+		if now.Sub(sl.lastUsed) >= rl.idleTimeout {
+			delete(rl.perSender, sender)
+		}
+	}
+}
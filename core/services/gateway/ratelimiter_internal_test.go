@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_EvictsIdleSenders(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(RateLimiterConfig{PerSenderRPS: 100, PerSenderBurst: 1})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Millisecond, rl.idleTimeout)
+
+	require.True(t, rl.Allow(common.HexToAddress("0x1")))
+	require.False(t, rl.Allow(common.HexToAddress("0x1")))
+	require.Len(t, rl.perSender, 1)
+
+	time.Sleep(2 * rl.idleTimeout)
+	require.True(t, rl.Allow(common.HexToAddress("0x2")))
+	require.Len(t, rl.perSender, 1)
+	require.Contains(t, rl.perSender, common.HexToAddress("0x2"))
+}
@@ -0,0 +1,80 @@
+package gateway_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+var (
+	user1 = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	user2 = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	user3 = common.HexToAddress("0x0000000000000000000000000000000000000003")
+)
+
+func TestRateLimiter_PerSender(t *testing.T) {
+	t.Parallel()
+
+	rl, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{GlobalRPS: 100, GlobalBurst: 100, PerSenderRPS: 0.001, PerSenderBurst: 2})
+	require.NoError(t, err)
+	require.True(t, rl.Allow(user1))
+	require.True(t, rl.Allow(user1))
+	require.False(t, rl.Allow(user1))
+	require.True(t, rl.Allow(user2))
+}
+
+func TestRateLimiter_Global(t *testing.T) {
+	t.Parallel()
+
+	rl, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{GlobalRPS: 0.001, GlobalBurst: 3, PerSenderRPS: 100, PerSenderBurst: 2})
+	require.NoError(t, err)
+	require.True(t, rl.Allow(user1))
+	require.True(t, rl.Allow(user1))
+	require.True(t, rl.Allow(user2))
+	require.False(t, rl.Allow(user3))
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	rl, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{})
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.True(t, rl.Allow(user1))
+	}
+}
+
+func TestRateLimiter_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{GlobalRPS: -1})
+	require.Error(t, err)
+	_, err = gateway.NewRateLimiter(gateway.RateLimiterConfig{PerSenderRPS: 1})
+	require.Error(t, err)
+}
+
+func TestRateLimiter_GlobalLimitDoesNotUseSenderTokens(t *testing.T) {
+	t.Parallel()
+
+	rl, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{GlobalRPS: 0.001, GlobalBurst: 1, PerSenderRPS: 0.001, PerSenderBurst: 1})
+	require.NoError(t, err)
+	require.True(t, rl.Allow(user1))
+	// user2 is rejected by the global limit, and keeps its own token.
+	require.False(t, rl.Allow(user2))
+	require.False(t, rl.Allow(user2))
+}
+
+func TestRateLimiter_SenderCasing(t *testing.T) {
+	t.Parallel()
+
+	rl, err := gateway.NewRateLimiter(gateway.RateLimiterConfig{PerSenderRPS: 0.001, PerSenderBurst: 1})
+	require.NoError(t, err)
+	sender := "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01"
+	require.True(t, rl.Allow(common.HexToAddress(sender)))
+	require.False(t, rl.Allow(common.HexToAddress(strings.ToLower(sender))))
+	require.False(t, rl.Allow(common.HexToAddress(strings.ToUpper(sender[2:]))))
+}
@@ -3,6 +3,7 @@ package gateway
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"encoding/binary"
 	"errors"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -14,9 +15,13 @@ import (
 //  1. MessageId aligned to 128 bytes
 //  2. Method aligned to 64 bytes
 //  3. DonId aligned to 64 bytes
-//  4. Payload (before parsing)
+//  4. Expiration as 8 bytes big-endian (only if non-zero)
+//  5. Payload (before parsing)
+//
+// Messages without an expiration are signed over the same data as before
+// expirations were introduced, so existing signers remain compatible.
 func SignMessage(msgBody *MessageBody, privateKey *ecdsa.PrivateKey) ([]byte, error) {
-	rawData, err := getRawMessageBody(msgBody)
+	rawData, err := GetRawMessageBody(msgBody)
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +29,7 @@ func SignMessage(msgBody *MessageBody, privateKey *ecdsa.PrivateKey) ([]byte, er
 }
 
 func ValidateMessageSignature(msg *Message) error {
-	rawData, err := getRawMessageBody(&msg.Body)
+	rawData, err := GetRawMessageBody(&msg.Body)
 	if err != nil {
 		return err
 	}
@@ -45,25 +50,39 @@ func SignData(data [][]byte, privateKey *ecdsa.PrivateKey) ([]byte, error) {
 }
 
 func ValidateSignature(data [][]byte, signature []byte, signerAddress []byte) error {
-	hash := crypto.Keccak256Hash(data...)
-	sigPublicKey, err := crypto.Ecrecover(hash.Bytes(), signature)
+	sigAddr, err := ExtractSigner(data, signature)
 	if err != nil {
 		return err
 	}
-	ecdsaPubKey, _ := crypto.UnmarshalPubkey(sigPublicKey)
-	sigAddr := crypto.PubkeyToAddress(*ecdsaPubKey)
-	if !bytes.Equal(sigAddr.Bytes(), signerAddress) {
+	if !bytes.Equal(sigAddr, signerAddress) {
 		return errors.New("invalid signer")
 	}
+	return nil
+}
 
+// ExtractSigner returns the address of the signer of data.
+func ExtractSigner(data [][]byte, signature []byte) ([]byte, error) {
+	if len(signature) != MessageSignatureLen {
+		return nil, errors.New("invalid signature length")
+	}
+	hash := crypto.Keccak256Hash(data...)
+	sigPublicKey, err := crypto.Ecrecover(hash.Bytes(), signature)
+	if err != nil {
+		return nil, err
+	}
 	signatureNoRecoverID := signature[:len(signature)-1]
 	if !crypto.VerifySignature(sigPublicKey, hash.Bytes(), signatureNoRecoverID) {
-		return errors.New("invalid signature")
+		return nil, errors.New("invalid signature")
 	}
-	return nil
+	ecdsaPubKey, err := crypto.UnmarshalPubkey(sigPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.PubkeyToAddress(*ecdsaPubKey).Bytes(), nil
 }
 
-func getRawMessageBody(msgBody *MessageBody) ([][]byte, error) {
+// GetRawMessageBody returns the data which message signatures are over.
+func GetRawMessageBody(msgBody *MessageBody) ([][]byte, error) {
 	if msgBody == nil {
 		return nil, errors.New("nil message")
 	}
@@ -73,5 +92,10 @@ func getRawMessageBody(msgBody *MessageBody) ([][]byte, error) {
 	copy(alignedMethod, msgBody.Method)
 	alignedDonId := make([]byte, MessageDonIdMaxLen)
 	copy(alignedDonId, msgBody.DonId)
-	return [][]byte{alignedMessageId, alignedMethod, alignedDonId, msgBody.Payload}, nil
+	if msgBody.Expiration == 0 {
+		return [][]byte{alignedMessageId, alignedMethod, alignedDonId, msgBody.Payload}, nil
+	}
+	expiration := make([]byte, MessageExpirationLen)
+	binary.BigEndian.PutUint64(expiration, uint64(msgBody.Expiration))
+	return [][]byte{alignedMessageId, alignedMethod, alignedDonId, expiration, msgBody.Payload}, nil
 }
@@ -50,3 +50,65 @@ func TestSignatures_BytesSignAndValidate(t *testing.T) {
 
 	require.NoError(t, gateway.ValidateSignature(data, signature, address))
 }
+
+func TestSignatures_LegacyMessageSignatureWithoutExpiration(t *testing.T) {
+	t.Parallel()
+
+	body := gateway.MessageBody{
+		MessageId: "abcd",
+		Method:    "request",
+		DonId:     "donA",
+		Payload:   []byte("datadata"),
+	}
+
+	// Sign over the original body layout, which has no expiration field.
+	alignedMessageId := make([]byte, gateway.MessageIdMaxLen)
+	copy(alignedMessageId, body.MessageId)
+	alignedMethod := make([]byte, gateway.MessageMethodMaxLen)
+	copy(alignedMethod, body.Method)
+	alignedDonId := make([]byte, gateway.MessageDonIdMaxLen)
+	copy(alignedDonId, body.DonId)
+	legacyData := [][]byte{alignedMessageId, alignedMethod, alignedDonId, body.Payload}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()
+
+	signature, err := gateway.SignData(legacyData, privateKey)
+	require.NoError(t, err)
+
+	body.Sender = utils.StringToHex(string(address))
+	msg := &gateway.Message{Body: body, Signature: utils.StringToHex(string(signature))}
+	require.NoError(t, gateway.ValidateMessageSignature(msg))
+}
+
+func TestSignatures_ExpirationIsSigned(t *testing.T) {
+	t.Parallel()
+
+	msg := &gateway.Message{
+		Body: gateway.MessageBody{
+			MessageId:  "abcd",
+			Method:     "request",
+			DonId:      "donA",
+			Expiration: 1700000000,
+			Payload:    []byte("datadata"),
+		},
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()
+
+	signature, err := gateway.SignMessage(&msg.Body, privateKey)
+	require.NoError(t, err)
+
+	msg.Signature = utils.StringToHex(string(signature))
+	msg.Body.Sender = utils.StringToHex(string(address))
+	require.NoError(t, gateway.ValidateMessageSignature(msg))
+
+	msg.Body.Expiration++
+	require.Error(t, gateway.ValidateMessageSignature(msg))
+
+	msg.Body.Expiration = 0
+	require.Error(t, gateway.ValidateMessageSignature(msg))
+}
@@ -32,6 +32,9 @@ type (
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// RestartJob stops the services of a job, if it is active, and starts them
+		// again from the spec stored in the DB.
+		RestartJob(ctx context.Context, jobID int32) error
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -335,6 +338,31 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ctx, cancel := js.chStop.Ctx(ctx)
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if exists {
+		js.stopService(jobID)
+	}
+
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error restarting job services", "type", jb.Type, "jobID", jobID, "error", err)
+		return err
+	}
+	js.lggr.Infow("Restarted job services", "type", jb.Type, "jobID", jobID)
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
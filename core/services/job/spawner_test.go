@@ -197,6 +197,43 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 
 	clearDB(t, db)
 
+	t.Run("restarts job services on 'RestartJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventually := cltest.NewAwaiter()
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventually.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), bridges.NewORM(db, lggr, config), keyStore, config)
+		mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+
+		serviceA1.On("Close").Return(nil).Twice()
+		serviceA2.On("Close").Return(nil).Twice()
+
+		require.NoError(t, spawner.RestartJob(testutils.Context(t), jobA.ID))
+		eventually.AwaitOrFail(t)
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.Close())
+	})
+
+	clearDB(t, db)
+
 	t.Run("closes job services on 'DeleteJob()'", func(t *testing.T) {
 		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
 
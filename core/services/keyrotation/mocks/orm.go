@@ -0,0 +1,229 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	common "github.com/ethereum/go-ethereum/common"
+	keyrotation "github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// ORM is an autogenerated mock type for the ORM type
+type ORM struct {
+	mock.Mock
+}
+
+// CountInFlightTransactions provides a mock function with given fields: address, qopts
+func (_m *ORM) CountInFlightTransactions(address common.Address, qopts ...pg.QOpt) (int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address, ...pg.QOpt) (int64, error)); ok {
+		return rf(address, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, ...pg.QOpt) int64); ok {
+		r0 = rf(address, qopts...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, ...pg.QOpt) error); ok {
+		r1 = rf(address, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindJobReferences provides a mock function with given fields: keyType, keyID, qopts
+func (_m *ORM) FindJobReferences(keyType keyrotation.KeyType, keyID string, qopts ...pg.QOpt) ([]keyrotation.Reference, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, keyType, keyID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []keyrotation.Reference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(keyrotation.KeyType, string, ...pg.QOpt) ([]keyrotation.Reference, error)); ok {
+		return rf(keyType, keyID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(keyrotation.KeyType, string, ...pg.QOpt) []keyrotation.Reference); ok {
+		r0 = rf(keyType, keyID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keyrotation.Reference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(keyrotation.KeyType, string, ...pg.QOpt) error); ok {
+		r1 = rf(keyType, keyID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindRotation provides a mock function with given fields: id, qopts
+func (_m *ORM) FindRotation(id int64, qopts ...pg.QOpt) (keyrotation.Rotation, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 keyrotation.Rotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) (keyrotation.Rotation, error)); ok {
+		return rf(id, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) keyrotation.Rotation); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Get(0).(keyrotation.Rotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRotations provides a mock function with given fields: qopts
+func (_m *ORM) ListRotations(qopts ...pg.QOpt) ([]keyrotation.Rotation, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []keyrotation.Rotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) ([]keyrotation.Rotation, error)); ok {
+		return rf(qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []keyrotation.Rotation); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keyrotation.Rotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRemoved provides a mock function with given fields: id, qopts
+func (_m *ORM) MarkRemoved(id int64, qopts ...pg.QOpt) (keyrotation.Rotation, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 keyrotation.Rotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) (keyrotation.Rotation, error)); ok {
+		return rf(id, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) keyrotation.Rotation); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Get(0).(keyrotation.Rotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rotate provides a mock function with given fields: keyType, oldKeyID, newKeyID, qopts
+func (_m *ORM) Rotate(keyType keyrotation.KeyType, oldKeyID string, newKeyID string, qopts ...pg.QOpt) (keyrotation.Rotation, []int32, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, keyType, oldKeyID, newKeyID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 keyrotation.Rotation
+	var r1 []int32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(keyrotation.KeyType, string, string, ...pg.QOpt) (keyrotation.Rotation, []int32, error)); ok {
+		return rf(keyType, oldKeyID, newKeyID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(keyrotation.KeyType, string, string, ...pg.QOpt) keyrotation.Rotation); ok {
+		r0 = rf(keyType, oldKeyID, newKeyID, qopts...)
+	} else {
+		r0 = ret.Get(0).(keyrotation.Rotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(keyrotation.KeyType, string, string, ...pg.QOpt) []int32); ok {
+		r1 = rf(keyType, oldKeyID, newKeyID, qopts...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(keyrotation.KeyType, string, string, ...pg.QOpt) error); ok {
+		r2 = rf(keyType, oldKeyID, newKeyID, qopts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewORM interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewORM(t mockConstructorTestingTNewORM) *ORM {
+	mock := &ORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
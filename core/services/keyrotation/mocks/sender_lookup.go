@@ -0,0 +1,58 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	big "math/big"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SenderLookup is an autogenerated mock type for the SenderLookup type
+type SenderLookup struct {
+	mock.Mock
+}
+
+// AuthorizedSenders provides a mock function with given fields: ctx, chainID, forwarder
+func (_m *SenderLookup) AuthorizedSenders(ctx context.Context, chainID *big.Int, forwarder common.Address) ([]common.Address, error) {
+	ret := _m.Called(ctx, chainID, forwarder)
+
+	var r0 []common.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, common.Address) ([]common.Address, error)); ok {
+		return rf(ctx, chainID, forwarder)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, common.Address) []common.Address); ok {
+		r0 = rf(ctx, chainID, forwarder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, common.Address) error); ok {
+		r1 = rf(ctx, chainID, forwarder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSenderLookup interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSenderLookup creates a new instance of SenderLookup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSenderLookup(t mockConstructorTestingTNewSenderLookup) *SenderLookup {
+	mock := &SenderLookup{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
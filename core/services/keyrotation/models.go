@@ -0,0 +1,80 @@
+package keyrotation
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// KeyType is the type of a rotated key.
+type KeyType string
+
+const (
+	KeyTypeEth  KeyType = "eth"
+	KeyTypeOCR2 KeyType = "ocr2"
+	KeyTypeP2P  KeyType = "p2p"
+)
+
+// ParseKeyType parses a KeyType from its name.
+func ParseKeyType(s string) (KeyType, error) {
+	switch t := KeyType(s); t {
+	case KeyTypeEth, KeyTypeOCR2, KeyTypeP2P:
+		return t, nil
+	}
+	return "", errors.Errorf("unsupported key type %q, must be one of: %s, %s, %s", s, KeyTypeEth, KeyTypeOCR2, KeyTypeP2P)
+}
+
+// Status is the status of a rotation.
+type Status string
+
+const (
+	// StatusRetiring means that references were switched to the new key,
+	// and the old key is kept until the user confirms its removal.
+	StatusRetiring Status = "retiring"
+	// StatusRemoved means that the old key was deleted.
+	StatusRemoved Status = "removed"
+)
+
+// Rotation records the replacement of OldKeyID by NewKeyID.
+type Rotation struct {
+	ID        int64      `db:"id"`
+	KeyType   KeyType    `db:"key_type"`
+	OldKeyID  string     `db:"old_key_id"`
+	NewKeyID  string     `db:"new_key_id"`
+	Status    Status     `db:"status"`
+	CreatedAt time.Time  `db:"created_at"`
+	RemovedAt *time.Time `db:"removed_at"`
+}
+
+// ReferenceKind is the kind of a Reference to a key.
+type ReferenceKind string
+
+const (
+	// ReferenceKindJob is a field of a job spec.
+	ReferenceKindJob ReferenceKind = "job"
+	// ReferenceKindForwarder is a forwarder contract, which authorizes the key as sender.
+	ReferenceKindForwarder ReferenceKind = "forwarder"
+	// ReferenceKindConfig is a node config field.
+	ReferenceKindConfig ReferenceKind = "config"
+)
+
+// Reference is a use of a key.
+type Reference struct {
+	Kind ReferenceKind `json:"kind"`
+	// JobID and JobName identify the job of a ReferenceKindJob.
+	JobID   int32  `json:"jobID,omitempty"`
+	JobName string `json:"jobName,omitempty"`
+	// Field is the job spec or config field which holds the key.
+	Field string `json:"field,omitempty"`
+	// ChainID and Address identify the contract of a ReferenceKindForwarder.
+	ChainID *utils.Big      `json:"chainID,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	// Switched is true for references which are updated by the rotation.
+	Switched bool `json:"switched"`
+	// Action is what the user has to do, e.g. for on-chain config, which
+	// the rotation can not update.
+	Action string `json:"action,omitempty"`
+}
@@ -0,0 +1,176 @@
+package keyrotation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+type ORM interface {
+	// FindJobReferences returns the job spec fields which reference the key.
+	FindJobReferences(keyType KeyType, keyID string, qopts ...pg.QOpt) ([]Reference, error)
+	// Rotate switches the job spec fields which reference the old key to the
+	// new key, and records the rotation, in one transaction. It returns the
+	// IDs of the updated jobs.
+	Rotate(keyType KeyType, oldKeyID, newKeyID string, qopts ...pg.QOpt) (Rotation, []int32, error)
+	FindRotation(id int64, qopts ...pg.QOpt) (Rotation, error)
+	ListRotations(qopts ...pg.QOpt) ([]Rotation, error)
+	// MarkRemoved completes a retiring rotation, and returns sql.ErrNoRows
+	// if there is none with the given ID.
+	MarkRemoved(id int64, qopts ...pg.QOpt) (Rotation, error)
+	// CountInFlightTransactions counts the transactions from address which
+	// are not confirmed yet.
+	CountInFlightTransactions(address common.Address, qopts ...pg.QOpt) (int64, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{q: pg.NewQ(db, lggr.Named("KeyRotationORM"), cfg)}
+}
+
+// jobReference is a job spec column which holds a key. In the conditions
+// and assignments, $1 is the old key and $2 the new one.
+type jobReference struct {
+	field  string // job spec TOML field
+	table  string
+	specFK string // column of jobs which references table
+	where  string // condition on the spec row s
+	set    string // assignment of the spec column, empty if it can not be switched
+	action string
+}
+
+// Eth keys are matched by their EIP55 address, and stored as bytea in most specs.
+const (
+	ethOld = `decode(substr($1, 3), 'hex')`
+	ethNew = `decode(substr($2, 3), 'hex')`
+)
+
+// P2P keys are matched by their raw peer ID, which is the prefix of a bootstrapper.
+const (
+	p2pWhere = `EXISTS (SELECT 1 FROM unnest(s.p2pv2_bootstrappers) b WHERE split_part(b, '@', 1) = $1)`
+	p2pSet   = `p2pv2_bootstrappers = ARRAY(
+		SELECT CASE WHEN split_part(b, '@', 1) = $1 THEN $2 || substr(b, length($1) + 1) ELSE b END
+		FROM unnest(p2pv2_bootstrappers) WITH ORDINALITY AS t(b, i) ORDER BY i)`
+	p2pAction = "update the bootstrappers of other nodes' jobs which reference this node"
+)
+
+var jobReferences = map[KeyType][]jobReference{
+	KeyTypeEth: {
+		{"transmitterAddress", "ocr_oracle_specs", "ocr_oracle_spec_id", `s.transmitter_address = ` + ethOld, `transmitter_address = ` + ethNew, "update the transmitters of the OCR contract config"},
+		{"transmitterID", "ocr2_oracle_specs", "ocr2_oracle_spec_id", `lower(s.transmitter_id) = lower($1)`, `transmitter_id = $2`, "update the transmitters of the OCR2 contract config"},
+		{"fromAddress", "keeper_specs", "keeper_spec_id", `s.from_address = ` + ethOld, `from_address = ` + ethNew, "update the keepers of the registry contract"},
+		{"fromAddresses", "vrf_specs", "vrf_spec_id", ethOld + ` = ANY(s.from_addresses)`, `from_addresses = array_replace(from_addresses, ` + ethOld + `, ` + ethNew + `)`, ""},
+		{"fromAddresses", "blockhash_store_specs", "blockhash_store_spec_id", ethOld + ` = ANY(s.from_addresses)`, `from_addresses = array_replace(from_addresses, ` + ethOld + `, ` + ethNew + `)`, ""},
+		{"fromAddresses", "block_header_feeder_specs", "block_header_feeder_spec_id", ethOld + ` = ANY(s.from_addresses)`, `from_addresses = array_replace(from_addresses, ` + ethOld + `, ` + ethNew + `)`, ""},
+		{"observationSource", "pipeline_specs", "pipeline_spec_id", `position(lower(substr($1, 3)) IN lower(s.dot_dag_source)) > 0`, "", "replace the key in the job's observationSource"},
+	},
+	KeyTypeOCR2: {
+		{"ocrKeyBundleID", "ocr2_oracle_specs", "ocr2_oracle_spec_id", `s.ocr_key_bundle_id = $1`, `ocr_key_bundle_id = $2`, "update the signers and offchain public keys of the OCR2 contract config"},
+	},
+	KeyTypeP2P: {
+		{"p2pv2Bootstrappers", "ocr_oracle_specs", "ocr_oracle_spec_id", p2pWhere, p2pSet, p2pAction},
+		{"p2pv2Bootstrappers", "ocr2_oracle_specs", "ocr2_oracle_spec_id", p2pWhere, p2pSet, p2pAction},
+	},
+}
+
+// matchValue returns the value which identifies the key in job specs.
+func matchValue(keyType KeyType, keyID string) string {
+	if keyType == KeyTypeP2P {
+		return strings.TrimPrefix(keyID, "p2p_")
+	}
+	return keyID
+}
+
+func (o *orm) FindJobReferences(keyType KeyType, keyID string, qopts ...pg.QOpt) (refs []Reference, err error) {
+	q := o.q.WithOpts(qopts...)
+	refs = []Reference{}
+	for _, jr := range jobReferences[keyType] {
+		var rows []struct {
+			ID   int32  `db:"id"`
+			Name string `db:"name"`
+		}
+		stmt := fmt.Sprintf(`SELECT j.id, COALESCE(j.name, '') AS name FROM jobs j JOIN %s s ON s.id = j.%s WHERE %s ORDER BY j.id`, jr.table, jr.specFK, jr.where)
+		if err = q.Select(&rows, stmt, matchValue(keyType, keyID)); err != nil {
+			return nil, errors.Wrapf(err, "failed to find references in %s", jr.table)
+		}
+		for _, row := range rows {
+			refs = append(refs, Reference{
+				Kind:     ReferenceKindJob,
+				JobID:    row.ID,
+				JobName:  row.Name,
+				Field:    jr.field,
+				Switched: jr.set != "",
+				Action:   jr.action,
+			})
+		}
+	}
+	return refs, nil
+}
+
+func (o *orm) Rotate(keyType KeyType, oldKeyID, newKeyID string, qopts ...pg.QOpt) (rotation Rotation, jobIDs []int32, err error) {
+	oldValue, newValue := matchValue(keyType, oldKeyID), matchValue(keyType, newKeyID)
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		seen := map[int32]bool{}
+		for _, jr := range jobReferences[keyType] {
+			if jr.set == "" {
+				continue
+			}
+			var ids []int32
+			stmt := fmt.Sprintf(`UPDATE %s s SET %s FROM jobs j WHERE j.%s = s.id AND %s RETURNING j.id`, jr.table, jr.set, jr.specFK, jr.where)
+			if err := tx.Select(&ids, stmt, oldValue, newValue); err != nil {
+				return errors.Wrapf(err, "failed to update %s", jr.table)
+			}
+			for _, id := range ids {
+				if !seen[id] {
+					seen[id] = true
+					jobIDs = append(jobIDs, id)
+				}
+			}
+		}
+		if keyType == KeyTypeEth {
+			// keeper registries are synced from keeper specs
+			if _, err := tx.Exec(`UPDATE keeper_registries SET from_address = `+ethNew+` WHERE from_address = `+ethOld, oldValue, newValue); err != nil {
+				return errors.Wrap(err, "failed to update keeper_registries")
+			}
+		}
+		stmt := `INSERT INTO key_rotations (key_type, old_key_id, new_key_id, status, created_at)
+			VALUES ($1, $2, $3, $4, NOW()) RETURNING *`
+		return errors.Wrap(tx.Get(&rotation, stmt, keyType, oldKeyID, newKeyID, StatusRetiring), "failed to insert key rotation")
+	})
+	return rotation, jobIDs, err
+}
+
+func (o *orm) FindRotation(id int64, qopts ...pg.QOpt) (rotation Rotation, err error) {
+	err = o.q.WithOpts(qopts...).Get(&rotation, `SELECT * FROM key_rotations WHERE id = $1`, id)
+	return rotation, err
+}
+
+func (o *orm) ListRotations(qopts ...pg.QOpt) (rotations []Rotation, err error) {
+	err = o.q.WithOpts(qopts...).Select(&rotations, `SELECT * FROM key_rotations ORDER BY id`)
+	return rotations, errors.Wrap(err, "failed to list key rotations")
+}
+
+func (o *orm) MarkRemoved(id int64, qopts ...pg.QOpt) (rotation Rotation, err error) {
+	stmt := `UPDATE key_rotations SET status = $2, removed_at = NOW() WHERE id = $1 AND status = $3 RETURNING *`
+	err = o.q.WithOpts(qopts...).Get(&rotation, stmt, id, StatusRemoved, StatusRetiring)
+	return rotation, err
+}
+
+func (o *orm) CountInFlightTransactions(address common.Address, qopts ...pg.QOpt) (count int64, err error) {
+	stmt := `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND state IN ('unstarted', 'in_progress', 'unconfirmed')`
+	err = o.q.WithOpts(qopts...).Get(&count, stmt, address)
+	return count, errors.Wrap(err, "failed to count in-flight transactions")
+}
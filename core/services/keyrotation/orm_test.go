@@ -0,0 +1,79 @@
+package keyrotation_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	configtest2 "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+)
+
+func TestORM_RotateEthKey(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest2.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	korm := keeper.NewORM(db, lggr, evmtest.NewChainScopedConfig(t, cfg))
+	registry, jb := cltest.MustInsertKeeperRegistry(t, db, korm, ethKeyStore, 0, 1, 20)
+	orm := keyrotation.NewORM(db, lggr, cfg)
+
+	oldKeyID := registry.FromAddress.Hex()
+	refs, err := orm.FindJobReferences(keyrotation.KeyTypeEth, oldKeyID)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.Equal(t, jb.ID, refs[0].JobID)
+	assert.Equal(t, "fromAddress", refs[0].Field)
+	assert.True(t, refs[0].Switched)
+
+	newKey, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	rotation, jobIDs, err := orm.Rotate(keyrotation.KeyTypeEth, oldKeyID, newKey.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb.ID}, jobIDs)
+	assert.Equal(t, keyrotation.StatusRetiring, rotation.Status)
+	assert.Equal(t, oldKeyID, rotation.OldKeyID)
+	assert.Equal(t, newKey.ID(), rotation.NewKeyID)
+
+	refs, err = orm.FindJobReferences(keyrotation.KeyTypeEth, oldKeyID)
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+	refs, err = orm.FindJobReferences(keyrotation.KeyTypeEth, newKey.ID())
+	require.NoError(t, err)
+	assert.Len(t, refs, 1)
+
+	updated, err := korm.Registries()
+	require.NoError(t, err)
+	require.Len(t, updated, 1)
+	assert.Equal(t, newKey.EIP55Address, updated[0].FromAddress)
+
+	_, _, err = orm.Rotate(keyrotation.KeyTypeEth, oldKeyID, newKey.ID())
+	require.Error(t, err, "a key can only be retiring once")
+
+	rotations, err := orm.ListRotations()
+	require.NoError(t, err)
+	require.Len(t, rotations, 1)
+
+	removed, err := orm.MarkRemoved(rotation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.StatusRemoved, removed.Status)
+	assert.NotNil(t, removed.RemovedAt)
+	_, err = orm.MarkRemoved(rotation.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	found, err := orm.FindRotation(rotation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keyrotation.StatusRemoved, found.Status)
+
+	count, err := orm.CountInFlightTransactions(registry.FromAddress.Address())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
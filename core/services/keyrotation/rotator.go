@@ -0,0 +1,403 @@
+package keyrotation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_receiver"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// Rotator replaces keys by new ones. A rotation creates the replacement key,
+// switches the job specs which reference the old key to it in one
+// transaction and restarts their jobs. The old key is kept, in the retiring
+// state, until the user confirms its removal, so that e.g. in-flight
+// transactions of an eth key can complete. Retiring eth keys are no longer
+// picked for new transactions.
+//
+// References which can not be switched by the node, like on-chain config
+// and forwarders, are listed with the action the user has to take.
+type Rotator interface {
+	// References returns the uses of a key.
+	References(ctx context.Context, keyType KeyType, keyID string) ([]Reference, error)
+	// Rotate creates a replacement for a key and switches its references. The
+	// returned references are the ones of the old key, before the rotation.
+	Rotate(ctx context.Context, keyType KeyType, keyID string) (Rotation, []Reference, error)
+	Rotations(ctx context.Context) ([]Rotation, error)
+	// ConfirmRemoval deletes the old key of a retiring rotation, once nothing
+	// references it anymore.
+	ConfirmRemoval(ctx context.Context, id int64) (Rotation, error)
+}
+
+// SenderLookup reads the authorized senders of forwarder contracts.
+//
+//go:generate mockery --quiet --name SenderLookup --output ./mocks/ --case=underscore
+type SenderLookup interface {
+	AuthorizedSenders(ctx context.Context, chainID *big.Int, forwarder common.Address) ([]common.Address, error)
+}
+
+// Config is the node config which references keys.
+type Config interface {
+	P2PPeerID() p2pkey.PeerID
+}
+
+type rotator struct {
+	orm     ORM
+	ks      keystore.Master
+	spawner job.Spawner
+	fwdORM  forwarders.ORM
+	senders SenderLookup
+	cfg     Config
+	lggr    logger.Logger
+}
+
+var _ Rotator = (*rotator)(nil)
+
+func NewRotator(orm ORM, ks keystore.Master, spawner job.Spawner, fwdORM forwarders.ORM, senders SenderLookup, cfg Config, lggr logger.Logger) Rotator {
+	return &rotator{
+		orm:     orm,
+		ks:      ks,
+		spawner: spawner,
+		fwdORM:  fwdORM,
+		senders: senders,
+		cfg:     cfg,
+		lggr:    lggr.Named("KeyRotator"),
+	}
+}
+
+func (r *rotator) References(ctx context.Context, keyType KeyType, keyID string) ([]Reference, error) {
+	keyID, err := r.normalizeKeyID(keyType, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return r.references(ctx, keyType, keyID)
+}
+
+func (r *rotator) references(ctx context.Context, keyType KeyType, keyID string) ([]Reference, error) {
+	refs, err := r.orm.FindJobReferences(keyType, keyID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	switch keyType {
+	case KeyTypeEth:
+		authorized, unverified, err := r.forwarderReferences(ctx, keyID)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, authorized...)
+		refs = append(refs, unverified...)
+	case KeyTypeP2P:
+		peerID := r.cfg.P2PPeerID()
+		if peerID.String() == keyID {
+			refs = append(refs, Reference{Kind: ReferenceKindConfig, Field: "P2P.PeerID", Action: "set P2P.PeerID to the new key and restart the node"})
+		} else if peerID == "" {
+			refs = append(refs, Reference{Kind: ReferenceKindConfig, Field: "P2P.PeerID", Action: "set P2P.PeerID, which is required once the node has more than one P2P key"})
+		}
+	}
+	return refs, nil
+}
+
+// forwarderReferences returns the forwarders of the key's chains, which
+// authorize it as sender, and those whose senders could not be read.
+func (r *rotator) forwarderReferences(ctx context.Context, keyID string) (authorized, unverified []Reference, err error) {
+	key, err := r.ks.Eth().Get(keyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	states, err := r.ks.Eth().GetStatesForKeys([]ethkey.KeyV2{key})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get key states")
+	}
+	for _, state := range states {
+		chainID := state.EVMChainID
+		fwds, err := r.fwdORM.FindForwardersByChain(chainID)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to find forwarders of chain %s", chainID.String())
+		}
+		for _, fwd := range fwds {
+			fwd := fwd
+			ref := Reference{
+				Kind:    ReferenceKindForwarder,
+				ChainID: &fwd.EVMChainID,
+				Address: &fwd.Address,
+				Action:  "authorize the new key as sender of the forwarder, and deauthorize the old one before confirming its removal",
+			}
+			senders, err := r.senders.AuthorizedSenders(ctx, chainID.ToInt(), fwd.Address)
+			if err != nil {
+				r.lggr.Warnw("Failed to read authorized senders of forwarder", "forwarder", fwd.Address, "evmChainID", chainID.String(), "err", err)
+				ref.Action = fmt.Sprintf("failed to read the authorized senders (%v), check whether they include the key", err)
+				unverified = append(unverified, ref)
+				continue
+			}
+			for _, sender := range senders {
+				if sender == key.Address {
+					authorized = append(authorized, ref)
+					break
+				}
+			}
+		}
+	}
+	return authorized, unverified, nil
+}
+
+func (r *rotator) Rotate(ctx context.Context, keyType KeyType, keyID string) (rotation Rotation, refs []Reference, err error) {
+	keyID, err = r.normalizeKeyID(keyType, keyID)
+	if err != nil {
+		return rotation, nil, err
+	}
+	rotations, err := r.orm.ListRotations(pg.WithParentCtx(ctx))
+	if err != nil {
+		return rotation, nil, err
+	}
+	for _, rot := range rotations {
+		if rot.KeyType == keyType && rot.OldKeyID == keyID && rot.Status == StatusRetiring {
+			return rotation, nil, errors.Errorf("key %s is already being rotated by rotation %d", keyID, rot.ID)
+		}
+	}
+
+	refs, err = r.references(ctx, keyType, keyID)
+	if err != nil {
+		return rotation, nil, err
+	}
+
+	newKeyID, err := r.createKey(keyType, keyID)
+	if err != nil {
+		return rotation, nil, errors.Wrap(err, "failed to create replacement key")
+	}
+	if keyType == KeyTypeEth {
+		// Stop picking the old key for new transactions, while letting its
+		// in-flight ones complete.
+		if err = r.ks.Eth().SetRetiring(common.HexToAddress(keyID), true); err != nil {
+			err = errors.Wrapf(err, "failed to mark key %s as retiring", keyID)
+			if derr := r.deleteKey(keyType, newKeyID); derr != nil {
+				err = multierr.Append(err, errors.Wrapf(derr, "failed to delete replacement key %s", newKeyID))
+			}
+			return rotation, nil, err
+		}
+	}
+	rotation, jobIDs, err := r.orm.Rotate(keyType, keyID, newKeyID, pg.WithParentCtx(ctx))
+	if err != nil {
+		if keyType == KeyTypeEth {
+			if rerr := r.ks.Eth().SetRetiring(common.HexToAddress(keyID), false); rerr != nil {
+				err = multierr.Append(err, errors.Wrapf(rerr, "failed to re-enable key %s", keyID))
+			}
+		}
+		if derr := r.deleteKey(keyType, newKeyID); derr != nil {
+			err = multierr.Append(err, errors.Wrapf(derr, "failed to delete replacement key %s", newKeyID))
+		}
+		return rotation, nil, err
+	}
+	r.lggr.Infow("Rotated key", "keyType", keyType, "oldKeyID", keyID, "newKeyID", newKeyID, "jobIDs", jobIDs)
+
+	for _, jobID := range jobIDs {
+		if err := r.spawner.RestartJob(ctx, jobID); err != nil {
+			r.lggr.Errorw("Failed to restart job after key rotation", "jobID", jobID, "err", err)
+		}
+	}
+	return rotation, refs, nil
+}
+
+func (r *rotator) Rotations(ctx context.Context) ([]Rotation, error) {
+	return r.orm.ListRotations(pg.WithParentCtx(ctx))
+}
+
+func (r *rotator) ConfirmRemoval(ctx context.Context, id int64) (rotation Rotation, err error) {
+	rotation, err = r.orm.FindRotation(id, pg.WithParentCtx(ctx))
+	if err != nil {
+		return rotation, err
+	}
+	if rotation.Status != StatusRetiring {
+		return rotation, errors.Errorf("rotation %d is already %s", id, rotation.Status)
+	}
+
+	refs, err := r.orm.FindJobReferences(rotation.KeyType, rotation.OldKeyID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return rotation, err
+	}
+	if len(refs) > 0 {
+		return rotation, errors.Errorf("key %s is still referenced by %d job spec field(s), e.g. %s of job %d", rotation.OldKeyID, len(refs), refs[0].Field, refs[0].JobID)
+	}
+	switch rotation.KeyType {
+	case KeyTypeEth:
+		count, err := r.orm.CountInFlightTransactions(common.HexToAddress(rotation.OldKeyID), pg.WithParentCtx(ctx))
+		if err != nil {
+			return rotation, err
+		}
+		if count > 0 {
+			return rotation, errors.Errorf("key %s has %d in-flight transaction(s)", rotation.OldKeyID, count)
+		}
+		if r.keyExists(rotation.KeyType, rotation.OldKeyID) {
+			authorized, unverified, err := r.forwarderReferences(ctx, rotation.OldKeyID)
+			if err != nil {
+				return rotation, err
+			}
+			if len(authorized) > 0 {
+				return rotation, errors.Errorf("key %s is still an authorized sender of %d forwarder(s), e.g. %s on chain %s", rotation.OldKeyID, len(authorized), authorized[0].Address.Hex(), authorized[0].ChainID.String())
+			}
+			for _, ref := range unverified {
+				r.lggr.Warnw("Removing key which may still be an authorized sender of forwarder", "keyID", rotation.OldKeyID, "forwarder", ref.Address, "evmChainID", ref.ChainID.String())
+			}
+		}
+	case KeyTypeP2P:
+		if r.cfg.P2PPeerID().String() == rotation.OldKeyID {
+			return rotation, errors.Errorf("key %s is still P2P.PeerID, set it to the new key %s and restart the node first", rotation.OldKeyID, rotation.NewKeyID)
+		}
+	}
+
+	if r.keyExists(rotation.KeyType, rotation.OldKeyID) {
+		if err = r.deleteKey(rotation.KeyType, rotation.OldKeyID); err != nil {
+			return rotation, errors.Wrapf(err, "failed to delete key %s", rotation.OldKeyID)
+		}
+	}
+	rotation, err = r.orm.MarkRemoved(id, pg.WithParentCtx(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return rotation, errors.Errorf("rotation %d is not retiring", id)
+	}
+	r.lggr.Infow("Removed rotated key", "keyType", rotation.KeyType, "keyID", rotation.OldKeyID, "rotationID", id)
+	return rotation, err
+}
+
+// normalizeKeyID returns the canonical ID of an existing key.
+func (r *rotator) normalizeKeyID(keyType KeyType, keyID string) (string, error) {
+	switch keyType {
+	case KeyTypeEth:
+		key, err := r.ks.Eth().Get(keyID)
+		if err != nil {
+			return "", err
+		}
+		if key.IsRemote() {
+			return "", errors.Errorf("key %s is held by a remote signer, and must be rotated there", key.ID())
+		}
+		return key.ID(), nil
+	case KeyTypeOCR2:
+		key, err := r.ks.OCR2().Get(keyID)
+		if err != nil {
+			return "", err
+		}
+		return key.ID(), nil
+	case KeyTypeP2P:
+		peerID, err := p2pkey.MakePeerID(keyID)
+		if err != nil {
+			return "", err
+		}
+		key, err := r.ks.P2P().Get(peerID)
+		if err != nil {
+			return "", err
+		}
+		return key.PeerID().String(), nil
+	}
+	return "", errors.Errorf("unsupported key type %q", keyType)
+}
+
+// createKey creates a key like the one with oldKeyID and returns its ID.
+func (r *rotator) createKey(keyType KeyType, oldKeyID string) (string, error) {
+	switch keyType {
+	case KeyTypeEth:
+		old, err := r.ks.Eth().Get(oldKeyID)
+		if err != nil {
+			return "", err
+		}
+		states, err := r.ks.Eth().GetStatesForKeys([]ethkey.KeyV2{old})
+		if err != nil {
+			return "", err
+		}
+		var chainIDs []*big.Int
+		for _, state := range states {
+			if !state.Disabled {
+				chainIDs = append(chainIDs, state.EVMChainID.ToInt())
+			}
+		}
+		if len(chainIDs) == 0 {
+			return "", errors.Errorf("key %s is not enabled for any chain", oldKeyID)
+		}
+		key, err := r.ks.Eth().Create(chainIDs...)
+		return key.ID(), err
+	case KeyTypeOCR2:
+		old, err := r.ks.OCR2().Get(oldKeyID)
+		if err != nil {
+			return "", err
+		}
+		key, err := r.ks.OCR2().Create(old.ChainType())
+		if err != nil {
+			return "", err
+		}
+		return key.ID(), nil
+	case KeyTypeP2P:
+		key, err := r.ks.P2P().Create()
+		return key.PeerID().String(), err
+	}
+	return "", errors.Errorf("unsupported key type %q", keyType)
+}
+
+func (r *rotator) keyExists(keyType KeyType, keyID string) bool {
+	var err error
+	switch keyType {
+	case KeyTypeEth:
+		_, err = r.ks.Eth().Get(keyID)
+	case KeyTypeOCR2:
+		_, err = r.ks.OCR2().Get(keyID)
+	case KeyTypeP2P:
+		var peerID p2pkey.PeerID
+		if peerID, err = p2pkey.MakePeerID(keyID); err == nil {
+			_, err = r.ks.P2P().Get(peerID)
+		}
+	}
+	return err == nil
+}
+
+func (r *rotator) deleteKey(keyType KeyType, keyID string) error {
+	switch keyType {
+	case KeyTypeEth:
+		_, err := r.ks.Eth().Delete(keyID)
+		return err
+	case KeyTypeOCR2:
+		return r.ks.OCR2().Delete(keyID)
+	case KeyTypeP2P:
+		peerID, err := p2pkey.MakePeerID(keyID)
+		if err != nil {
+			return err
+		}
+		_, err = r.ks.P2P().Delete(peerID)
+		return err
+	}
+	return errors.Errorf("unsupported key type %q", keyType)
+}
+
+type chainSenderLookup struct {
+	chains evm.ChainSet
+}
+
+// NewChainSenderLookup returns a SenderLookup which calls the forwarder
+// contracts on their chains.
+func NewChainSenderLookup(chains evm.ChainSet) SenderLookup {
+	return &chainSenderLookup{chains: chains}
+}
+
+func (l *chainSenderLookup) AuthorizedSenders(ctx context.Context, chainID *big.Int, forwarder common.Address) ([]common.Address, error) {
+	if l.chains == nil {
+		return nil, errors.New("EVM is disabled")
+	}
+	chain, err := l.chains.Get(chainID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := authorized_receiver.NewAuthorizedReceiverCaller(forwarder, chain.Client())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init forwarder caller")
+	}
+	return c.GetAuthorizedSenders(&bind.CallOpts{Context: ctx})
+}
@@ -0,0 +1,281 @@
+package keyrotation_test
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders"
+	fwdmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type rotatorTest struct {
+	orm     *mocks.ORM
+	senders *mocks.SenderLookup
+	fwdORM  *fwdmocks.ORM
+	spawner *jobmocks.Spawner
+	eth     *ksmocks.Eth
+	ocr2    *ksmocks.OCR2
+	p2p     *ksmocks.P2P
+	peerID  p2pkey.PeerID
+}
+
+func (rt *rotatorTest) P2PPeerID() p2pkey.PeerID { return rt.peerID }
+
+func newRotator(t *testing.T) (keyrotation.Rotator, *rotatorTest) {
+	rt := &rotatorTest{
+		orm:     mocks.NewORM(t),
+		senders: mocks.NewSenderLookup(t),
+		fwdORM:  fwdmocks.NewORM(t),
+		spawner: jobmocks.NewSpawner(t),
+		eth:     ksmocks.NewEth(t),
+		ocr2:    ksmocks.NewOCR2(t),
+		p2p:     ksmocks.NewP2P(t),
+	}
+	ks := ksmocks.NewMaster(t)
+	ks.On("Eth").Return(rt.eth).Maybe()
+	ks.On("OCR2").Return(rt.ocr2).Maybe()
+	ks.On("P2P").Return(rt.p2p).Maybe()
+	return keyrotation.NewRotator(rt.orm, ks, rt.spawner, rt.fwdORM, rt.senders, rt, logger.TestLogger(t)), rt
+}
+
+func TestRotator_RotateOCR2(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	oldKey := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	newKey := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	refs := []keyrotation.Reference{{Kind: keyrotation.ReferenceKindJob, JobID: 1, Field: "ocrKeyBundleID", Switched: true}}
+
+	t.Run("switches references and restarts jobs", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.ocr2.On("Get", oldKey.ID()).Return(oldKey, nil)
+		rt.orm.On("ListRotations", mock.Anything).Return(nil, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeOCR2, oldKey.ID(), mock.Anything).Return(refs, nil)
+		rt.ocr2.On("Create", chaintype.EVM).Return(newKey, nil)
+		rotation := keyrotation.Rotation{ID: 7, KeyType: keyrotation.KeyTypeOCR2, OldKeyID: oldKey.ID(), NewKeyID: newKey.ID(), Status: keyrotation.StatusRetiring}
+		rt.orm.On("Rotate", keyrotation.KeyTypeOCR2, oldKey.ID(), newKey.ID(), mock.Anything).Return(rotation, []int32{1}, nil)
+		rt.spawner.On("RestartJob", mock.Anything, int32(1)).Return(nil)
+
+		got, gotRefs, err := rotator.Rotate(ctx, keyrotation.KeyTypeOCR2, oldKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, rotation, got)
+		assert.Equal(t, refs, gotRefs)
+	})
+
+	t.Run("deletes the replacement if switching fails", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.ocr2.On("Get", oldKey.ID()).Return(oldKey, nil)
+		rt.orm.On("ListRotations", mock.Anything).Return(nil, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeOCR2, oldKey.ID(), mock.Anything).Return(refs, nil)
+		rt.ocr2.On("Create", chaintype.EVM).Return(newKey, nil)
+		rt.orm.On("Rotate", keyrotation.KeyTypeOCR2, oldKey.ID(), newKey.ID(), mock.Anything).Return(keyrotation.Rotation{}, nil, sql.ErrConnDone)
+		rt.ocr2.On("Delete", newKey.ID()).Return(nil)
+
+		_, _, err := rotator.Rotate(ctx, keyrotation.KeyTypeOCR2, oldKey.ID())
+		require.ErrorIs(t, err, sql.ErrConnDone)
+	})
+
+	t.Run("rejects keys which are already retiring", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.ocr2.On("Get", oldKey.ID()).Return(oldKey, nil)
+		rt.orm.On("ListRotations", mock.Anything).Return([]keyrotation.Rotation{
+			{ID: 3, KeyType: keyrotation.KeyTypeOCR2, OldKeyID: oldKey.ID(), Status: keyrotation.StatusRetiring},
+		}, nil)
+
+		_, _, err := rotator.Rotate(ctx, keyrotation.KeyTypeOCR2, oldKey.ID())
+		require.ErrorContains(t, err, "already being rotated by rotation 3")
+	})
+}
+
+func TestRotator_RotateEth(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	oldKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	newKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	chainID := utils.NewBigI(1337)
+
+	setup := func(t *testing.T) (keyrotation.Rotator, *rotatorTest) {
+		rotator, rt := newRotator(t)
+		rt.eth.On("Get", oldKey.ID()).Return(oldKey, nil)
+		rt.eth.On("GetStatesForKeys", []ethkey.KeyV2{oldKey}).Return([]ethkey.State{{Address: oldKey.EIP55Address, EVMChainID: *chainID}}, nil)
+		rt.orm.On("ListRotations", mock.Anything).Return(nil, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, oldKey.ID(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+		rt.fwdORM.On("FindForwardersByChain", *chainID).Return(nil, nil)
+		rt.eth.On("Create", big.NewInt(1337)).Return(newKey, nil)
+		return rotator, rt
+	}
+
+	t.Run("marks the old key as retiring", func(t *testing.T) {
+		rotator, rt := setup(t)
+		rt.eth.On("SetRetiring", oldKey.Address, true).Return(nil).Once()
+		rotation := keyrotation.Rotation{ID: 7, KeyType: keyrotation.KeyTypeEth, OldKeyID: oldKey.ID(), NewKeyID: newKey.ID(), Status: keyrotation.StatusRetiring}
+		rt.orm.On("Rotate", keyrotation.KeyTypeEth, oldKey.ID(), newKey.ID(), mock.Anything).Return(rotation, nil, nil)
+
+		got, _, err := rotator.Rotate(ctx, keyrotation.KeyTypeEth, oldKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, rotation, got)
+	})
+
+	t.Run("re-enables the old key if switching fails", func(t *testing.T) {
+		rotator, rt := setup(t)
+		rt.eth.On("SetRetiring", oldKey.Address, true).Return(nil).Once()
+		rt.orm.On("Rotate", keyrotation.KeyTypeEth, oldKey.ID(), newKey.ID(), mock.Anything).Return(keyrotation.Rotation{}, nil, sql.ErrConnDone)
+		rt.eth.On("SetRetiring", oldKey.Address, false).Return(nil).Once()
+		rt.eth.On("Delete", newKey.ID()).Return(newKey, nil)
+
+		_, _, err := rotator.Rotate(ctx, keyrotation.KeyTypeEth, oldKey.ID())
+		require.ErrorIs(t, err, sql.ErrConnDone)
+	})
+}
+
+func TestRotator_EthReferences(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	chainID := utils.NewBigI(1337)
+	authorized := forwarders.Forwarder{Address: testutils.NewAddress(), EVMChainID: *chainID}
+	unrelated := forwarders.Forwarder{Address: testutils.NewAddress(), EVMChainID: *chainID}
+
+	rotator, rt := newRotator(t)
+	rt.eth.On("Get", key.ID()).Return(key, nil)
+	rt.eth.On("GetStatesForKeys", []ethkey.KeyV2{key}).Return([]ethkey.State{{Address: key.EIP55Address, EVMChainID: *chainID}}, nil)
+	rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, key.ID(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+	rt.fwdORM.On("FindForwardersByChain", *chainID).Return([]forwarders.Forwarder{authorized, unrelated}, nil)
+	rt.senders.On("AuthorizedSenders", mock.Anything, big.NewInt(1337), authorized.Address).Return([]common.Address{testutils.NewAddress(), key.Address}, nil)
+	rt.senders.On("AuthorizedSenders", mock.Anything, big.NewInt(1337), unrelated.Address).Return([]common.Address{testutils.NewAddress()}, nil)
+
+	refs, err := rotator.References(ctx, keyrotation.KeyTypeEth, key.ID())
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.Equal(t, keyrotation.ReferenceKindForwarder, refs[0].Kind)
+	assert.Equal(t, authorized.Address, *refs[0].Address)
+	assert.False(t, refs[0].Switched)
+	assert.NotEmpty(t, refs[0].Action)
+}
+
+func TestRotator_P2PReferences(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key := p2pkey.MustNewV2XXXTestingOnly(big.NewInt(1))
+
+	rotator, rt := newRotator(t)
+	rt.peerID = key.PeerID()
+	rt.p2p.On("Get", key.PeerID()).Return(key, nil)
+	rt.orm.On("FindJobReferences", keyrotation.KeyTypeP2P, key.PeerID().String(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+
+	refs, err := rotator.References(ctx, keyrotation.KeyTypeP2P, key.PeerID().Raw())
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.Equal(t, keyrotation.ReferenceKindConfig, refs[0].Kind)
+	assert.Equal(t, "P2P.PeerID", refs[0].Field)
+}
+
+func TestRotator_ConfirmRemoval(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	rotation := keyrotation.Rotation{ID: 1, KeyType: keyrotation.KeyTypeEth, OldKeyID: key.ID(), NewKeyID: testutils.NewAddress().Hex(), Status: keyrotation.StatusRetiring}
+
+	t.Run("requires the old key to be unused", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(rotation, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, key.ID(), mock.Anything).Return([]keyrotation.Reference{{Kind: keyrotation.ReferenceKindJob, JobID: 2, Field: "observationSource"}}, nil)
+
+		_, err := rotator.ConfirmRemoval(ctx, 1)
+		require.ErrorContains(t, err, "observationSource of job 2")
+	})
+
+	t.Run("requires in-flight transactions to complete", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(rotation, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, key.ID(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+		rt.orm.On("CountInFlightTransactions", key.Address, mock.Anything).Return(int64(2), nil)
+
+		_, err := rotator.ConfirmRemoval(ctx, 1)
+		require.ErrorContains(t, err, "2 in-flight transaction(s)")
+	})
+
+	chainID := utils.NewBigI(1337)
+	fwd := forwarders.Forwarder{Address: testutils.NewAddress(), EVMChainID: *chainID}
+
+	t.Run("requires forwarders to deauthorize the old key", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(rotation, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, key.ID(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+		rt.orm.On("CountInFlightTransactions", key.Address, mock.Anything).Return(int64(0), nil)
+		rt.eth.On("Get", key.ID()).Return(key, nil)
+		rt.eth.On("GetStatesForKeys", []ethkey.KeyV2{key}).Return([]ethkey.State{{Address: key.EIP55Address, EVMChainID: *chainID}}, nil)
+		rt.fwdORM.On("FindForwardersByChain", *chainID).Return([]forwarders.Forwarder{fwd}, nil)
+		rt.senders.On("AuthorizedSenders", mock.Anything, big.NewInt(1337), fwd.Address).Return([]common.Address{key.Address}, nil)
+
+		_, err := rotator.ConfirmRemoval(ctx, 1)
+		require.ErrorContains(t, err, "still an authorized sender of 1 forwarder(s), e.g. "+fwd.Address.Hex())
+	})
+
+	t.Run("requires P2P.PeerID to be switched", func(t *testing.T) {
+		p2pKey := p2pkey.MustNewV2XXXTestingOnly(big.NewInt(1))
+		p2pRotation := keyrotation.Rotation{ID: 1, KeyType: keyrotation.KeyTypeP2P, OldKeyID: p2pKey.PeerID().String(), Status: keyrotation.StatusRetiring}
+		rotator, rt := newRotator(t)
+		rt.peerID = p2pKey.PeerID()
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(p2pRotation, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeP2P, p2pKey.PeerID().String(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+
+		_, err := rotator.ConfirmRemoval(ctx, 1)
+		require.ErrorContains(t, err, "is still P2P.PeerID")
+	})
+
+	t.Run("deletes the old key", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(rotation, nil)
+		rt.orm.On("FindJobReferences", keyrotation.KeyTypeEth, key.ID(), mock.Anything).Return([]keyrotation.Reference{}, nil)
+		rt.orm.On("CountInFlightTransactions", key.Address, mock.Anything).Return(int64(0), nil)
+		rt.eth.On("Get", key.ID()).Return(key, nil)
+		rt.eth.On("GetStatesForKeys", []ethkey.KeyV2{key}).Return([]ethkey.State{{Address: key.EIP55Address, EVMChainID: *chainID}}, nil)
+		rt.fwdORM.On("FindForwardersByChain", *chainID).Return([]forwarders.Forwarder{fwd}, nil)
+		rt.senders.On("AuthorizedSenders", mock.Anything, big.NewInt(1337), fwd.Address).Return([]common.Address{testutils.NewAddress()}, nil)
+		rt.eth.On("Delete", key.ID()).Return(key, nil)
+		removed := rotation
+		removed.Status = keyrotation.StatusRemoved
+		rt.orm.On("MarkRemoved", int64(1), mock.Anything).Return(removed, nil)
+
+		got, err := rotator.ConfirmRemoval(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, keyrotation.StatusRemoved, got.Status)
+	})
+
+	t.Run("rejects removed rotations", func(t *testing.T) {
+		rotator, rt := newRotator(t)
+		removed := rotation
+		removed.Status = keyrotation.StatusRemoved
+		rt.orm.On("FindRotation", int64(1), mock.Anything).Return(removed, nil)
+
+		_, err := rotator.ConfirmRemoval(ctx, 1)
+		require.ErrorContains(t, err, "already removed")
+	})
+}
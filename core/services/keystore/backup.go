@@ -0,0 +1,166 @@
+package keystore
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// BackupVersion is the version of the backup archives written by Backup.
+const BackupVersion = 1
+
+// backupArchive is the file format of a keystore backup. The content is
+// encrypted with the backup password, and the key ring within it remains
+// encrypted with the keystore password.
+type backupArchive struct {
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+type backupContent struct {
+	EncryptedKeys json.RawMessage `json:"encryptedKeys"`
+	EVMKeyStates  []ethkey.State  `json:"evmKeyStates"`
+}
+
+// RestoreSummary describes what a backup contains, and the records in the
+// database which prevent it from being restored.
+type RestoreSummary struct {
+	Version      int
+	CreatedAt    time.Time
+	HasKeyRing   bool
+	EVMKeyStates []ethkey.State
+	Conflicts    []string
+}
+
+// Backup exports the encrypted key ring and the EVM key states into a
+// single archive, encrypted with the given password.
+func (orm ksORM) Backup(password string, scryptParams utils.ScryptParams) ([]byte, error) {
+	if password == "" {
+		return nil, errors.New("backup password must not be empty")
+	}
+
+	var content backupContent
+	err := orm.q.Transaction(func(tx pg.Queryer) error {
+		var kr encryptedKeyRing
+		if err := tx.Get(&kr, `SELECT * FROM encrypted_key_rings LIMIT 1`); err != nil {
+			return errors.Wrap(err, "failed to load key ring")
+		}
+		content.EncryptedKeys = kr.EncryptedKeys
+		err := tx.Select(&content.EVMKeyStates, `SELECT id, address, evm_chain_id, next_nonce, disabled, created_at, updated_at FROM evm_key_states ORDER BY id`)
+		return errors.Wrap(err, "failed to load evm_key_states")
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(content.EncryptedKeys) == 0 {
+		return nil, errors.New("key ring is empty, nothing to back up")
+	}
+
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(password), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt backup")
+	}
+	return json.Marshal(backupArchive{
+		Version:   BackupVersion,
+		CreatedAt: time.Now(),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// Restore imports a backup written by Backup. The database must not hold a
+// key ring or EVM key states yet, in which case the conflicts are listed in
+// the summary and nothing is written. With dryRun, the archive is only
+// decrypted and checked.
+func (orm ksORM) Restore(archive []byte, password string, dryRun bool) (summary RestoreSummary, err error) {
+	content, err := decryptBackup(archive, password, &summary)
+	if err != nil {
+		return summary, err
+	}
+	summary.HasKeyRing = len(content.EncryptedKeys) > 0
+	summary.EVMKeyStates = content.EVMKeyStates
+
+	err = orm.q.Transaction(func(tx pg.Queryer) error {
+		if err = findRestoreConflicts(tx, &summary); err != nil {
+			return err
+		}
+		if dryRun || len(summary.Conflicts) > 0 {
+			return nil
+		}
+
+		if _, err = tx.Exec(`DELETE FROM encrypted_key_rings`); err != nil {
+			return errors.Wrap(err, "failed to delete empty key ring")
+		}
+		if _, err = tx.Exec(`INSERT INTO encrypted_key_rings (encrypted_keys, updated_at) VALUES ($1, NOW())`, []byte(content.EncryptedKeys)); err != nil {
+			return errors.Wrap(err, "failed to insert key ring")
+		}
+		for _, state := range content.EVMKeyStates {
+			if _, err = tx.Exec(`INSERT INTO evm_key_states (address, evm_chain_id, next_nonce, disabled, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, NOW())`,
+				state.Address, state.EVMChainID, state.NextNonce, state.Disabled, state.CreatedAt); err != nil {
+				return errors.Wrapf(err, "failed to insert key state for %s on chain %s", state.Address, state.EVMChainID.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return summary, err
+	}
+	if len(summary.Conflicts) > 0 && !dryRun {
+		return summary, errors.Errorf("cannot restore into a database which already holds keys: %s", strings.Join(summary.Conflicts, "; "))
+	}
+	return summary, nil
+}
+
+func decryptBackup(archive []byte, password string, summary *RestoreSummary) (content backupContent, err error) {
+	var ba backupArchive
+	if err = json.Unmarshal(archive, &ba); err != nil {
+		return content, errors.Wrap(err, "invalid backup archive")
+	}
+	summary.Version = ba.Version
+	summary.CreatedAt = ba.CreatedAt
+	if ba.Version != BackupVersion {
+		return content, errors.Errorf("unsupported backup version %d, expected %d", ba.Version, BackupVersion)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(ba.Crypto, password)
+	if err != nil {
+		return content, errors.Wrap(err, "failed to decrypt backup")
+	}
+	if err = json.Unmarshal(plaintext, &content); err != nil {
+		return content, errors.Wrap(err, "invalid backup content")
+	}
+	return content, nil
+}
+
+func findRestoreConflicts(tx pg.Queryer, summary *RestoreSummary) error {
+	var rings []encryptedKeyRing
+	if err := tx.Select(&rings, `SELECT * FROM encrypted_key_rings`); err != nil {
+		return errors.Wrap(err, "failed to load key ring")
+	}
+	for _, kr := range rings {
+		if len(kr.EncryptedKeys) > 0 {
+			summary.Conflicts = append(summary.Conflicts, "the database already has a key ring")
+			break
+		}
+	}
+
+	var states []ethkey.State
+	if err := tx.Select(&states, `SELECT id, address, evm_chain_id, next_nonce, disabled, created_at, updated_at FROM evm_key_states ORDER BY id`); err != nil {
+		return errors.Wrap(err, "failed to load evm_key_states")
+	}
+	for _, state := range states {
+		summary.Conflicts = append(summary.Conflicts, fmt.Sprintf("the database already has a key state for %s on chain %s", state.Address, state.EVMChainID.String()))
+	}
+	return nil
+}
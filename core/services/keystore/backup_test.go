@@ -0,0 +1,80 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestORM_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	cfg := pgtest.NewQConfig(false)
+	const password = "backup-password"
+
+	db := pgtest.NewSqlxDB(t)
+	ks := cltest.NewKeyStore(t, db, cfg)
+	ethKey, _ := cltest.MustInsertRandomKey(t, ks.Eth())
+	p2pKey, err := ks.P2P().Create()
+	require.NoError(t, err)
+	orm := keystore.NewORM(db, logger.TestLogger(t), cfg)
+
+	archive, err := orm.Backup(password, utils.FastScryptParams)
+	require.NoError(t, err)
+
+	t.Run("rejects an empty password", func(t *testing.T) {
+		_, err := orm.Backup("", utils.FastScryptParams)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		_, err := orm.Restore(archive, "wrong", true)
+		require.Error(t, err)
+	})
+
+	t.Run("lists conflicts with the existing keys", func(t *testing.T) {
+		summary, err := orm.Restore(archive, password, true)
+		require.NoError(t, err)
+		assert.NotEmpty(t, summary.Conflicts)
+
+		_, err = orm.Restore(archive, password, false)
+		require.Error(t, err)
+	})
+
+	t.Run("restores into an empty database", func(t *testing.T) {
+		emptyDB := pgtest.NewSqlxDB(t)
+		emptyORM := keystore.NewORM(emptyDB, logger.TestLogger(t), cfg)
+
+		summary, err := emptyORM.Restore(archive, password, true)
+		require.NoError(t, err)
+		assert.Equal(t, keystore.BackupVersion, summary.Version)
+		assert.True(t, summary.HasKeyRing)
+		require.Len(t, summary.EVMKeyStates, 1)
+		assert.Equal(t, ethKey.EIP55Address, summary.EVMKeyStates[0].Address)
+		assert.Empty(t, summary.Conflicts)
+
+		_, err = emptyORM.Restore(archive, password, false)
+		require.NoError(t, err)
+
+		restored := keystore.New(emptyDB, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, restored.Unlock(cltest.Password))
+		_, err = restored.Eth().Get(ethKey.ID())
+		require.NoError(t, err)
+		_, err = restored.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		states, err := restored.Eth().GetStatesForKeys([]ethkey.KeyV2{ethKey})
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+
+		_, err = emptyORM.Restore(archive, password, false)
+		require.Error(t, err, "a database can only be restored once")
+	})
+}
@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -31,9 +32,14 @@ type Eth interface {
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	AddRemote(address common.Address, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	SetRemoteSigner(signer EthSigner)
 
 	Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
 	Disable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
+	// SetRetiring marks a key as being replaced on all its chains. Retiring
+	// keys are not returned by GetRoundRobinAddress.
+	SetRetiring(address common.Address, retiring bool, qopts ...pg.QOpt) error
 	Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error
 
 	NextSequence(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (evmtypes.Nonce, error)
@@ -57,8 +63,15 @@ type Eth interface {
 	XXXTestingOnlyAdd(key ethkey.KeyV2)
 }
 
+// EthSigner signs transactions for eth keys whose private keys are held
+// outside of the keystore.
+type EthSigner interface {
+	SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
 type eth struct {
 	*keyManager
+	remoteSigner  EthSigner
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
 }
@@ -180,9 +193,41 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		return nil, errors.Errorf("eth key %s is held by the remote signer and cannot be exported", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
+// AddRemote registers the address of a key held by the remote signer, and
+// enables it for the given chain IDs. Transactions from it are signed by the
+// signer set with SetRemoteSigner.
+func (ks *eth) AddRemote(address common.Address, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.FromAddress(address)
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	err := ks.add(key, chainIDs...)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Added remote EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "evmChainIDs", chainIDs)
+	return key, nil
+}
+
+// SetRemoteSigner sets the signer of the keys added with AddRemote.
+func (ks *eth) SetRemoteSigner(signer EthSigner) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	ks.remoteSigner = signer
+}
+
 // Get the next nonce for the given key and chain. It is safest to always to go the DB for this
 func (ks *eth) NextSequence(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (nonce evmtypes.Nonce, err error) {
 	if !ks.exists(address) {
@@ -245,7 +290,7 @@ func (ks *eth) enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt
 VALUES ($1, 0, false, $2, NOW(), NOW()) ON CONFLICT (evm_chain_id, address) DO UPDATE SET
 disabled=false,
 updated_at=NOW()
-RETURNING id, next_nonce, address, evm_chain_id, disabled, created_at, updated_at;`
+RETURNING id, next_nonce, address, evm_chain_id, disabled, retiring, created_at, updated_at;`
 	q := ks.orm.q.WithOpts(qopts...)
 	if err := q.Get(state, sql, address, chainID.String()); err != nil {
 		return errors.Wrap(err, "failed to insert evm_key_state")
@@ -277,6 +322,24 @@ func (ks *eth) disable(address common.Address, chainID *big.Int, qopts ...pg.QOp
 	return nil
 }
 
+func (ks *eth) SetRetiring(address common.Address, retiring bool, qopts ...pg.QOpt) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	_, found := ks.keyRing.Eth[address.Hex()]
+	if !found {
+		return errors.Errorf("no key exists with ID %s", address.Hex())
+	}
+	q := ks.orm.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE evm_key_states SET retiring = $1, updated_at = NOW() WHERE address = $2`, retiring, address)
+	if err != nil {
+		return errors.Wrap(err, "failed to set retiring state")
+	}
+
+	ks.keyStates.setRetiring(address, retiring)
+	ks.notify()
+	return nil
+}
+
 // Reset the key/chain nonce to the given one
 func (ks *eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error {
 	q := ks.orm.q.WithOpts(qopts...)
@@ -342,14 +405,23 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ks.lock.RLock()
-	defer ks.lock.RUnlock()
 	if ks.isLocked() {
+		ks.lock.RUnlock()
 		return nil, ErrLocked
 	}
 	key, err := ks.getByID(address.String())
+	remoteSigner := ks.remoteSigner
+	ks.lock.RUnlock()
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		// The keystore lock is not held while waiting for the remote signer.
+		if remoteSigner == nil {
+			return nil, errors.Errorf("eth key %s is held by a remote signer, but no remote signer is enabled", key.ID())
+		}
+		return remoteSigner.SignTx(context.Background(), address, tx, chainID)
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
@@ -377,11 +449,19 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 		return common.Address{}, ErrLocked
 	}
 
+	states := ks.keyStates.ChainIDKeyID[chainID.String()]
 	var keys []ethkey.KeyV2
 	if len(whitelist) == 0 {
-		keys = ks.enabledKeysForChain(chainID)
+		for _, k := range ks.enabledKeysForChain(chainID) {
+			if !states[k.ID()].Retiring {
+				keys = append(keys, k)
+			}
+		}
 	} else if len(whitelist) > 0 {
 		for _, k := range ks.enabledKeysForChain(chainID) {
+			if states[k.ID()].Retiring {
+				continue
+			}
 			for _, addr := range whitelist {
 				if addr == k.Address {
 					keys = append(keys, k)
@@ -404,7 +484,6 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 		return common.Address{}, err
 	}
 
-	states := ks.keyStates.ChainIDKeyID[chainID.String()]
 	sort.SliceStable(keys, func(i, j int) bool {
 		return states[keys[i].ID()].LastUsed().Before(states[keys[j].ID()].LastUsed())
 	})
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,8 +21,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/remotesignertest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -319,6 +323,26 @@ func Test_EthKeyStore_GetRoundRobinAddress(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, fmt.Sprintf("no sending keys available for chain %s that match whitelist: [%s]", testutils.FixtureChainID.String(), addr.Hex()), err.Error())
 	})
+
+	t.Run("skips retiring keys", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.SetRetiring(k1.Address, true))
+		t.Cleanup(func() { require.NoError(t, ethKeyStore.SetRetiring(k1.Address, false)) })
+
+		for i := 0; i < 4; i++ {
+			address, err := ethKeyStore.GetRoundRobinAddress(testutils.FixtureChainID)
+			require.NoError(t, err)
+			assert.NotEqual(t, k1.Address, address)
+		}
+
+		_, err := ethKeyStore.GetRoundRobinAddress(testutils.SimulatedChainID, k1.Address)
+		require.Error(t, err)
+
+		// Retiring keys remain enabled, so that their in-flight transactions complete.
+		require.NoError(t, ethKeyStore.CheckEnabled(k1.Address, testutils.FixtureChainID))
+		state, err := ethKeyStore.GetState(k1.Address.Hex(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, state.Retiring)
+	})
 }
 
 func Test_EthKeyStore_SignTx(t *testing.T) {
@@ -344,6 +368,45 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignTx_Remote(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	ethKeyStore := keyStore.Eth()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+	chainID := big.NewInt(evmclient.NullClientChainID)
+
+	k, err := ethKeyStore.AddRemote(address, chainID)
+	require.NoError(t, err)
+	require.True(t, k.IsRemote())
+	require.NoError(t, ethKeyStore.CheckEnabled(address, chainID))
+
+	_, err = ethKeyStore.AddRemote(address, chainID)
+	require.EqualError(t, err, fmt.Sprintf("key with ID %s already exists", address.Hex()))
+	_, err = ethKeyStore.Export(k.ID(), cltest.Password)
+	require.EqualError(t, err, fmt.Sprintf("eth key %s is held by the remote signer and cannot be exported", address.Hex()))
+
+	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+	_, err = ethKeyStore.SignTx(address, tx, chainID)
+	require.EqualError(t, err, fmt.Sprintf("eth key %s is held by a remote signer, but no remote signer is enabled", address.Hex()))
+
+	signer, err := remotesigner.NewWeb3Signer(remotesignertest.NewServer(t, privKey), time.Minute, logger.TestLogger(t))
+	require.NoError(t, err)
+	ethKeyStore.SetRemoteSigner(signer)
+
+	signed, err := ethKeyStore.SignTx(address, tx, chainID)
+	require.NoError(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.NoError(t, err)
+	require.Equal(t, address, sender)
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
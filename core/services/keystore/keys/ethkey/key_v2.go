@@ -64,6 +64,15 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a key for an address whose private key is held by a
+// remote signer.
+func FromAddress(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: EIP55AddressFromAddress(address),
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -76,6 +85,12 @@ func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
 
+// IsRemote returns true if the private key is held by a remote signer, and
+// the key can only sign through it.
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) String() string {
 	return fmt.Sprintf("EthKeyV2{PrivateKey: <redacted>, Address: %s}", key.Address)
 }
@@ -14,6 +14,9 @@ type State struct {
 	// truth is always the DB
 	NextNonce int64
 	Disabled  bool
+	// Retiring keys are being replaced by a key rotation. They still
+	// complete their in-flight transactions, but are not picked for new ones.
+	Retiring  bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	lastUsed  time.Time
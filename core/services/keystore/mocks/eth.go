@@ -10,6 +10,8 @@ import (
 
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
+	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -22,6 +24,37 @@ type Eth struct {
 	mock.Mock
 }
 
+// AddRemote provides a mock function with given fields: address, chainIDs
+func (_m *Eth) AddRemote(address common.Address, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 ethkey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address, ...*big.Int) (ethkey.KeyV2, error)); ok {
+		return rf(address, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(address, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, ...*big.Int) error); ok {
+		r1 = rf(address, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckEnabled provides a mock function with given fields: address, chainID
 func (_m *Eth) CheckEnabled(address common.Address, chainID *big.Int) error {
 	ret := _m.Called(address, chainID)
@@ -493,6 +526,32 @@ func (_m *Eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopt
 	return r0
 }
 
+// SetRemoteSigner provides a mock function with given fields: signer
+func (_m *Eth) SetRemoteSigner(signer keystore.EthSigner) {
+	_m.Called(signer)
+}
+
+// SetRetiring provides a mock function with given fields: address, retiring, qopts
+func (_m *Eth) SetRetiring(address common.Address, retiring bool, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, retiring)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, bool, ...pg.QOpt) error); ok {
+		r0 = rf(address, retiring, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *coretypes.Transaction, chainID *big.Int) (*coretypes.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
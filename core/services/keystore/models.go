@@ -127,6 +127,13 @@ func (ks *keyStates) disable(addr common.Address, chainID *big.Int) {
 	state.Disabled = true
 }
 
+// warning: not thread-safe! caller must sync
+func (ks *keyStates) setRetiring(addr common.Address, retiring bool) {
+	for _, state := range ks.KeyIDChainID[addr.Hex()] {
+		state.Retiring = retiring
+	}
+}
+
 // warning: not thread-safe! caller must sync
 func (ks *keyStates) delete(addr common.Address) {
 	var chainIDs []*big.Int
@@ -207,6 +214,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		if ethKey.IsRemote() {
+			rawKeys.EthRemote = append(rawKeys.EthRemote, ethKey.Address)
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -328,6 +339,7 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // (like public keys) to the database
 type rawKeyRing struct {
 	Eth        []ethkey.Raw
+	EthRemote  []common.Address
 	CSA        []csakey.Raw
 	OCR        []ocrkey.Raw
 	OCR2       []ocr2key.Raw
@@ -351,6 +363,10 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		ethKey := rawETHKey.Key()
 		keyRing.Eth[ethKey.ID()] = ethKey
 	}
+	for _, address := range rawKeys.EthRemote {
+		ethKey := ethkey.FromAddress(address)
+		keyRing.Eth[ethKey.ID()] = ethKey
+	}
 	for _, rawOCRKey := range rawKeys.OCR {
 		ocrKey := rawOCRKey.Key()
 		keyRing.OCR[ocrKey.ID()] = ocrKey
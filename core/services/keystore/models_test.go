@@ -6,8 +6,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
@@ -27,6 +29,7 @@ const password = "password"
 func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
 	eth1, eth2 := mustNewEthKey(t), mustNewEthKey(t)
+	ethRemote := ethkey.FromAddress(testutils.NewAddress())
 	ocr := []ocrkey.KeyV2{
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(1)),
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(2)),
@@ -47,6 +50,7 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	originalKeyRingRaw := rawKeyRing{
 		CSA:        []csakey.Raw{csa1.Raw(), csa2.Raw()},
 		Eth:        []ethkey.Raw{eth1.Raw(), eth2.Raw()},
+		EthRemote:  []common.Address{ethRemote.Address},
 		OCR:        []ocrkey.Raw{ocr[0].Raw(), ocr[1].Raw()},
 		OCR2:       ocr2_raw,
 		P2P:        []p2pkey.Raw{p2p1.Raw(), p2p2.Raw()},
@@ -73,9 +77,12 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Equal(t, originalKeyRing.CSA[csa1.ID()].PublicKey, decryptedKeyRing.CSA[csa1.ID()].PublicKey)
 		require.Equal(t, originalKeyRing.CSA[csa2.ID()].PublicKey, decryptedKeyRing.CSA[csa2.ID()].PublicKey)
 		// compare eth keys
-		require.Equal(t, 2, len(decryptedKeyRing.Eth))
+		require.Equal(t, 3, len(decryptedKeyRing.Eth))
 		require.Equal(t, originalKeyRing.Eth[eth1.ID()].Address, decryptedKeyRing.Eth[eth1.ID()].Address)
 		require.Equal(t, originalKeyRing.Eth[eth2.ID()].Address, decryptedKeyRing.Eth[eth2.ID()].Address)
+		require.False(t, decryptedKeyRing.Eth[eth1.ID()].IsRemote())
+		require.Equal(t, ethRemote.Address, decryptedKeyRing.Eth[ethRemote.ID()].Address)
+		require.True(t, decryptedKeyRing.Eth[ethRemote.ID()].IsRemote())
 		// compare ocr keys
 		require.Equal(t, 2, len(decryptedKeyRing.OCR))
 		require.Equal(t, originalKeyRing.OCR[ocr[0].ID()].OnChainSigning.X, decryptedKeyRing.OCR[ocr[0].ID()].OnChainSigning.X)
@@ -67,7 +67,7 @@ func (orm ksORM) getEncryptedKeyRing() (kr encryptedKeyRing, err error) {
 func (orm ksORM) loadKeyStates() (*keyStates, error) {
 	ks := newKeyStates()
 	var ethkeystates []*ethkey.State
-	if err := orm.q.Select(&ethkeystates, `SELECT id, address, evm_chain_id, next_nonce, disabled, created_at, updated_at FROM evm_key_states`); err != nil {
+	if err := orm.q.Select(&ethkeystates, `SELECT id, address, evm_chain_id, next_nonce, disabled, retiring, created_at, updated_at FROM evm_key_states`); err != nil {
 		return ks, errors.Wrap(err, "error loading evm_key_states from DB")
 	}
 	for _, state := range ethkeystates {
@@ -0,0 +1,112 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+var _ keystore.EthSigner = (*Web3Signer)(nil)
+
+// Web3Signer signs transactions with an external signer over the
+// eth_signTransaction JSON-RPC method, as served by Web3Signer.
+type Web3Signer struct {
+	client  *rpc.Client
+	timeout time.Duration
+	lggr    logger.Logger
+}
+
+// SignTxArgs are the parameters of eth_signTransaction.
+type SignTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+// NewWeb3Signer returns a signer for the JSON-RPC endpoint at u. Each signing
+// request is cancelled after timeout.
+func NewWeb3Signer(u *url.URL, timeout time.Duration, lggr logger.Logger) (*Web3Signer, error) {
+	if u == nil {
+		return nil, errors.New("remote signer URL is required")
+	}
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create remote signer client")
+	}
+	return &Web3Signer{
+		client:  client,
+		timeout: timeout,
+		lggr:    lggr.Named("Web3Signer"),
+	}, nil
+}
+
+// SignTx asks the signer to sign tx from address, and checks that the returned
+// transaction is the one requested, signed by address.
+func (s *Web3Signer) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := newSignTxArgs(address, tx, chainID)
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var raw hexutil.Bytes
+	if err := s.client.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign transaction from %s", address)
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.Errorf("remote signer returned a different transaction %s than requested", signed.Hash())
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned a transaction with an invalid signature")
+	}
+	if sender != address {
+		return nil, errors.Errorf("remote signer signed transaction with %s, expected %s", sender, address)
+	}
+	s.lggr.Debugw("Signed transaction with remote signer", "address", address, "txHash", signed.Hash(), "nonce", tx.Nonce())
+	return signed, nil
+}
+
+func newSignTxArgs(address common.Address, tx *types.Transaction, chainID *big.Int) SignTxArgs {
+	args := SignTxArgs{
+		From:    address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	if al := tx.AccessList(); len(al) > 0 {
+		args.AccessList = &al
+	}
+	return args
+}
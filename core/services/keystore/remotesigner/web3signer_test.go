@@ -0,0 +1,62 @@
+package remotesigner_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/remotesignertest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+func TestWeb3Signer_SignTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(1337)
+	to := testutils.NewAddress()
+
+	u := remotesignertest.NewServer(t, key)
+	signer, err := remotesigner.NewWeb3Signer(u, time.Minute, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name string
+		tx   *types.Transaction
+	}{
+		{"legacy", types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(53), Data: []byte{1, 2, 3}})},
+		{"access list", types.NewTx(&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(0), AccessList: types.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}}})},
+		{"dynamic fee", types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(200), Gas: 21000, To: &to, Value: big.NewInt(7)})},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			signed, err := signer.SignTx(testutils.Context(t), address, test.tx, chainID)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.tx.Type(), signed.Type())
+			assert.Equal(t, test.tx.Nonce(), signed.Nonce())
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender)
+		})
+	}
+
+	t.Run("unknown account", func(t *testing.T) {
+		tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(0)})
+		other := testutils.NewAddress()
+		_, err := signer.SignTx(testutils.Context(t), other, tx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote signer failed to sign transaction from "+other.String())
+		assert.Contains(t, err.Error(), "unknown account")
+	})
+}
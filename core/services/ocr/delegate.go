@@ -215,7 +215,10 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 		}
 
 		cfg := chain.Config()
-		strategy := txmgr.NewQueueingTxStrategy(jb.ExternalJobID, cfg.OCRDefaultTransactionQueueDepth(), cfg.DatabaseDefaultQueryTimeout())
+		strategy := txmgr.NewPriorityTxStrategy(
+			txmgr.NewQueueingTxStrategy(jb.ExternalJobID, cfg.OCRDefaultTransactionQueueDepth(), cfg.DatabaseDefaultQueryTimeout()),
+			cfg.EvmTxPriority(string(job.OffchainReporting)),
+		)
 
 		var checker txmgr.EvmTransmitCheckerSpec
 		if chain.Config().OCRSimulateTransactions() {
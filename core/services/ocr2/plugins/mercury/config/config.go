@@ -8,36 +8,94 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
 
 	pkgerrors "github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+// ServerPolicy is how the answers of several mercury servers to the same
+// query are combined.
+type ServerPolicy string
+
+const (
+	// ServerPolicyFirstSuccess uses the first successful answer.
+	ServerPolicyFirstSuccess ServerPolicy = "firstSuccess"
+	// ServerPolicyConsensus uses the answer of a strict majority of the
+	// servers.
+	ServerPolicyConsensus ServerPolicy = "consensus"
+)
+
 type PluginConfig struct {
 	RawServerURL string              `json:"serverURL" toml:"serverURL"`
 	ServerPubKey utils.PlainHexBytes `json:"serverPubKey" toml:"serverPubKey"`
+	// Servers maps the URLs of additional mercury servers to their public
+	// keys. Every report is transmitted to each of them.
+	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
+	// ServerPolicy is how the latest reports fetched from the servers are
+	// combined. Defaults to ServerPolicyFirstSuccess.
+	ServerPolicy ServerPolicy `json:"serverPolicy" toml:"serverPolicy"`
+	// ReportSchemaVersion is the layout of the reports of the feed, which
+	// selects how observations are parsed and reports built. Defaults to
+	// reportcodec.DefaultReportSchemaVersion.
+	ReportSchemaVersion reportcodec.ReportSchemaVersion `json:"reportSchemaVersion" toml:"reportSchemaVersion"`
+}
+
+// Server is a mercury server the reports are transmitted to.
+type Server struct {
+	URL    string
+	PubKey utils.PlainHexBytes
 }
 
 func ValidatePluginConfig(config PluginConfig) (merr error) {
-	if config.RawServerURL == "" {
+	if config.RawServerURL == "" && len(config.Servers) == 0 {
 		merr = errors.New("Mercury: ServerURL must be specified")
-	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(config.RawServerURL) {
-			normalizedURI = config.RawServerURL
-		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", config.RawServerURL)
+	} else if config.RawServerURL != "" {
+		merr = validateServer(config.RawServerURL, config.ServerPubKey, "ServerPubKey is required and must be a 32-byte hex string")
+	}
+	urls := make([]string, 0, len(config.Servers))
+	for u := range config.Servers {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	for _, u := range urls {
+		if u == "" {
+			merr = errors.Join(merr, errors.New("Mercury: server URL must not be empty"))
+			continue
 		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
-		} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
-			merr = pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, config.RawServerURL, uri.Scheme)
+		merr = errors.Join(merr, validateServer(u, config.Servers[u], fmt.Sprintf("public key of server %q must be a 32-byte hex string", u)))
+		if config.RawServerURL != "" && wssRegexp.ReplaceAllString(u, "") == config.ServerURL() {
+			merr = errors.Join(merr, pkgerrors.Errorf("Mercury: server %q is specified twice", u))
 		}
 	}
-	if len(config.ServerPubKey) != 32 {
-		merr = errors.Join(merr, errors.New("Mercury: ServerPubKey is required and must be a 32-byte hex string"))
+	switch config.ServerPolicy {
+	case "", ServerPolicyFirstSuccess, ServerPolicyConsensus:
+	default:
+		merr = errors.Join(merr, pkgerrors.Errorf("Mercury: invalid ServerPolicy %q, expected %q or %q", config.ServerPolicy, ServerPolicyFirstSuccess, ServerPolicyConsensus))
+	}
+	if v := config.GetReportSchemaVersion(); !reportcodec.IsSupported(v) {
+		merr = errors.Join(merr, pkgerrors.Errorf("Mercury: unsupported ReportSchemaVersion %d, supported: %v", v, reportcodec.SupportedVersions()))
+	}
+	return merr
+}
+
+func validateServer(rawURL string, pubKey utils.PlainHexBytes, pubKeyErr string) (merr error) {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawURL) {
+		normalizedURI = rawURL
+	} else {
+		normalizedURI = fmt.Sprintf("wss://%s", rawURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		merr = pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
+	} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
+		merr = pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawURL, uri.Scheme)
+	}
+	if len(pubKey) != 32 {
+		merr = errors.Join(merr, errors.New("Mercury: "+pubKeyErr))
 	}
 	return merr
 }
@@ -48,3 +106,38 @@ var wssRegexp = regexp.MustCompile(`^wss://`)
 func (p PluginConfig) ServerURL() string {
 	return wssRegexp.ReplaceAllString(p.RawServerURL, "")
 }
+
+// GetServers returns every mercury server of the config, i.e. ServerURL
+// followed by Servers sorted by URL, with their URL normalized as by
+// ServerURL.
+func (p PluginConfig) GetServers() []Server {
+	var servers []Server
+	if p.RawServerURL != "" {
+		servers = append(servers, Server{URL: p.ServerURL(), PubKey: p.ServerPubKey})
+	}
+	urls := make([]string, 0, len(p.Servers))
+	for u := range p.Servers {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	for _, u := range urls {
+		servers = append(servers, Server{URL: wssRegexp.ReplaceAllString(u, ""), PubKey: p.Servers[u]})
+	}
+	return servers
+}
+
+// GetServerPolicy returns ServerPolicy, or its default if unset.
+func (p PluginConfig) GetServerPolicy() ServerPolicy {
+	if p.ServerPolicy == "" {
+		return ServerPolicyFirstSuccess
+	}
+	return p.ServerPolicy
+}
+
+// GetReportSchemaVersion returns ReportSchemaVersion, or its default if unset.
+func (p PluginConfig) GetReportSchemaVersion() reportcodec.ReportSchemaVersion {
+	if p.ReportSchemaVersion == 0 {
+		return reportcodec.DefaultReportSchemaVersion
+	}
+	return p.ReportSchemaVersion
+}
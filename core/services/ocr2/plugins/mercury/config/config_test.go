@@ -6,6 +6,8 @@ import (
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 )
 
 func Test_PluginConfig(t *testing.T) {
@@ -41,6 +43,99 @@ ServerPubKey = "4242"
 		assert.Contains(t, err.Error(), `Mercury: invalid scheme specified for MercuryServer, got: "http://example.com" (scheme: "http") but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`)
 		assert.Contains(t, err.Error(), `Mercury: ServerPubKey is required and must be a 32-byte hex string`)
 	})
+
+	t.Run("with multiple servers", func(t *testing.T) {
+		rawToml := `
+ServerURL = "example.com:80"
+ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+ServerPolicy = "consensus"
+[Servers]
+"wss://example.org:80" = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"example.net:80" = "624ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+		require.NoError(t, ValidatePluginConfig(mc))
+
+		servers := mc.GetServers()
+		require.Len(t, servers, 3)
+		assert.Equal(t, "example.com:80", servers[0].URL)
+		assert.Equal(t, "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", servers[0].PubKey.String())
+		assert.Equal(t, "example.net:80", servers[1].URL)
+		assert.Equal(t, "example.org:80", servers[2].URL)
+		assert.Equal(t, "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", servers[2].PubKey.String())
+		assert.Equal(t, ServerPolicyConsensus, mc.GetServerPolicy())
+	})
+
+	t.Run("with servers only", func(t *testing.T) {
+		rawToml := `
+[Servers]
+"example.org:80" = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+		require.NoError(t, ValidatePluginConfig(mc))
+
+		servers := mc.GetServers()
+		require.Len(t, servers, 1)
+		assert.Equal(t, "example.org:80", servers[0].URL)
+		assert.Equal(t, ServerPolicyFirstSuccess, mc.GetServerPolicy())
+	})
+
+	t.Run("invalid servers", func(t *testing.T) {
+		rawToml := `
+ServerURL = "example.com:80"
+ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+ServerPolicy = "all"
+[Servers]
+"wss://example.com:80" = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"http://example.org" = "4242"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		err = ValidatePluginConfig(mc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Mercury: server "wss://example.com:80" is specified twice`)
+		assert.Contains(t, err.Error(), `Mercury: invalid scheme specified for MercuryServer, got: "http://example.org" (scheme: "http")`)
+		assert.Contains(t, err.Error(), `Mercury: public key of server "http://example.org" must be a 32-byte hex string`)
+		assert.Contains(t, err.Error(), `Mercury: invalid ServerPolicy "all", expected "firstSuccess" or "consensus"`)
+	})
+
+	t.Run("report schema version", func(t *testing.T) {
+		rawToml := `
+ServerURL = "example.com:80"
+ServerPubKey = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+ReportSchemaVersion = 2
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+		require.NoError(t, ValidatePluginConfig(mc))
+		assert.Equal(t, reportcodec.ReportSchemaV2, mc.GetReportSchemaVersion())
+
+		mc.ReportSchemaVersion = 0
+		require.NoError(t, ValidatePluginConfig(mc))
+		assert.Equal(t, reportcodec.ReportSchemaV1, mc.GetReportSchemaVersion())
+
+		mc.ReportSchemaVersion = 42
+		err = ValidatePluginConfig(mc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mercury: unsupported ReportSchemaVersion 42, supported: [1 2]")
+	})
+
+	t.Run("without servers", func(t *testing.T) {
+		err := ValidatePluginConfig(PluginConfig{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Mercury: ServerURL must be specified`)
+	})
 }
 
 func Test_PluginConfig_ServerURL(t *testing.T) {
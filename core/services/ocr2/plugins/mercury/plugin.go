@@ -54,6 +54,7 @@ func NewServices(
 		runResults,
 		chEnhancedTelem,
 		chainHeadTracker,
+		pluginConfig.GetReportSchemaVersion(),
 	)
 	wrappedPluginFactory := relaymercury.NewFactory(
 		ds,
@@ -0,0 +1,300 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrCircuitOpen is returned by http and bridge tasks when the circuit breaker
+// for the target bridge or host is open, and the request was not attempted.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// NOTE: These metrics generate a new label per bridge or host, in the same way
+// as the bridge metrics do.
+var (
+	promCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_circuit_breaker_state",
+		Help: "Circuit breaker state scoped by endpoint (0 = closed, 1 = open, 2 = half-open)",
+	},
+		[]string{"endpoint"},
+	)
+	promCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_circuit_breaker_rejections_total",
+		Help: "Number of requests rejected by an open circuit breaker scoped by endpoint",
+	},
+		[]string{"endpoint"},
+	)
+	promHTTPHedgedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_http_hedged_requests_total",
+		Help: "Number of hedged requests sent scoped by endpoint",
+	},
+		[]string{"endpoint"},
+	)
+)
+
+const (
+	// latencyWindowSize is the number of most recent successful request
+	// latencies kept per endpoint for hedging.
+	latencyWindowSize = 100
+	// minLatencySamples is the number of samples required before hedging is
+	// attempted for an endpoint.
+	minLatencySamples = 10
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// httpResponse holds the return values of makeHTTPRequest.
+type httpResponse struct {
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+	err        error
+}
+
+// endpoint tracks the circuit breaker state and recent latencies of a single
+// bridge or host.
+type endpoint struct {
+	name string
+
+	mu        sync.Mutex
+	state     breakerState
+	failures  uint32
+	openedAt  time.Time
+	probing   bool
+	latencies []time.Duration
+	next      int
+}
+
+// allow reports whether a request may be sent to the endpoint. In the
+// half-open state only a single probe request is allowed through at a time.
+func (e *endpoint) allow(now time.Time, openTimeout time.Duration) (ok bool, retryIn time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch e.state {
+	case breakerOpen:
+		if elapsed := now.Sub(e.openedAt); elapsed < openTimeout {
+			return false, openTimeout - elapsed
+		}
+		e.setState(breakerHalfOpen)
+		e.probing = true
+		return true, 0
+	case breakerHalfOpen:
+		if e.probing {
+			return false, 0
+		}
+		e.probing = true
+		return true, 0
+	default:
+		return true, 0
+	}
+}
+
+// record updates the breaker with the outcome of a request.
+func (e *endpoint) record(failed bool, now time.Time, failureThreshold uint32) (opened bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.probing = false
+	if !failed {
+		e.failures = 0
+		if e.state != breakerClosed {
+			e.setState(breakerClosed)
+		}
+		return false
+	}
+	e.failures++
+	if e.state == breakerHalfOpen || (e.state == breakerClosed && e.failures >= failureThreshold) {
+		e.openedAt = now
+		e.setState(breakerOpen)
+		return true
+	}
+	return false
+}
+
+func (e *endpoint) setState(s breakerState) {
+	e.state = s
+	promCircuitBreakerState.WithLabelValues(e.name).Set(float64(s))
+}
+
+func (e *endpoint) observeLatency(d time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.latencies) < latencyWindowSize {
+		e.latencies = append(e.latencies, d)
+		return
+	}
+	e.latencies[e.next] = d
+	e.next = (e.next + 1) % latencyWindowSize
+}
+
+// latencyPercentile returns the given percentile of recently observed
+// latencies, or zero if not enough samples have been collected yet.
+func (e *endpoint) latencyPercentile(percentile uint16) time.Duration {
+	e.mu.Lock()
+	if len(e.latencies) < minLatencySamples {
+		e.mu.Unlock()
+		return 0
+	}
+	sorted := make([]time.Duration, len(e.latencies))
+	copy(sorted, e.latencies)
+	e.mu.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := (len(sorted)*int(percentile))/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// httpGuard is shared by all http and bridge tasks run by a node. It applies a
+// circuit breaker per bridge or host, and optionally hedges slow requests.
+// A nil *httpGuard sends every request as is.
+type httpGuard struct {
+	breakerEnabled    bool
+	failureThreshold  uint32
+	openTimeout       time.Duration
+	hedgingPercentile uint16
+	lggr              logger.Logger
+
+	mu        sync.Mutex
+	endpoints map[string]*endpoint
+}
+
+func newHTTPGuard(cfg Config, lggr logger.Logger) *httpGuard {
+	return &httpGuard{
+		breakerEnabled:    cfg.JobPipelineCircuitBreakerEnabled(),
+		failureThreshold:  cfg.JobPipelineCircuitBreakerFailureThreshold(),
+		openTimeout:       cfg.JobPipelineCircuitBreakerOpenTimeout(),
+		hedgingPercentile: cfg.JobPipelineHTTPHedgingPercentile(),
+		lggr:              lggr.Named("HTTPGuard"),
+		endpoints:         make(map[string]*endpoint),
+	}
+}
+
+func (g *httpGuard) endpoint(name string) *endpoint {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	e, ok := g.endpoints[name]
+	if !ok {
+		e = &endpoint{name: name}
+		g.endpoints[name] = e
+		promCircuitBreakerState.WithLabelValues(name).Set(float64(breakerClosed))
+	}
+	return e
+}
+
+// do sends a request to the named endpoint using send. The request is rejected
+// with ErrCircuitOpen if the endpoint's breaker is open. If hedge is true and
+// hedging is enabled, a second request is sent when the first one is slower
+// than the configured latency percentile, and the first successful response
+// wins.
+func (g *httpGuard) do(ctx context.Context, name string, hedge bool, send func(ctx context.Context) httpResponse) httpResponse {
+	if g == nil || (!g.breakerEnabled && g.hedgingPercentile == 0) {
+		return send(ctx)
+	}
+	e := g.endpoint(name)
+
+	if g.breakerEnabled {
+		if ok, retryIn := e.allow(time.Now(), g.openTimeout); !ok {
+			promCircuitBreakerRejections.WithLabelValues(name).Inc()
+			if retryIn > 0 {
+				return httpResponse{err: errors.Wrapf(ErrCircuitOpen, "%s: too many consecutive failures, retrying in %s", name, retryIn.Round(time.Second))}
+			}
+			return httpResponse{err: errors.Wrapf(ErrCircuitOpen, "%s: waiting for probe request to complete", name)}
+		}
+	}
+
+	var resp httpResponse
+	if hedge && g.hedgingPercentile > 0 {
+		resp = g.hedged(ctx, e, send)
+	} else {
+		resp = send(ctx)
+	}
+
+	if resp.err == nil {
+		e.observeLatency(resp.elapsed)
+	}
+	if g.breakerEnabled {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			// The caller gave up, this says nothing about the endpoint's health.
+			e.mu.Lock()
+			e.probing = false
+			e.mu.Unlock()
+		} else if e.record(isEndpointFailure(resp.statusCode, resp.err), time.Now(), g.failureThreshold) {
+			g.lggr.Warnw("Circuit breaker opened", "endpoint", name, "openTimeout", g.openTimeout, "err", resp.err)
+		}
+	}
+	return resp
+}
+
+func (g *httpGuard) hedged(ctx context.Context, e *endpoint, send func(ctx context.Context) httpResponse) httpResponse {
+	delay := e.latencyPercentile(g.hedgingPercentile)
+	if delay <= 0 {
+		return send(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // cancels the losing request
+
+	chResp := make(chan httpResponse, 2)
+	launch := func() {
+		go func() { chResp <- send(ctx) }()
+	}
+	launch()
+	inFlight := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case resp := <-chResp:
+			inFlight--
+			if resp.err == nil || inFlight == 0 {
+				return resp
+			}
+			// the other request is still in flight, it may yet succeed
+		case <-timer.C:
+			promHTTPHedgedRequests.WithLabelValues(e.name).Inc()
+			launch()
+			inFlight++
+		}
+	}
+}
+
+// isEndpointFailure reports whether a request outcome should count against the
+// endpoint's circuit breaker. Client errors (4xx) mean the endpoint is up.
+func isEndpointFailure(statusCode int, err error) bool {
+	if err == nil {
+		return false
+	}
+	return statusCode == 0 || statusCode >= 500
+}
@@ -0,0 +1,161 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTestHTTPGuard(t *testing.T, failureThreshold uint32, openTimeout time.Duration, hedgingPercentile uint16) *httpGuard {
+	return &httpGuard{
+		breakerEnabled:    failureThreshold > 0,
+		failureThreshold:  failureThreshold,
+		openTimeout:       openTimeout,
+		hedgingPercentile: hedgingPercentile,
+		lggr:              logger.TestLogger(t),
+		endpoints:         make(map[string]*endpoint),
+	}
+}
+
+func TestHTTPGuard_NilSendsRequest(t *testing.T) {
+	var g *httpGuard
+	resp := g.do(testutils.Context(t), "example.com", true, func(context.Context) httpResponse {
+		return httpResponse{statusCode: 200, body: []byte("ok")}
+	})
+	require.NoError(t, resp.err)
+	assert.Equal(t, "ok", string(resp.body))
+}
+
+func TestHTTPGuard_CircuitBreaker(t *testing.T) {
+	ctx := testutils.Context(t)
+	g := newTestHTTPGuard(t, 3, time.Hour, 0)
+
+	var calls atomic.Int32
+	fail := func(context.Context) httpResponse {
+		calls.Add(1)
+		return httpResponse{statusCode: 503, err: errors.New("unavailable")}
+	}
+	succeed := func(context.Context) httpResponse {
+		calls.Add(1)
+		return httpResponse{statusCode: 200}
+	}
+
+	t.Run("client errors do not open the breaker", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			resp := g.do(ctx, "client-errors", false, func(context.Context) httpResponse {
+				return httpResponse{statusCode: 400, err: errors.New("bad request")}
+			})
+			require.False(t, errors.Is(resp.err, ErrCircuitOpen))
+		}
+		assert.Equal(t, breakerClosed, g.endpoint("client-errors").state)
+	})
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			resp := g.do(ctx, "bridge:foo", false, fail)
+			require.Error(t, resp.err)
+			require.False(t, errors.Is(resp.err, ErrCircuitOpen))
+		}
+		assert.Equal(t, breakerOpen, g.endpoint("bridge:foo").state)
+
+		resp := g.do(ctx, "bridge:foo", false, succeed)
+		require.ErrorIs(t, resp.err, ErrCircuitOpen)
+		assert.Contains(t, resp.err.Error(), "bridge:foo")
+		assert.Equal(t, int32(3), calls.Load())
+
+		// other endpoints are unaffected
+		resp = g.do(ctx, "bridge:bar", false, succeed)
+		require.NoError(t, resp.err)
+	})
+
+	t.Run("half-open probe closes the breaker on success", func(t *testing.T) {
+		e := g.endpoint("bridge:foo")
+		e.openedAt = time.Now().Add(-2 * time.Hour)
+
+		resp := g.do(ctx, "bridge:foo", false, succeed)
+		require.NoError(t, resp.err)
+		assert.Equal(t, breakerClosed, e.state)
+	})
+
+	t.Run("half-open probe re-opens the breaker on failure", func(t *testing.T) {
+		e := g.endpoint("bridge:baz")
+		e.state = breakerOpen
+		e.openedAt = time.Now().Add(-2 * time.Hour)
+
+		resp := g.do(ctx, "bridge:baz", false, fail)
+		require.Error(t, resp.err)
+		assert.Equal(t, breakerOpen, e.state)
+
+		resp = g.do(ctx, "bridge:baz", false, succeed)
+		require.ErrorIs(t, resp.err, ErrCircuitOpen)
+	})
+
+	t.Run("only a single probe is allowed while half-open", func(t *testing.T) {
+		e := g.endpoint("bridge:qux")
+		now := time.Now()
+		e.state = breakerOpen
+		e.openedAt = now.Add(-2 * time.Hour)
+
+		ok, _ := e.allow(now, g.openTimeout)
+		require.True(t, ok)
+		assert.Equal(t, breakerHalfOpen, e.state)
+		ok, _ = e.allow(now, g.openTimeout)
+		require.False(t, ok)
+	})
+}
+
+func TestHTTPGuard_Hedging(t *testing.T) {
+	ctx := testutils.Context(t)
+	g := newTestHTTPGuard(t, 0, 0, 50)
+
+	e := g.endpoint("example.com")
+	for i := 0; i < minLatencySamples; i++ {
+		e.observeLatency(10 * time.Millisecond)
+	}
+	require.Equal(t, 10*time.Millisecond, e.latencyPercentile(50))
+
+	t.Run("sends a second request when the first is slow", func(t *testing.T) {
+		var calls atomic.Int32
+		resp := g.do(ctx, "example.com", true, func(ctx context.Context) httpResponse {
+			if calls.Add(1) == 1 {
+				// the first request hangs until cancelled
+				<-ctx.Done()
+				return httpResponse{err: ctx.Err()}
+			}
+			return httpResponse{statusCode: 200, body: []byte("hedged")}
+		})
+		require.NoError(t, resp.err)
+		assert.Equal(t, "hedged", string(resp.body))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("does not hedge when disallowed", func(t *testing.T) {
+		var calls atomic.Int32
+		resp := g.do(ctx, "example.com", false, func(ctx context.Context) httpResponse {
+			calls.Add(1)
+			time.Sleep(50 * time.Millisecond)
+			return httpResponse{statusCode: 200}
+		})
+		require.NoError(t, resp.err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not hedge without enough samples", func(t *testing.T) {
+		var calls atomic.Int32
+		resp := g.do(ctx, "new.example.com", true, func(ctx context.Context) httpResponse {
+			calls.Add(1)
+			time.Sleep(50 * time.Millisecond)
+			return httpResponse{statusCode: 200}
+		})
+		require.NoError(t, resp.err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
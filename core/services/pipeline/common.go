@@ -67,6 +67,10 @@ type (
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() models.Duration
 		TriggerFallbackDBPollInterval() time.Duration
+		JobPipelineCircuitBreakerEnabled() bool
+		JobPipelineCircuitBreakerFailureThreshold() uint32
+		JobPipelineCircuitBreakerOpenTimeout() time.Duration
+		JobPipelineHTTPHedgingPercentile() uint16
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
@@ -116,7 +120,10 @@ func pendingRunInfo() RunInfo {
 }
 
 func isRetryableHTTPError(statusCode int, err error) bool {
-	if statusCode >= 400 && statusCode < 500 {
+	if errors.Is(err, ErrCircuitOpen) {
+		// The breaker will still be open by the time the task is retried
+		return false
+	} else if statusCode >= 400 && statusCode < 500 {
 		// Client errors are not likely to succeed by resubmitting the exact same information again
 		return false
 	} else if statusCode >= 500 {
@@ -202,6 +209,8 @@ type TaskRunResult struct {
 	FinishedAt null.Time
 	// runInfo is never persisted
 	runInfo RunInfo
+	// inputs are never persisted
+	inputs []Result
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -358,6 +367,7 @@ func (t TaskType) String() string {
 }
 
 const (
+	TaskTypeAggregate        TaskType = "aggregate"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
@@ -431,6 +441,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAggregate:
+		task = &AggregateTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
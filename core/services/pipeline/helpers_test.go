@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 const (
@@ -48,6 +49,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetHTTPGuard(config Config, lggr logger.Logger) {
+	t.guard = newHTTPGuard(config, lggr)
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.config = config
@@ -55,6 +60,10 @@ func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, spec
 	t.jobType = jobType
 }
 
+func (t *ETHTxTask) HelperSetDryRun(dryRun bool) {
+	t.dryRun = dryRun
+}
+
 func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.keyStore = keyStore
@@ -88,6 +88,62 @@ func (_m *Config) DefaultHTTPTimeout() models.Duration {
 	return r0
 }
 
+// JobPipelineCircuitBreakerEnabled provides a mock function with given fields:
+func (_m *Config) JobPipelineCircuitBreakerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *Config) JobPipelineCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineCircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *Config) JobPipelineCircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineHTTPHedgingPercentile provides a mock function with given fields:
+func (_m *Config) JobPipelineHTTPHedgingPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -12,6 +12,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -38,6 +39,9 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but without any side effects: ethtx tasks do not send
+	// transactions and bridge responses are not cached. It returns a trace of every task that ran.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trace []TaskRunTrace, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
@@ -61,6 +65,7 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpGuard              *httpGuard
 
 	// test helper
 	runFinished func(*Run)
@@ -114,6 +119,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, chainSet evm.ChainSet, et
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpGuard:              newHTTPGuard(cfg, lggr),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -218,7 +224,7 @@ func (r *runner) ExecuteRun(
 ) (Run, TaskRunResults, error) {
 	run := NewRun(spec, vars)
 
-	pipeline, err := r.initializePipeline(&run)
+	pipeline, err := r.initializePipeline(&run, false)
 
 	if err != nil {
 		return run, nil, err
@@ -233,7 +239,9 @@ func (r *runner) ExecuteRun(
 	return run, taskRunResults, nil
 }
 
-func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
+// initializePipeline parses the pipeline of run and initializes its tasks. In
+// dryRun mode, tasks are set up to skip their side effects.
+func (r *runner) initializePipeline(run *Run, dryRun bool) (*Pipeline, error) {
 	pipeline, err := Parse(run.PipelineSpec.DotDagSource)
 	if err != nil {
 		return nil, err
@@ -248,6 +256,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).guard = r.httpGuard
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).orm = r.btORM
@@ -256,6 +265,10 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).guard = r.httpGuard
+			if dryRun {
+				task.(*BridgeTask).orm = dryRunBridgeORM{r.btORM}
+			}
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -275,6 +288,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*ETHTxTask).specGasLimit = run.PipelineSpec.GasLimit
 			task.(*ETHTxTask).jobType = run.PipelineSpec.JobType
 			task.(*ETHTxTask).forwardingAllowed = run.PipelineSpec.ForwardingAllowed
+			task.(*ETHTxTask).dryRun = dryRun
 		default:
 		}
 	}
@@ -296,6 +310,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	ctx, span := tracer.Start(ctx, "pipeline run", runAttributes(run))
+	defer span.End()
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -390,10 +407,12 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
 			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			recordError(span, run.FatalErrors.ToError())
 		} else {
 			run.State = RunStatusCompleted
 		}
 	}
+	span.SetAttributes(attribute.String("pipeline.run.state", string(run.State)))
 
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
@@ -443,7 +462,14 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx, span := tracer.Start(ctx, "pipeline task "+string(taskRun.task.Type()), taskAttributes(taskRun))
+	defer span.End()
+
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	recordError(span, result.Error)
+	if runInfo.IsPending {
+		span.SetAttributes(attribute.Bool("task.pending", true))
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -469,6 +495,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		CreatedAt:  start,
 		FinishedAt: finishedAt,
 		runInfo:    runInfo,
+		inputs:     taskRun.inputs,
 	}
 }
 
@@ -507,7 +534,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error) {
-	pipeline, err := r.initializePipeline(run)
+	pipeline, err := r.initializePipeline(run, false)
 	if err != nil {
 		return false, err
 	}
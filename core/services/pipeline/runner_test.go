@@ -133,6 +133,68 @@ ds5 [type=http method="GET" url="%s" index=2]
 	require.Len(t, errorResults, 3)
 }
 
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	btcUSDPairing := utils.MustUnmarshalToMap(`{"data":{"coin":"BTC","market":"USD"}}`)
+	s1 := httptest.NewServer(fakePriceResponder(t, btcUSDPairing, decimal.NewFromInt(9700), "", nil))
+	defer s1.Close()
+
+	bridgeFeedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+
+	_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridgeFeedURL.String()}, cfg)
+
+	// The mock fails the test if the bridge response is cached
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", bt.Name).Return(*bt, nil).Once()
+
+	r, _ := newRunner(t, db, btORM, cfg)
+
+	s := fmt.Sprintf(`
+ds1 [type=bridge name="%s" requestData=<{"data": $(jobRun.pair)}>]
+ds1_parse [type=jsonparse path="data,result"]
+ds1_multiply [type=multiply times=100]
+
+ds1->ds1_parse->ds1_multiply;
+`, bt.Name.String())
+
+	spec := pipeline.Spec{DotDagSource: s}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobRun": map[string]interface{}{
+			"pair": map[string]interface{}{"coin": "BTC", "market": "USD"},
+		},
+	})
+
+	run, trace, err := r.SimulateRun(testutils.Context(t), spec, vars, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.False(t, run.HasErrors())
+	require.Len(t, trace, 3)
+
+	byDotID := make(map[string]pipeline.TaskRunTrace)
+	for _, tr := range trace {
+		byDotID[tr.DotID] = tr
+	}
+
+	ds1 := byDotID["ds1"]
+	assert.Equal(t, pipeline.TaskTypeBridge, ds1.Type)
+	assert.Empty(t, ds1.Inputs)
+	assert.NoError(t, ds1.Result.Error)
+
+	parse := byDotID["ds1_parse"]
+	require.Len(t, parse.Inputs, 1)
+	assert.Equal(t, ds1.Result, parse.Inputs[0])
+	assert.Equal(t, decimal.NewFromInt(9700).String(), fmt.Sprint(parse.Result.Value))
+
+	multiply := byDotID["ds1_multiply"]
+	require.Len(t, multiply.Inputs, 1)
+	assert.Equal(t, parse.Result, multiply.Inputs[0])
+	assert.Equal(t, "970000", multiply.Result.Value.(decimal.Decimal).String())
+	assert.True(t, multiply.FinishedAt.Valid)
+	assert.GreaterOrEqual(t, multiply.Duration(), time.Duration(0))
+}
+
 type taskRunWithVars struct {
 	bridgeName        string
 	ds2URL, ds4URL    string
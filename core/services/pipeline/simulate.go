@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TaskRunTrace describes the execution of a single task in a simulated run.
+type TaskRunTrace struct {
+	DotID string
+	Type  TaskType
+	Index int32
+	// Inputs are the results of the task's dependencies, sorted by input index
+	Inputs     []Result
+	Result     Result
+	Attempts   uint
+	CreatedAt  time.Time
+	FinishedAt null.Time
+}
+
+// Duration returns how long the task took to run.
+func (t TaskRunTrace) Duration() time.Duration {
+	if !t.FinishedAt.Valid {
+		return 0
+	}
+	return t.FinishedAt.Time.Sub(t.CreatedAt)
+}
+
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (Run, []TaskRunTrace, error) {
+	run := NewRun(spec, vars)
+
+	pipeline, err := r.initializePipeline(&run, true)
+	if err != nil {
+		return run, nil, err
+	}
+
+	taskRunResults := r.run(ctx, pipeline, &run, vars, l.Named("Simulation"))
+
+	if run.Pending {
+		return run, nil, pkgerrors.Errorf("cannot simulate async run for spec ID %v", spec.ID)
+	}
+
+	trace := make([]TaskRunTrace, len(taskRunResults))
+	for i, trr := range taskRunResults {
+		trace[i] = TaskRunTrace{
+			DotID:      trr.Task.DotID(),
+			Type:       trr.Task.Type(),
+			Index:      trr.Task.OutputIndex(),
+			Inputs:     trr.inputs,
+			Result:     trr.Result,
+			Attempts:   trr.Attempts,
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+		}
+	}
+	return run, trace, nil
+}
+
+// dryRunBridgeORM does not cache bridge responses, so that simulated runs do not
+// write to the database.
+type dryRunBridgeORM struct {
+	bridges.ORM
+}
+
+func (dryRunBridgeORM) UpsertBridgeResponse(dotId string, specId int32, response []byte) error {
+	return nil
+}
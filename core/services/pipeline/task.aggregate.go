@@ -0,0 +1,318 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// AggregateTask combines numeric values into one with the given method, after
+// discarding faulty values and outliers.
+//
+// Methods:
+//
+//	median         the median of the values (default)
+//	weightedMedian the median of the values, each counted according to the matching entry of weights
+//	mean           the arithmetic mean of the values
+//	trimmedMean    the mean of the values after discarding trimFraction of them from each end
+//
+// Values are discarded before aggregating when they:
+//   - are errors or cannot be converted to a decimal (up to allowedFaults)
+//   - deviate from the median by more than madThreshold times the median absolute deviation, if madThreshold is set
+//   - deviate from the median by more than maxDeviation, as a fraction of the median, if maxDeviation is set
+//
+// At least minSources values must remain (default 1).
+//
+// Return types:
+//
+//	map[string]interface{}{
+//	    "result":    decimal.Decimal,
+//	    "sources":   int,                      // the number of values aggregated
+//	    "discarded": []map[string]interface{}, // with "index", "value" and "reason" of every discarded value
+//	}
+type AggregateTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Method        string `json:"method"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+	TrimFraction  string `json:"trimFraction"`
+	MADThreshold  string `json:"madThreshold"`
+	MaxDeviation  string `json:"maxDeviation"`
+	MinSources    string `json:"minSources"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*AggregateTask)(nil)
+
+// Aggregation methods, lowercased since they are matched case-insensitively.
+const (
+	aggregateMethodMedian         = "median"
+	aggregateMethodWeightedMedian = "weightedmedian"
+	aggregateMethodMean           = "mean"
+	aggregateMethodTrimmedMean    = "trimmedmean"
+)
+
+var ErrInsufficientSources = errors.New("insufficient sources")
+
+var decimalTwo = decimal.NewFromInt(2)
+
+// aggregateSource is a value to aggregate, and its position in the values.
+type aggregateSource struct {
+	index  int
+	value  decimal.Decimal
+	weight decimal.Decimal
+}
+
+func (t *AggregateTask) Type() TaskType {
+	return TaskTypeAggregate
+}
+
+func (t *AggregateTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		method             StringParam
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		valuesAndErrs      SliceParam
+		trimFraction       DecimalParam
+		madThreshold       DecimalParam
+		maxDeviation       DecimalParam
+		minSources         Uint64Param
+		allowedFaults      int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&method, From(VarExpr(t.Method, vars), NonemptyString(t.Method), aggregateMethodMedian)), "method"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&trimFraction, From(VarExpr(t.TrimFraction, vars), NonemptyString(t.TrimFraction), "0.1")), "trimFraction"),
+		errors.Wrap(ResolveParam(&madThreshold, From(VarExpr(t.MADThreshold, vars), NonemptyString(t.MADThreshold), 0)), "madThreshold"),
+		errors.Wrap(ResolveParam(&maxDeviation, From(VarExpr(t.MaxDeviation, vars), NonemptyString(t.MaxDeviation), 0)), "maxDeviation"),
+		errors.Wrap(ResolveParam(&minSources, From(VarExpr(t.MinSources, vars), NonemptyString(t.MinSources), 1)), "minSources"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	m := strings.ToLower(string(method))
+	switch m {
+	case aggregateMethodMedian, aggregateMethodWeightedMedian, aggregateMethodMean:
+	case aggregateMethodTrimmedMean:
+		if trimFraction.Decimal().IsNegative() || trimFraction.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+			return Result{Error: errors.Wrapf(ErrBadInput, "trimFraction must be at least 0 and less than 0.5, got %v", trimFraction.Decimal())}, runInfo
+		}
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "unknown method %q", string(method))}, runInfo
+	}
+	if madThreshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrap(ErrBadInput, "madThreshold must not be negative")}, runInfo
+	}
+	if maxDeviation.Decimal().IsNegative() {
+		return Result{Error: errors.Wrap(ErrBadInput, "maxDeviation must not be negative")}, runInfo
+	}
+
+	var weights DecimalSliceParam
+	if m == aggregateMethodWeightedMedian {
+		err = errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights")
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		if len(weights) != len(valuesAndErrs) {
+			return Result{Error: errors.Wrapf(ErrBadInput, "got %v weights for %v values", len(weights), len(valuesAndErrs))}, runInfo
+		}
+		for _, w := range weights {
+			if w.IsNegative() {
+				return Result{Error: errors.Wrap(ErrBadInput, "weights must not be negative")}, runInfo
+			}
+		}
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	var (
+		sources   []aggregateSource
+		discarded []map[string]interface{}
+		faults    int
+	)
+	discard := func(index int, value interface{}, reason string) {
+		discarded = append(discarded, map[string]interface{}{
+			"index":  index,
+			"value":  value,
+			"reason": reason,
+		})
+	}
+	for i, v := range valuesAndErrs {
+		if e, is := v.(error); is {
+			faults++
+			discard(i, nil, fmt.Sprintf("error: %v", e))
+			continue
+		}
+		var d DecimalParam
+		if e := d.UnmarshalPipelineParam(v); e != nil {
+			faults++
+			discard(i, v, fmt.Sprintf("invalid value: %v", e))
+			continue
+		}
+		source := aggregateSource{index: i, value: d.Decimal()}
+		if weights != nil {
+			source.weight = weights[i]
+		}
+		sources = append(sources, source)
+	}
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to aggregate task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(sources) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to aggregate")}, runInfo
+	}
+
+	// Outliers are measured against the median of all valid values, which a
+	// minority of outliers cannot move far.
+	sortSources(sources)
+	median := medianOfSorted(sources)
+
+	if k := madThreshold.Decimal(); k.IsPositive() {
+		deviations := make([]aggregateSource, len(sources))
+		for i, s := range sources {
+			deviations[i] = aggregateSource{value: s.value.Sub(median).Abs()}
+		}
+		sortSources(deviations)
+		mad := medianOfSorted(deviations)
+		limit := mad.Mul(k)
+		sources = filterSources(sources, func(s aggregateSource) bool {
+			if s.value.Sub(median).Abs().LessThanOrEqual(limit) {
+				return true
+			}
+			discard(s.index, s.value, fmt.Sprintf("outlier: deviates from median %v by more than %v times the median absolute deviation %v", median, k, mad))
+			return false
+		})
+	}
+
+	if maxDev := maxDeviation.Decimal(); maxDev.IsPositive() {
+		limit := median.Abs().Mul(maxDev)
+		sources = filterSources(sources, func(s aggregateSource) bool {
+			if s.value.Sub(median).Abs().LessThanOrEqual(limit) {
+				return true
+			}
+			discard(s.index, s.value, fmt.Sprintf("outlier: deviates from median %v by more than %v", median, maxDev))
+			return false
+		})
+	}
+
+	if len(sources) == 0 || uint64(len(sources)) < uint64(minSources) {
+		return Result{Error: errors.Wrapf(ErrInsufficientSources, "%v of %v values remain after discarding faults and outliers, need at least %v", len(sources), len(valuesAndErrs), minSources)}, runInfo
+	}
+
+	var value decimal.Decimal
+	switch m {
+	case aggregateMethodMedian:
+		value = medianOfSorted(sources)
+	case aggregateMethodWeightedMedian:
+		value, err = weightedMedianOfSorted(sources)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	case aggregateMethodMean:
+		value = meanOf(sources, maybePrecision)
+	case aggregateMethodTrimmedMean:
+		trim := int(trimFraction.Decimal().Mul(decimal.NewFromInt(int64(len(sources)))).IntPart())
+		for _, s := range sources[:trim] {
+			discard(s.index, s.value, fmt.Sprintf("trimmed: among the lowest %v of values", trimFraction.Decimal()))
+		}
+		for _, s := range sources[len(sources)-trim:] {
+			discard(s.index, s.value, fmt.Sprintf("trimmed: among the highest %v of values", trimFraction.Decimal()))
+		}
+		sources = sources[trim : len(sources)-trim]
+		value = meanOf(sources, maybePrecision)
+	}
+
+	sort.SliceStable(discarded, func(i, j int) bool {
+		return discarded[i]["index"].(int) < discarded[j]["index"].(int)
+	})
+	if discarded == nil {
+		discarded = []map[string]interface{}{}
+	}
+
+	return Result{Value: map[string]interface{}{
+		"result":    value,
+		"sources":   len(sources),
+		"discarded": discarded,
+	}}, runInfo
+}
+
+func sortSources(sources []aggregateSource) {
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].value.LessThan(sources[j].value)
+	})
+}
+
+func filterSources(sources []aggregateSource, keep func(aggregateSource) bool) []aggregateSource {
+	var kept []aggregateSource
+	for _, s := range sources {
+		if keep(s) {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
+// medianOfSorted returns the median of non-empty sources sorted by value.
+func medianOfSorted(sources []aggregateSource) decimal.Decimal {
+	k := len(sources) / 2
+	if len(sources)%2 == 1 {
+		return sources[k].value
+	}
+	return sources[k].value.Add(sources[k-1].value).Div(decimalTwo)
+}
+
+// weightedMedianOfSorted returns the value at which the cumulative weight of
+// sources sorted by value reaches half of the total. When it does so exactly
+// between two values, their average is returned.
+func weightedMedianOfSorted(sources []aggregateSource) (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, s := range sources {
+		total = total.Add(s.weight)
+	}
+	if !total.IsPositive() {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, "total weight of the remaining values must be positive")
+	}
+	half := total.Div(decimalTwo)
+	cumulative := decimal.Zero
+	for i, s := range sources {
+		cumulative = cumulative.Add(s.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			for _, next := range sources[i+1:] {
+				if next.weight.IsPositive() {
+					return s.value.Add(next.value).Div(decimalTwo), nil
+				}
+			}
+		}
+		return s.value, nil
+	}
+	return sources[len(sources)-1].value, nil
+}
+
+func meanOf(sources []aggregateSource, maybePrecision MaybeInt32Param) decimal.Decimal {
+	total := decimal.Zero
+	for _, s := range sources {
+		total = total.Add(s.value)
+	}
+	numValues := decimal.NewFromInt(int64(len(sources)))
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return total.DivRound(numValues, precision)
+	}
+	return total.Div(numValues)
+}
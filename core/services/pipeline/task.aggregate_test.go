@@ -0,0 +1,234 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestAggregateTask(t *testing.T) {
+	t.Parallel()
+
+	values := func(vals ...string) []pipeline.Result {
+		var results []pipeline.Result
+		for _, v := range vals {
+			if v == "err" {
+				results = append(results, pipeline.Result{Error: errors.New("boom")})
+			} else {
+				results = append(results, pipeline.Result{Value: mustDecimal(t, v)})
+			}
+		}
+		return results
+	}
+
+	tests := []struct {
+		name          string
+		task          pipeline.AggregateTask
+		inputs        []pipeline.Result
+		want          string
+		wantSources   int
+		wantDiscarded []int
+		wantReasons   []string
+		wantError     error
+	}{
+		{
+			"median by default",
+			pipeline.AggregateTask{},
+			values("3", "1", "2", "4"),
+			"2.5", 4, nil, nil, nil,
+		},
+		{
+			"mean",
+			pipeline.AggregateTask{Method: "mean", Precision: "2"},
+			values("1", "2", "2"),
+			"1.67", 3, nil, nil, nil,
+		},
+		{
+			"weighted median",
+			pipeline.AggregateTask{Method: "weightedMedian", Weights: "[1, 1, 5]"},
+			values("1", "2", "3"),
+			"3", 3, nil, nil, nil,
+		},
+		{
+			"weighted median between values",
+			pipeline.AggregateTask{Method: "weightedMedian", Weights: "[2, 1, 1]"},
+			values("1", "2", "3"),
+			"1.5", 3, nil, nil, nil,
+		},
+		{
+			"weighted median skips errors",
+			pipeline.AggregateTask{Method: "weightedMedian", Weights: "[1, 100, 1, 2]"},
+			values("1", "err", "2", "3"),
+			"2.5", 3, []int{1}, []string{"error: boom"}, nil,
+		},
+		{
+			"weighted median with wrong number of weights",
+			pipeline.AggregateTask{Method: "weightedMedian", Weights: "[1, 1]"},
+			values("1", "2", "3"),
+			"", 0, nil, nil, pipeline.ErrBadInput,
+		},
+		{
+			"trimmed mean",
+			pipeline.AggregateTask{Method: "trimmedMean", TrimFraction: "0.2"},
+			values("100", "1", "2", "3", "-50"),
+			"2", 3, []int{0, 4}, []string{"trimmed: among the highest 0.2 of values", "trimmed: among the lowest 0.2 of values"}, nil,
+		},
+		{
+			"trimmed mean with too large a fraction",
+			pipeline.AggregateTask{Method: "trimmedMean", TrimFraction: "0.5"},
+			values("1", "2"),
+			"", 0, nil, nil, pipeline.ErrBadInput,
+		},
+		{
+			"MAD outlier rejection",
+			pipeline.AggregateTask{Method: "mean", MADThreshold: "3"},
+			values("100", "101", "99", "100.5", "150"),
+			"100.125", 4, []int{4}, []string{"outlier: deviates from median 100.5 by more than 3 times the median absolute deviation 0.5"}, nil,
+		},
+		{
+			"MAD outlier rejection keeps all values within threshold",
+			pipeline.AggregateTask{Method: "mean", MADThreshold: "3"},
+			values("100", "101", "99"),
+			"100", 3, nil, nil, nil,
+		},
+		{
+			"max deviation",
+			pipeline.AggregateTask{MaxDeviation: "0.05"},
+			values("100", "102", "98", "110"),
+			"100", 3, []int{3}, []string{"outlier: deviates from median 101 by more than 0.05"}, nil,
+		},
+		{
+			"min sources met",
+			pipeline.AggregateTask{MaxDeviation: "0.05", MinSources: "3"},
+			values("100", "102", "98", "110"),
+			"100", 3, []int{3}, nil, nil,
+		},
+		{
+			"min sources not met",
+			pipeline.AggregateTask{MaxDeviation: "0.01", MinSources: "3"},
+			values("100", "102", "98", "110"),
+			"", 0, nil, nil, pipeline.ErrInsufficientSources,
+		},
+		{
+			"too many faults",
+			pipeline.AggregateTask{AllowedFaults: "1"},
+			values("1", "err", "err"),
+			"", 0, nil, nil, pipeline.ErrTooManyErrors,
+		},
+		{
+			"no values",
+			pipeline.AggregateTask{AllowedFaults: "0"},
+			nil,
+			"", 0, nil, nil, pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"invalid values are faults",
+			pipeline.AggregateTask{AllowedFaults: "1"},
+			[]pipeline.Result{{Value: "foo"}, {Value: "1"}, {Value: 2}},
+			"1.5", 2, []int{0}, nil, nil,
+		},
+		{
+			"unknown method",
+			pipeline.AggregateTask{Method: "max"},
+			values("1"),
+			"", 0, nil, nil, pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := test.task
+			task.BaseTask = pipeline.NewBaseTask(0, "task", nil, nil, 0)
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantError != nil {
+				require.Equal(t, test.wantError, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+
+			m := output.Value.(map[string]interface{})
+			assert.Equal(t, test.want, m["result"].(decimal.Decimal).String())
+			assert.Equal(t, test.wantSources, m["sources"])
+
+			discarded := m["discarded"].([]map[string]interface{})
+			require.Len(t, discarded, len(test.wantDiscarded))
+			for i, d := range discarded {
+				assert.Equal(t, test.wantDiscarded[i], d["index"])
+				if test.wantReasons != nil {
+					assert.Equal(t, test.wantReasons[i], d["reason"])
+				}
+			}
+		})
+	}
+}
+
+func TestAggregateTask_Vars(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"prices":  []interface{}{"100", "101", "500"},
+		"weights": []interface{}{1, 2, 1},
+		"method":  "weightedMedian",
+	})
+	task := pipeline.AggregateTask{
+		BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Method:       "$(method)",
+		Values:       "$(prices)",
+		Weights:      "$(weights)",
+		MaxDeviation: "0.1",
+	}
+	output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+	require.NoError(t, output.Error)
+
+	m := output.Value.(map[string]interface{})
+	assert.Equal(t, "101", m["result"].(decimal.Decimal).String())
+	assert.Equal(t, 2, m["sources"])
+	discarded := m["discarded"].([]map[string]interface{})
+	require.Len(t, discarded, 1)
+	assert.Equal(t, 2, discarded[0]["index"])
+	assert.Equal(t, "500", discarded[0]["value"].(decimal.Decimal).String())
+}
+
+func TestAggregateTask_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+	ds1 [type=memo value=100];
+	ds2 [type=memo value=101];
+	ds3 [type=memo value=150];
+
+	ds1 -> answer;
+	ds2 -> answer;
+	ds3 -> answer;
+
+	answer [type=aggregate method=trimmedMean trimFraction=0.25 madThreshold=3 maxDeviation=0.02 minSources=2 allowedFaults=1];
+`)
+	require.NoError(t, err)
+
+	var task *pipeline.AggregateTask
+	for _, tsk := range p.Tasks {
+		if tsk.Type() == pipeline.TaskTypeAggregate {
+			task = tsk.(*pipeline.AggregateTask)
+		}
+	}
+	require.NotNil(t, task)
+	assert.Equal(t, "trimmedMean", task.Method)
+	assert.Equal(t, "0.25", task.TrimFraction)
+	assert.Equal(t, "3", task.MADThreshold)
+	assert.Equal(t, "0.02", task.MaxDeviation)
+	assert.Equal(t, "2", task.MinSources)
+	assert.Equal(t, "1", task.AllowedFaults)
+}
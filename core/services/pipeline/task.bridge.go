@@ -12,6 +12,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -62,11 +64,13 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
+	Hedge             string `json:"hedge"`
 
 	specId     int32
 	orm        bridges.ORM
 	config     Config
 	httpClient *http.Client
+	guard      *httpGuard
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -91,6 +95,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		hedge             BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -98,6 +103,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.config.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&hedge, From(NonemptyString(t.Hedge), false)), "hedge"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -165,7 +171,18 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	// Bridge requests are POSTs, so they are only hedged when the task opts
+	// in. Async bridges are never hedged, since the external adapter would be
+	// asked to resume the same run twice.
+	resp := t.guard.do(requestCtx, "bridge:"+string(name), bool(hedge) && t.Async != "true", func(ctx context.Context) (r httpResponse) {
+		ctx, span := tracer.Start(ctx, "bridge request", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attribute.String("bridge.name", string(name))))
+		defer span.End()
+		r.body, r.statusCode, r.headers, r.elapsed, r.err = makeHTTPRequest(ctx, lggr, "POST", URLParam(url), injectTraceHeaders(ctx, reqHeaders), requestData, t.httpClient, t.config.DefaultHTTPLimit())
+		span.SetAttributes(attribute.Int("http.status_code", r.statusCode))
+		recordError(span, r.err)
+		return
+	})
+	responseBytes, statusCode, headers, elapsed, err := resp.body, resp.statusCode, resp.headers, resp.elapsed, resp.err
 	if err != nil {
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
@@ -9,6 +9,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
@@ -120,9 +122,15 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		With("gasTipCap", call.GasTipCap).
 		With("gasFeeCap", call.GasFeeCap)
 
+	ctx, span := tracer.Start(ctx, "eth call", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("evm.chain_id", chain.ID().String()),
+		attribute.String("evm.to", call.To.Hex()),
+	))
 	start := time.Now()
 	resp, err := chain.Client().CallContract(ctx, call, nil)
 	elapsed := time.Since(start)
+	recordError(span, err)
+	span.End()
 	if err != nil {
 		if t.ExtractRevertReason {
 			rpcError, errExtract := evmclient.ExtractRPCError(err)
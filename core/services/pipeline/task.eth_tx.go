@@ -7,8 +7,13 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
@@ -22,6 +27,7 @@ import (
 // Return types:
 //
 //	nil
+//	map[string]interface{} describing the transaction that would have been sent, in simulated runs
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
@@ -42,6 +48,7 @@ type ETHTxTask struct {
 	keyStore          ETHKeyStore
 	chainSet          evm.ChainSet
 	jobType           string
+	dryRun            bool
 }
 
 type ETHKeyStore interface {
@@ -54,7 +61,7 @@ func (t *ETHTxTask) Type() TaskType {
 	return TaskTypeETHTx
 }
 
-func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var chainID StringParam
 	err := errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID")
 	if err != nil {
@@ -124,7 +131,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	}
 
 	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
-	strategy := txmgr.NewSendEveryStrategy()
+	strategy := txmgr.NewPriorityTxStrategy(txmgr.NewSendEveryStrategy(), cfg.EvmTxPriority(t.jobType))
 
 	var forwarderAddress common.Address
 	if t.forwardingAllowed {
@@ -152,7 +159,35 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		newTx.MinConfirmations = clnull.Uint32From(uint32(minOutgoingConfirmations))
 	}
 
-	_, err = txManager.CreateEthTransaction(newTx)
+	if t.dryRun {
+		lggr.Debugw("Skipping transaction in simulated run", "from", fromAddr, "to", newTx.ToAddress)
+		return Result{Value: map[string]interface{}{
+			"from":             fromAddr.Hex(),
+			"to":               newTx.ToAddress.Hex(),
+			"data":             hexutil.Encode(newTx.EncodedPayload),
+			"gasLimit":         newTx.FeeLimit,
+			"evmChainID":       chain.ID().String(),
+			"minConfirmations": minOutgoingConfirmations,
+		}}, runInfo
+	}
+
+	spanCtx, span := tracer.Start(ctx, "eth tx", trace.WithAttributes(
+		attribute.String("evm.chain_id", chain.ID().String()),
+		attribute.String("evm.from", fromAddr.Hex()),
+		attribute.String("evm.to", newTx.ToAddress.Hex()),
+	))
+	// Store the trace context on the tx, so that the spans of broadcasting
+	// and confirming it are children of this one.
+	if span.SpanContext().IsValid() {
+		txMeta.TraceContext = make(map[string]string)
+		otel.GetTextMapPropagator().Inject(spanCtx, propagation.MapCarrier(txMeta.TraceContext))
+	}
+	etx, err := txManager.CreateEthTransaction(newTx)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("evm.eth_tx.id", etx.ID))
+	}
+	recordError(span, err)
+	span.End()
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
 	}
@@ -1,9 +1,11 @@
 package pipeline_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -595,4 +597,44 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_DryRun(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	task := pipeline.ETHTxTask{
+		BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:             fmt.Sprintf(`[ "%s" ]`, from.Hex()),
+		To:               to.Hex(),
+		Data:             "foobar",
+		GasLimit:         "12345",
+		MinConfirmations: "2",
+	}
+
+	// The tx manager mock fails the test if CreateEthTransaction is called
+	keyStore := keystoremocks.NewEth(t)
+	txManager := txmmocks.NewMockEvmTxManager(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+		TxManager: txManager, KeyStore: keyStore})
+
+	keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+	task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+	task.HelperSetDryRun(true)
+
+	result, runInfo := task.Run(testutils.Context(t), lggr, pipeline.NewVarsFrom(nil), nil)
+	assert.Equal(t, pipeline.RunInfo{}, runInfo)
+	require.NoError(t, result.Error)
+	assert.Equal(t, map[string]interface{}{
+		"from":             from.Hex(),
+		"to":               to.Hex(),
+		"data":             hexutil.Encode([]byte("foobar")),
+		"gasLimit":         uint32(12345),
+		"evmChainID":       testutils.FixtureChainID.String(),
+		"minConfirmations": uint64(2),
+	}, result.Value)
+}
+
 func ptr[T any](t T) *T { return &t }
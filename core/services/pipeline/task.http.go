@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +25,12 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	Hedge                          string
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	guard                  *httpGuard
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -63,6 +66,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		hedge                          BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -77,6 +81,11 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	// Only idempotent methods are hedged by default, since a hedged request
+	// may reach the server more than once.
+	if err = ResolveParam(&hedge, From(NonemptyString(t.Hedge), isIdempotentHTTPMethod(string(method)))); err != nil {
+		return Result{Error: errors.Wrap(err, "hedge")}, runInfo
+	}
 
 	if len(reqHeaders)%2 != 0 {
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
@@ -103,7 +112,11 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	resp := t.guard.do(requestCtx, url.Host, bool(hedge), func(ctx context.Context) (r httpResponse) {
+		r.body, r.statusCode, r.headers, r.elapsed, r.err = makeHTTPRequest(ctx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		return
+	})
+	responseBytes, statusCode, respHeaders, elapsed, err := resp.body, resp.statusCode, resp.headers, resp.elapsed, resp.err
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
@@ -127,3 +140,12 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	// value instead.
 	return Result{Value: string(responseBytes)}, runInfo
 }
+
+func isIdempotentHTTPMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead:
+		return true
+	default:
+		return false
+	}
+}
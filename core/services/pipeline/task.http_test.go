@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -320,6 +322,101 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Nil(t, result.Value)
 }
 
+func TestHTTPTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.CircuitBreaker.Enabled = ptr(true)
+		c.JobPipeline.CircuitBreaker.FailureThreshold = ptr[uint32](2)
+		c.JobPipeline.CircuitBreaker.OpenTimeout = models.MustNewDuration(time.Hour)
+	})
+	var calls atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method: "GET",
+		URL:    server.URL,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(config, c, c)
+	task.HelperSetHTTPGuard(config, logger.TestLogger(t))
+
+	for i := 0; i < 2; i++ {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.True(t, runInfo.IsRetryable)
+		require.Error(t, result.Error)
+		require.NotErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	}
+
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.False(t, runInfo.IsRetryable)
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestHTTPTask_Hedging(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.HTTPRequest.HedgingPercentile = ptr[uint16](50)
+	})
+
+	// run warms up the latency samples for the server with fast requests,
+	// then sends one slow request and returns how many requests reached the
+	// server for it.
+	run := func(t *testing.T, method string, hedge string) int32 {
+		var calls, slow atomic.Int32
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			if slow.Load() == 1 {
+				time.Sleep(200 * time.Millisecond)
+			}
+			_, _ = w.Write([]byte("ok"))
+		})
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		task := pipeline.HTTPTask{
+			Method: method,
+			URL:    server.URL,
+			Hedge:  hedge,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config, c, c)
+		task.HelperSetHTTPGuard(config, logger.TestLogger(t))
+
+		for i := 0; i < 10; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+		}
+		warmup := calls.Load()
+
+		slow.Store(1)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		return calls.Load() - warmup
+	}
+
+	t.Run("GET is hedged", func(t *testing.T) {
+		assert.Equal(t, int32(2), run(t, "GET", ""))
+	})
+	t.Run("POST is not hedged by default", func(t *testing.T) {
+		assert.Equal(t, int32(1), run(t, "POST", ""))
+	})
+	t.Run("POST is hedged when enabled", func(t *testing.T) {
+		assert.Equal(t, int32(2), run(t, "POST", "true"))
+	})
+	t.Run("GET is not hedged when disabled", func(t *testing.T) {
+		assert.Equal(t, int32(1), run(t, "GET", "false"))
+	})
+}
+
 func TestHTTPTask_Headers(t *testing.T) {
 	allHeaders := func(headers http.Header) (s []string) {
 		var keys []string
@@ -0,0 +1,52 @@
+package pipeline
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates the spans of pipeline runs. Spans are only recorded and
+// exported when tracing is enabled, see package tracing.
+var tracer = otel.Tracer("github.com/smartcontractkit/chainlink/v2/core/services/pipeline")
+
+func runAttributes(run *Run) trace.SpanStartOption {
+	return trace.WithAttributes(
+		attribute.Int64("job.id", int64(run.PipelineSpec.JobID)),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+		attribute.String("job.type", run.PipelineSpec.JobType),
+		attribute.Int64("pipeline.run.id", run.ID),
+	)
+}
+
+func taskAttributes(taskRun *memoryTaskRun) trace.SpanStartOption {
+	return trace.WithAttributes(
+		attribute.String("task.dot_id", taskRun.task.DotID()),
+		attribute.String("task.type", string(taskRun.task.Type())),
+		attribute.Int64("task.attempt", int64(taskRun.attempts)),
+	)
+}
+
+// recordError marks span as failed with err, if any.
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// injectTraceHeaders returns headers with the trace context of ctx appended, so
+// that external adapters can continue the trace.
+func injectTraceHeaders(ctx context.Context, headers []string) []string {
+	carrier := propagation.HeaderCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	for _, key := range carrier.Keys() {
+		headers = append(headers[:len(headers):len(headers)], key, carrier.Get(key))
+	}
+	return headers
+}
@@ -0,0 +1,114 @@
+package pipeline_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	traceparents := make(chan string, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents <- r.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"result":42}}`))
+	}))
+	defer s.Close()
+	u, err := url.ParseRequestURI(s.URL)
+	require.NoError(t, err)
+
+	bt := bridges.BridgeType{Name: bridges.MustParseBridgeName("traced"), URL: models.WebURL(*u)}
+	btORM := bridgesMocks.NewORM(t)
+	btORM.On("FindBridge", bt.Name).Return(bt, nil).Once()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(mocks.NewORM(t), btORM, cfg, nil, nil, nil, logger.TestLogger(t), c, c)
+
+	spec := pipeline.Spec{
+		JobID:   1,
+		JobName: "traced job",
+		JobType: "webhook",
+		DotDagSource: `
+ds1 [type=bridge name=traced]
+ds1_parse [type=jsonparse path="data,result"]
+ds1_divide [type=divide divisor=0]
+
+ds1->ds1_parse->ds1_divide;
+`,
+	}
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.True(t, run.HasFatalErrors())
+
+	spans := recorder.Ended()
+	byName := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range spans {
+		byName[span.Name()] = span
+	}
+	require.Len(t, byName, 5)
+
+	runSpan := byName["pipeline run"]
+	require.NotNil(t, runSpan)
+	assert.Equal(t, codes.Error, runSpan.Status().Code)
+	assert.Equal(t, "traced job", attributeOf(t, runSpan, "job.name").Value.AsString())
+
+	for _, name := range []string{"pipeline task bridge", "pipeline task jsonparse", "pipeline task divide"} {
+		span := byName[name]
+		require.NotNil(t, span, name)
+		assert.Equal(t, runSpan.SpanContext().SpanID(), span.Parent().SpanID(), name)
+		assert.Equal(t, runSpan.SpanContext().TraceID(), span.SpanContext().TraceID(), name)
+	}
+	assert.Equal(t, codes.Error, byName["pipeline task divide"].Status().Code)
+	assert.Equal(t, codes.Unset, byName["pipeline task jsonparse"].Status().Code)
+
+	// The bridge request is traced and continued by the external adapter.
+	bridgeSpan := byName["bridge request"]
+	require.NotNil(t, bridgeSpan)
+	assert.Equal(t, byName["pipeline task bridge"].SpanContext().SpanID(), bridgeSpan.Parent().SpanID())
+	assert.Equal(t, "traced", attributeOf(t, bridgeSpan, "bridge.name").Value.AsString())
+
+	traceparent := <-traceparents
+	assert.Contains(t, traceparent, bridgeSpan.SpanContext().TraceID().String())
+	assert.Contains(t, traceparent, bridgeSpan.SpanContext().SpanID().String())
+}
+
+func attributeOf(t *testing.T, span sdktrace.ReadOnlySpan, key attribute.Key) attribute.KeyValue {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv
+		}
+	}
+	t.Fatalf("span %q has no attribute %q", span.Name(), key)
+	return attribute.KeyValue{}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
@@ -41,6 +42,7 @@ import (
 var _ relaytypes.Relayer = &Relayer{}
 
 type RelayerConfig interface {
+	pg.QConfig
 }
 
 type Relayer struct {
@@ -108,7 +110,10 @@ func (r *Relayer) NewMercuryProvider(rargs relaytypes.RelayArgs, pargs relaytype
 		return nil, errors.WithStack(err)
 	}
 
-	reportCodec := reportcodec.NewEVMReportCodec(*relayConfig.FeedID, r.lggr.Named("ReportCodec"))
+	reportCodec, err := reportcodec.NewReportCodec(mercuryConfig.GetReportSchemaVersion(), *relayConfig.FeedID, r.lggr.Named("ReportCodec"))
+	if err != nil {
+		return nil, err
+	}
 
 	if !relayConfig.EffectiveTransmitterID.Valid {
 		return nil, errors.New("EffectiveTransmitterID must be specified")
@@ -118,11 +123,20 @@ func (r *Relayer) NewMercuryProvider(rargs relaytypes.RelayArgs, pargs relaytype
 		return nil, errors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
 
-	client, err := r.mercuryPool.Checkout(context.Background(), privKey, mercuryConfig.ServerPubKey, mercuryConfig.ServerURL())
-	if err != nil {
-		return nil, err
+	clients := make(map[string]wsrpc.Client)
+	for _, server := range mercuryConfig.GetServers() {
+		client, err := r.mercuryPool.Checkout(context.Background(), privKey, server.PubKey, server.URL)
+		if err != nil {
+			// Check the clients already checked out back in.
+			for _, c := range clients {
+				err = multierr.Append(err, c.Close())
+			}
+			return nil, err
+		}
+		clients[server.URL] = client
 	}
-	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), client, privKey.PublicKey, *relayConfig.FeedID)
+	orm := mercury.NewORM(r.db, r.lggr, r.cfg)
+	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), clients, mercuryConfig.GetServerPolicy(), privKey.PublicKey, rargs.JobID, *relayConfig.FeedID, reportCodec, orm)
 
 	return NewMercuryProvider(configWatcher, transmitter, reportCodec, r.lggr), nil
 }
@@ -326,7 +340,10 @@ func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, tran
 	}
 
 	scoped := configWatcher.chain.Config()
-	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	strategy := txm.NewPriorityTxStrategy(
+		txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout()),
+		scoped.EvmTxPriority(string(job.OffchainReporting2)),
+	)
 
 	var checker txm.EvmTransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
@@ -376,7 +393,10 @@ func newPipelineContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayAr
 	effectiveTransmitterAddress := common.HexToAddress(relayConfig.EffectiveTransmitterID.String)
 	transmitterAddress := common.HexToAddress(transmitterID)
 	scoped := configWatcher.chain.Config()
-	strategy := txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout())
+	strategy := txm.NewPriorityTxStrategy(
+		txm.NewQueueingTxStrategy(rargs.ExternalJobID, scoped.OCRDefaultTransactionQueueDepth(), scoped.DatabaseDefaultQueryTimeout()),
+		scoped.EvmTxPriority(string(job.OffchainReporting2)),
+	)
 
 	var checker txm.EvmTransmitCheckerSpec
 	if configWatcher.chain.Config().OCRSimulateTransactions() {
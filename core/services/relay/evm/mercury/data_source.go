@@ -2,9 +2,7 @@ package mercury
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"math/big"
 	"sync"
 
 	pkgerrors "github.com/pkg/errors"
@@ -18,7 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
-	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 )
 
 //go:generate mockery --quiet --name ChainHeadTracker --output ./mocks/ --case=underscore
@@ -38,12 +36,16 @@ type datasource struct {
 
 	chEnhancedTelem  chan<- ocrcommon.EnhancedTelemetryMercuryData
 	chainHeadTracker ChainHeadTracker
+
+	// schemaVersion is the report schema of the feed, which selects how the
+	// results of the pipeline are parsed
+	schemaVersion reportcodec.ReportSchemaVersion
 }
 
 var _ relaymercury.DataSource = &datasource{}
 
-func NewDataSource(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr logger.Logger, rr chan pipeline.Run, enhancedTelemChan chan ocrcommon.EnhancedTelemetryMercuryData, chainHeadTracker ChainHeadTracker) *datasource {
-	return &datasource{pr, jb, spec, lggr, rr, sync.RWMutex{}, enhancedTelemChan, chainHeadTracker}
+func NewDataSource(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr logger.Logger, rr chan pipeline.Run, enhancedTelemChan chan ocrcommon.EnhancedTelemetryMercuryData, chainHeadTracker ChainHeadTracker, schemaVersion reportcodec.ReportSchemaVersion) *datasource {
+	return &datasource{pr, jb, spec, lggr, rr, sync.RWMutex{}, enhancedTelemChan, chainHeadTracker, schemaVersion}
 }
 
 func (ds *datasource) Observe(ctx context.Context, repts ocrtypes.ReportTimestamp) (relaymercury.Observation, error) {
@@ -84,21 +86,16 @@ func (ds *datasource) Observe(ctx context.Context, repts ocrtypes.ReportTimestam
 	return parsed, nil
 }
 
-func toBigInt(val interface{}) (*big.Int, error) {
-	dec, err := utils.ToDecimal(val)
-	if err != nil {
-		return nil, err
-	}
-	return dec.BigInt(), nil
-}
-
-// parse expects the output of observe to be three values, in the following order:
-// 1. benchmark price
-// 2. bid
-// 3. ask
+// parse parses the terminal results of the run with the report schema of the
+// feed, see reportcodec.Schema.
 //
 // returns error on parse errors: if something is the wrong type
 func (ds *datasource) parse(trrs pipeline.TaskRunResults) (obs relaymercury.Observation, merr error) {
+	schema, err := reportcodec.GetSchema(ds.schemaVersion)
+	if err != nil {
+		return obs, err
+	}
+
 	var finaltrrs []pipeline.TaskRunResult
 	for _, trr := range trrs {
 		// only return terminal trrs from executeRun
@@ -109,49 +106,7 @@ func (ds *datasource) parse(trrs pipeline.TaskRunResults) (obs relaymercury.Obse
 
 	// pipeline.TaskRunResults comes ordered asc by index, this is guaranteed
 	// by the pipeline executor
-	if len(finaltrrs) != 3 {
-		return obs, fmt.Errorf("invalid number of results, expected: 3, got: %d", len(finaltrrs))
-	}
-	merr = errors.Join(
-		setBenchmarkPrice(&obs, finaltrrs[0].Result),
-		setBid(&obs, finaltrrs[1].Result),
-		setAsk(&obs, finaltrrs[2].Result),
-	)
-
-	return obs, merr
-}
-
-func setBenchmarkPrice(obs *relaymercury.Observation, res pipeline.Result) error {
-	if res.Error != nil {
-		obs.BenchmarkPrice.Err = res.Error
-	} else if val, err := toBigInt(res.Value); err != nil {
-		return fmt.Errorf("failed to parse BenchmarkPrice: %w", err)
-	} else {
-		obs.BenchmarkPrice.Val = val
-	}
-	return nil
-}
-
-func setBid(obs *relaymercury.Observation, res pipeline.Result) error {
-	if res.Error != nil {
-		obs.Bid.Err = res.Error
-	} else if val, err := toBigInt(res.Value); err != nil {
-		return fmt.Errorf("failed to parse Bid: %w", err)
-	} else {
-		obs.Bid.Val = val
-	}
-	return nil
-}
-
-func setAsk(obs *relaymercury.Observation, res pipeline.Result) error {
-	if res.Error != nil {
-		obs.Ask.Err = res.Error
-	} else if val, err := toBigInt(res.Value); err != nil {
-		return fmt.Errorf("failed to parse Ask: %w", err)
-	} else {
-		obs.Ask.Val = val
-	}
-	return nil
+	return schema.ParseObservation(finaltrrs)
 }
 
 // The context passed in here has a timeout of (ObservationTimeout + ObservationGracePeriod).
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
@@ -17,7 +18,9 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	mercurymocks "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -109,3 +112,42 @@ func TestMercurySetCurrentBlock(t *testing.T) {
 		headTracker.AssertExpectations(t)
 	})
 }
+
+func TestMercuryParse(t *testing.T) {
+	result := func(dotID string, val interface{}) pipeline.TaskRunResult {
+		return pipeline.TaskRunResult{
+			Task:   &pipeline.MedianTask{BaseTask: pipeline.NewBaseTask(0, dotID, nil, nil, 0)},
+			Result: pipeline.Result{Value: val},
+		}
+	}
+
+	t.Run("ReportSchemaV1 parses benchmark price, bid and ask", func(t *testing.T) {
+		ds := datasource{schemaVersion: reportcodec.ReportSchemaV1}
+		obs, err := ds.parse(pipeline.TaskRunResults{result("price", "123"), result("bid", "122"), result("ask", 124)})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(123), obs.BenchmarkPrice.Val)
+		assert.Equal(t, big.NewInt(122), obs.Bid.Val)
+		assert.Equal(t, big.NewInt(124), obs.Ask.Val)
+
+		_, err = ds.parse(pipeline.TaskRunResults{result("price", "123")})
+		assert.EqualError(t, err, "invalid number of results, expected: 3, got: 1")
+	})
+
+	t.Run("ReportSchemaV2 parses a single value", func(t *testing.T) {
+		ds := datasource{schemaVersion: reportcodec.ReportSchemaV2}
+		obs, err := ds.parse(pipeline.TaskRunResults{result("value", "123")})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(123), obs.BenchmarkPrice.Val)
+		assert.Equal(t, big.NewInt(123), obs.Bid.Val)
+		assert.Equal(t, big.NewInt(123), obs.Ask.Val)
+
+		_, err = ds.parse(pipeline.TaskRunResults{result("price", "123"), result("bid", "122"), result("ask", 124)})
+		assert.EqualError(t, err, "invalid number of results, expected: 1, got: 3")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		ds := datasource{schemaVersion: 42}
+		_, err := ds.parse(pipeline.TaskRunResults{result("value", "123")})
+		assert.EqualError(t, err, "unsupported report schema version 42, supported: [1 2]")
+	})
+}
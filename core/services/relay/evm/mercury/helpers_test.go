@@ -3,19 +3,20 @@ package mercury
 import (
 	"encoding/base64"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/reportcodec"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
-func buildSampleReport() []byte {
+func buildSampleReport(timestamp uint32) []byte {
 	feedID := [32]byte{'f', 'o', 'o'}
-	timestamp := uint32(42)
 	bp := big.NewInt(242)
 	bid := big.NewInt(243)
 	ask := big.NewInt(244)
@@ -54,8 +55,9 @@ func buildSamplePayload() []byte {
 
 var (
 	sampleFeedID        = [32]uint8{28, 145, 107, 74, 167, 229, 124, 167, 182, 138, 225, 191, 69, 101, 63, 86, 182, 86, 253, 58, 163, 53, 239, 127, 174, 105, 107, 102, 63, 27, 132, 114}
-	sampleReport        = buildSampleReport()
+	sampleReport        = buildSampleReport(uint32(time.Now().Unix()))
 	sampleReportHex     = hexutil.Encode(sampleReport)
+	sampleCodec         = reportcodec.NewEVMReportCodec(sampleFeedID, logger.NullLogger)
 	sampleClientPubKey  = hexutil.MustDecode("0x724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93")
 	sig2                = ocrtypes.AttributedOnchainSignature{Signature: mustDecodeBase64("kbeuRczizOJCxBzj7MUAFpz3yl2WRM6K/f0ieEBvA+oTFUaKslbQey10krumVjzAvlvKxMfyZo0WkOgNyfF6xwE="), Signer: 2}
 	sig3                = ocrtypes.AttributedOnchainSignature{Signature: mustDecodeBase64("9jz4b6Dh2WhXxQ97a6/S9UNjSfrEi9016XKTrfN0mLQFDiNuws23x7Z4n+6g0sqKH/hnxx1VukWUH/ohtw83/wE="), Signer: 3}
@@ -0,0 +1,57 @@
+package mercury
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type dropReason string
+
+const (
+	// dropReasonFull is a transmission evicted by a newer one from a full queue.
+	dropReasonFull dropReason = "full"
+	// dropReasonExpired is a transmission older than the maximum age.
+	dropReasonExpired dropReason = "expired"
+	// dropReasonRejected is a transmission the mercury server returned an error for.
+	dropReasonRejected dropReason = "rejected"
+)
+
+var (
+	transmitQueueDepthMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "mercury",
+		Name:      "transmit_queue_depth",
+		Help:      "Number of transmissions pending in the transmit queue",
+	}, []string{"serverURL", "feedID"})
+	transmitQueueDropCountMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mercury",
+		Name:      "transmit_queue_drop_count",
+		Help:      "Number of transmissions dropped without being delivered, by reason",
+	}, []string{"serverURL", "feedID", "reason"})
+	transmitDeliveryLatencyMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "mercury",
+		Name:      "transmit_delivery_latency_seconds",
+		Help:      "Time from enqueueing a transmission to its delivery to the Mercury server",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 60, 300, 3600},
+	}, []string{"serverURL", "feedID"})
+	transmitServerErrorCountMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mercury",
+		Name:      "transmit_server_error_count",
+		Help:      "Number of transmissions to the Mercury server that failed and were retried",
+	}, []string{"serverURL", "feedID"})
+)
+
+func setTransmitQueueDepthMetric(serverURL, feedID string, depth int) {
+	transmitQueueDepthMetric.WithLabelValues(serverURL, feedID).Set(float64(depth))
+}
+
+func incTransmitQueueDropMetric(serverURL, feedID string, reason dropReason) {
+	transmitQueueDropCountMetric.WithLabelValues(serverURL, feedID, string(reason)).Inc()
+}
+
+func observeTransmitDeliveryLatencyMetric(serverURL, feedID string, seconds float64) {
+	transmitDeliveryLatencyMetric.WithLabelValues(serverURL, feedID).Observe(seconds)
+}
+
+func incTransmitServerErrorMetric(serverURL, feedID string) {
+	transmitServerErrorCountMetric.WithLabelValues(serverURL, feedID).Inc()
+}
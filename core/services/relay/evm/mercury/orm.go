@@ -0,0 +1,130 @@
+package mercury
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// ORM persists the pending transmissions of mercury jobs to each of their
+// servers, so that they survive a restart. Transmissions are identified by
+// the hash of their payload.
+type ORM interface {
+	// InsertTransmitRequest stores t for jobID and serverURL. Storing the same
+	// payload twice is a no-op.
+	InsertTransmitRequest(ctx context.Context, jobID int32, serverURL string, t *Transmission) error
+	// DeleteTransmitRequests deletes the transmissions of reqs for jobID and
+	// serverURL.
+	DeleteTransmitRequests(ctx context.Context, jobID int32, serverURL string, reqs []*pb.TransmitRequest) error
+	// GetTransmitRequests returns at most limit transmissions of jobID to
+	// serverURL, latest first.
+	GetTransmitRequests(ctx context.Context, jobID int32, serverURL string, limit int) ([]*Transmission, error)
+	// PruneTransmitRequests deletes the transmissions of jobID to serverURL
+	// whose report was observed before cutoff.
+	PruneTransmitRequests(ctx context.Context, jobID int32, serverURL string, cutoff time.Time) (int64, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns an ORM backed by the mercury_transmit_requests table.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{q: pg.NewQ(db, lggr.Named("MercuryORM"), cfg)}
+}
+
+type transmitRequestRow struct {
+	Payload               []byte    `db:"payload"`
+	ConfigDigest          []byte    `db:"config_digest"`
+	Epoch                 int64     `db:"epoch"`
+	Round                 int64     `db:"round"`
+	ExtraHash             []byte    `db:"extra_hash"`
+	ObservationsTimestamp time.Time `db:"observations_timestamp"`
+	CreatedAt             time.Time `db:"created_at"`
+}
+
+func (r *transmitRequestRow) toTransmission() (*Transmission, error) {
+	cd, err := ocrtypes.BytesToConfigDigest(r.ConfigDigest)
+	if err != nil {
+		return nil, err
+	}
+	t := &Transmission{
+		Req: &pb.TransmitRequest{Payload: r.Payload},
+		ReportCtx: ocrtypes.ReportContext{
+			ReportTimestamp: ocrtypes.ReportTimestamp{
+				ConfigDigest: cd,
+				Epoch:        uint32(r.Epoch),
+				Round:        uint8(r.Round),
+			},
+		},
+		ObservationsTimestamp: r.ObservationsTimestamp,
+		CreatedAt:             r.CreatedAt,
+		index:                 -1,
+	}
+	copy(t.ReportCtx.ExtraHash[:], r.ExtraHash)
+	return t, nil
+}
+
+func hashPayload(payload []byte) []byte {
+	h := sha256.Sum256(payload)
+	return h[:]
+}
+
+func (o *orm) InsertTransmitRequest(ctx context.Context, jobID int32, serverURL string, t *Transmission) error {
+	ts := t.ReportCtx.ReportTimestamp
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQ(`INSERT INTO mercury_transmit_requests (job_id, server_url, payload_hash, payload, config_digest, epoch, round, extra_hash, observations_timestamp, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (job_id, server_url, payload_hash) DO NOTHING`,
+		jobID, serverURL, hashPayload(t.Req.Payload), t.Req.Payload, ts.ConfigDigest[:], ts.Epoch, ts.Round, t.ReportCtx.ExtraHash[:], t.ObservationsTimestamp, t.CreatedAt)
+	return errors.Wrap(err, "failed to insert mercury transmit request")
+}
+
+func (o *orm) DeleteTransmitRequests(ctx context.Context, jobID int32, serverURL string, reqs []*pb.TransmitRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+	hashes := make([][]byte, len(reqs))
+	for i, req := range reqs {
+		hashes[i] = hashPayload(req.Payload)
+	}
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQ(`DELETE FROM mercury_transmit_requests WHERE job_id = $1 AND server_url = $2 AND payload_hash = ANY($3)`, jobID, serverURL, pq.ByteaArray(hashes))
+	return errors.Wrap(err, "failed to delete mercury transmit requests")
+}
+
+func (o *orm) GetTransmitRequests(ctx context.Context, jobID int32, serverURL string, limit int) ([]*Transmission, error) {
+	var rows []transmitRequestRow
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).Select(&rows, `SELECT payload, config_digest, epoch, round, extra_hash, observations_timestamp, created_at FROM mercury_transmit_requests
+		WHERE job_id = $1 AND server_url = $2
+		ORDER BY epoch DESC, round DESC
+		LIMIT $3`, jobID, serverURL, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get mercury transmit requests")
+	}
+	transmissions := make([]*Transmission, len(rows))
+	for i := range rows {
+		if transmissions[i], err = rows[i].toTransmission(); err != nil {
+			return nil, errors.Wrap(err, "invalid mercury transmit request")
+		}
+	}
+	return transmissions, nil
+}
+
+func (o *orm) PruneTransmitRequests(ctx context.Context, jobID int32, serverURL string, cutoff time.Time) (int64, error) {
+	res, cancel, err := o.q.WithOpts(pg.WithParentCtx(ctx)).ExecQIter(`DELETE FROM mercury_transmit_requests WHERE job_id = $1 AND server_url = $2 AND observations_timestamp < $3`, jobID, serverURL, cutoff)
+	defer cancel()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune mercury transmit requests")
+	}
+	return res.RowsAffected()
+}
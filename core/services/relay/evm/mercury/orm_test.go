@@ -0,0 +1,64 @@
+package mercury
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS mercury_transmit_requests_job_id_fkey DEFERRED`)))
+	orm := NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	testTransmissions := createTestTransmissions(t)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	for i, tt := range testTransmissions {
+		// Enqueued in the opposite order of their observations, as transmissions are pruned by the latter.
+		require.NoError(t, orm.InsertTransmitRequest(ctx, 1, "example.com", &Transmission{Req: tt.tr, ReportCtx: tt.ctx, ObservationsTimestamp: now.Add(time.Duration(i) * time.Minute), CreatedAt: now.Add(-time.Duration(i) * time.Minute)}))
+	}
+	// Inserting the same payload again is a no-op.
+	require.NoError(t, orm.InsertTransmitRequest(ctx, 1, "example.com", &Transmission{Req: testTransmissions[0].tr, ReportCtx: testTransmissions[0].ctx, ObservationsTimestamp: now, CreatedAt: now}))
+	require.NoError(t, orm.InsertTransmitRequest(ctx, 2, "example.com", &Transmission{Req: testTransmissions[0].tr, ReportCtx: testTransmissions[0].ctx, ObservationsTimestamp: now, CreatedAt: now}))
+	require.NoError(t, orm.InsertTransmitRequest(ctx, 1, "example.org", &Transmission{Req: testTransmissions[0].tr, ReportCtx: testTransmissions[0].ctx, ObservationsTimestamp: now, CreatedAt: now}))
+
+	transmissions, err := orm.GetTransmitRequests(ctx, 1, "example.com", 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 3)
+	assert.Equal(t, testTransmissions[2].tr.Payload, transmissions[0].Req.Payload)
+	assert.Equal(t, testTransmissions[2].ctx, transmissions[0].ReportCtx)
+	assert.Equal(t, now.Add(2*time.Minute), transmissions[0].ObservationsTimestamp.UTC())
+	assert.Equal(t, now.Add(-2*time.Minute), transmissions[0].CreatedAt.UTC())
+	assert.Equal(t, testTransmissions[0].tr.Payload, transmissions[2].Req.Payload)
+
+	transmissions, err = orm.GetTransmitRequests(ctx, 1, "example.com", 1)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+
+	require.NoError(t, orm.DeleteTransmitRequests(ctx, 1, "example.com", []*pb.TransmitRequest{testTransmissions[2].tr}))
+	pruned, err := orm.PruneTransmitRequests(ctx, 1, "example.com", now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	transmissions, err = orm.GetTransmitRequests(ctx, 1, "example.com", 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	assert.Equal(t, testTransmissions[1].tr.Payload, transmissions[0].Req.Payload)
+
+	// The other job and server are untouched.
+	transmissions, err = orm.GetTransmitRequests(ctx, 2, "example.com", 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	transmissions, err = orm.GetTransmitRequests(ctx, 1, "example.org", 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+}
@@ -0,0 +1,161 @@
+package mercury
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	flushDeletesFrequency = time.Second
+	pruneFrequency        = time.Hour
+)
+
+// PersistenceManager stores the pending transmissions of a job to a server in
+// the db, deletes them once they are delivered or dropped, and prunes those
+// whose report is older than maxAge.
+type PersistenceManager struct {
+	utils.StartStopOnce
+	lggr      logger.Logger
+	orm       ORM
+	jobID     int32
+	serverURL string
+	maxlen    int
+	maxAge    time.Duration
+
+	deleteMu    sync.Mutex
+	deleteQueue []*pb.TransmitRequest
+
+	stopCh utils.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewPersistenceManager returns a PersistenceManager for the transmissions
+// of jobID to serverURL. Load returns at most maxlen transmissions.
+func NewPersistenceManager(lggr logger.Logger, orm ORM, jobID int32, serverURL string, maxlen int, maxAge time.Duration) *PersistenceManager {
+	return &PersistenceManager{
+		lggr:      lggr.Named("PersistenceManager"),
+		orm:       orm,
+		jobID:     jobID,
+		serverURL: serverURL,
+		maxlen:    maxlen,
+		maxAge:    maxAge,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (pm *PersistenceManager) Start(ctx context.Context) error {
+	return pm.StartOnce("MercuryPersistenceManager", func() error {
+		pm.wg.Add(2)
+		go pm.runFlushDeletesLoop()
+		go pm.runPruneLoop()
+		return nil
+	})
+}
+
+func (pm *PersistenceManager) Close() error {
+	return pm.StopOnce("MercuryPersistenceManager", func() error {
+		close(pm.stopCh)
+		pm.wg.Wait()
+		return nil
+	})
+}
+
+// Insert stores t.
+func (pm *PersistenceManager) Insert(ctx context.Context, t *Transmission) error {
+	return pm.orm.InsertTransmitRequest(ctx, pm.jobID, pm.serverURL, t)
+}
+
+// Delete deletes the transmission of req.
+func (pm *PersistenceManager) Delete(ctx context.Context, req *pb.TransmitRequest) error {
+	return pm.orm.DeleteTransmitRequests(ctx, pm.jobID, pm.serverURL, []*pb.TransmitRequest{req})
+}
+
+// AsyncDelete deletes the transmission of req with the next batch of deletes.
+func (pm *PersistenceManager) AsyncDelete(req *pb.TransmitRequest) {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	pm.deleteQueue = append(pm.deleteQueue, req)
+}
+
+// Load prunes the expired transmissions, then returns the latest ones.
+func (pm *PersistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
+	if _, err := pm.prune(ctx); err != nil {
+		return nil, err
+	}
+	return pm.orm.GetTransmitRequests(ctx, pm.jobID, pm.serverURL, pm.maxlen)
+}
+
+// Expired returns whether the observations of the report of t are older than
+// maxAge.
+func (pm *PersistenceManager) Expired(t *Transmission) bool {
+	return time.Since(t.ObservationsTimestamp) > pm.maxAge
+}
+
+func (pm *PersistenceManager) prune(ctx context.Context) (int64, error) {
+	return pm.orm.PruneTransmitRequests(ctx, pm.jobID, pm.serverURL, time.Now().Add(-pm.maxAge))
+}
+
+func (pm *PersistenceManager) runFlushDeletesLoop() {
+	defer pm.wg.Done()
+	ctx, cancel := pm.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(flushDeletesFrequency))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pm.stopCh:
+			// Flush the deletes queued before closing.
+			ctx, cancel := context.WithTimeout(context.Background(), flushDeletesFrequency)
+			pm.flushDeletes(ctx)
+			cancel()
+			return
+		case <-ticker.C:
+			pm.flushDeletes(ctx)
+		}
+	}
+}
+
+func (pm *PersistenceManager) flushDeletes(ctx context.Context) {
+	pm.deleteMu.Lock()
+	queue := pm.deleteQueue
+	pm.deleteQueue = nil
+	pm.deleteMu.Unlock()
+	if len(queue) == 0 {
+		return
+	}
+	if err := pm.orm.DeleteTransmitRequests(ctx, pm.jobID, pm.serverURL, queue); err != nil {
+		pm.lggr.Errorw("Failed to delete queued transmit requests", "err", err)
+		// Retried with the next batch.
+		pm.deleteMu.Lock()
+		pm.deleteQueue = append(pm.deleteQueue, queue...)
+		pm.deleteMu.Unlock()
+	}
+}
+
+func (pm *PersistenceManager) runPruneLoop() {
+	defer pm.wg.Done()
+	ctx, cancel := pm.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(pruneFrequency))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pm.stopCh:
+			return
+		case <-ticker.C:
+			pruned, err := pm.prune(ctx)
+			if err != nil {
+				pm.lggr.Errorw("Failed to prune transmit requests", "err", err)
+			} else if pruned > 0 {
+				pm.lggr.Infow("Pruned expired transmit requests", "count", pruned, "maxAge", pm.maxAge)
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package mercury
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// MockORM is an in-memory ORM
+type MockORM struct {
+	mu            sync.Mutex
+	transmissions map[string]*Transmission
+}
+
+func NewMockORM() *MockORM {
+	return &MockORM{transmissions: make(map[string]*Transmission)}
+}
+
+var _ ORM = &MockORM{}
+
+func mockORMKey(jobID int32, serverURL string, req *pb.TransmitRequest) string {
+	return fmt.Sprintf("%d:%s:%x", jobID, serverURL, hashPayload(req.Payload))
+}
+
+func (m *MockORM) InsertTransmitRequest(ctx context.Context, jobID int32, serverURL string, t *Transmission) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := mockORMKey(jobID, serverURL, t.Req)
+	if _, ok := m.transmissions[key]; !ok {
+		m.transmissions[key] = &Transmission{Req: t.Req, ReportCtx: t.ReportCtx, ObservationsTimestamp: t.ObservationsTimestamp, CreatedAt: t.CreatedAt, index: -1}
+	}
+	return nil
+}
+
+func (m *MockORM) DeleteTransmitRequests(ctx context.Context, jobID int32, serverURL string, reqs []*pb.TransmitRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, req := range reqs {
+		delete(m.transmissions, mockORMKey(jobID, serverURL, req))
+	}
+	return nil
+}
+
+func (m *MockORM) GetTransmitRequests(ctx context.Context, jobID int32, serverURL string, limit int) ([]*Transmission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var transmissions []*Transmission
+	for key, t := range m.transmissions {
+		if key == mockORMKey(jobID, serverURL, t.Req) {
+			transmissions = append(transmissions, t)
+		}
+	}
+	sort.Slice(transmissions, func(i, j int) bool {
+		return transmissions[i].ReportCtx.Epoch > transmissions[j].ReportCtx.Epoch
+	})
+	if len(transmissions) > limit {
+		transmissions = transmissions[:limit]
+	}
+	return transmissions, nil
+}
+
+func (m *MockORM) PruneTransmitRequests(ctx context.Context, jobID int32, serverURL string, cutoff time.Time) (pruned int64, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, t := range m.transmissions {
+		if key == mockORMKey(jobID, serverURL, t.Req) && t.ObservationsTimestamp.Before(cutoff) {
+			delete(m.transmissions, key)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+func (m *MockORM) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.transmissions)
+}
+
+func Test_PersistenceManager(t *testing.T) {
+	t.Parallel()
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	testTransmissions := createTestTransmissions(t)
+	orm := NewMockORM()
+	pm := NewPersistenceManager(lggr, orm, 1, "", 2, time.Hour)
+
+	for i, tt := range testTransmissions {
+		observationsTimestamp := time.Now()
+		if i == 0 {
+			observationsTimestamp = observationsTimestamp.Add(-2 * time.Hour)
+		}
+		require.NoError(t, pm.Insert(ctx, &Transmission{Req: tt.tr, ReportCtx: tt.ctx, ObservationsTimestamp: observationsTimestamp, CreatedAt: time.Now()}))
+	}
+	// Same payload for another job.
+	require.NoError(t, orm.InsertTransmitRequest(ctx, 2, "", &Transmission{Req: testTransmissions[0].tr, ReportCtx: testTransmissions[0].ctx, ObservationsTimestamp: time.Now().Add(-2 * time.Hour), CreatedAt: time.Now()}))
+
+	t.Run("Load prunes expired transmissions and returns the latest", func(t *testing.T) {
+		transmissions, err := pm.Load(ctx)
+		require.NoError(t, err)
+		require.Len(t, transmissions, 2)
+		assert.Equal(t, testTransmissions[2].tr, transmissions[0].Req)
+		assert.Equal(t, testTransmissions[1].tr, transmissions[1].Req)
+		assert.False(t, pm.Expired(transmissions[0]))
+		assert.True(t, pm.Expired(&Transmission{ObservationsTimestamp: time.Now().Add(-2 * time.Hour), CreatedAt: time.Now()}))
+		assert.Equal(t, 3, orm.len())
+	})
+
+	t.Run("Close flushes queued deletes", func(t *testing.T) {
+		require.NoError(t, pm.Start(ctx))
+		pm.AsyncDelete(testTransmissions[1].tr)
+		pm.AsyncDelete(testTransmissions[2].tr)
+		require.NoError(t, pm.Close())
+		assert.Equal(t, 1, orm.len())
+	})
+}
+
+func Test_Queue_EvictionDeletesTransmission(t *testing.T) {
+	t.Parallel()
+	lggr := logger.TestLogger(t)
+	testTransmissions := createTestTransmissions(t)
+	orm := NewMockORM()
+	pm := NewPersistenceManager(lggr, orm, 1, "", 1, time.Hour)
+	tq := NewTransmitQueue(lggr, "", "foo", 1, pm)
+
+	for _, tt := range testTransmissions[:2] {
+		require.NoError(t, pm.Insert(testutils.Context(t), &Transmission{Req: tt.tr, ReportCtx: tt.ctx, ObservationsTimestamp: time.Now(), CreatedAt: time.Now()}))
+		require.True(t, tq.Push(tt.tr, tt.ctx))
+	}
+	require.NoError(t, pm.Start(testutils.Context(t)))
+	require.NoError(t, pm.Close())
+
+	transmissions, err := orm.GetTransmitRequests(testutils.Context(t), 1, "", 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	assert.Equal(t, testTransmissions[1].tr, transmissions[0].Req)
+	assert.Equal(t, testTransmissions[1].tr, tq.BlockingPop().Req)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
@@ -19,18 +20,24 @@ var _ services.ServiceCtx = (*TransmitQueue)(nil)
 // TransmitQueue is the high-level package that everything outside of this file should be using
 // It stores pending transmissions, yielding the latest (highest priority) first to the caller
 type TransmitQueue struct {
-	cond sync.Cond
-	lggr logger.Logger
-	mu   *sync.RWMutex
+	cond      sync.Cond
+	lggr      logger.Logger
+	mu        *sync.RWMutex
+	serverURL string
+	feedID    string
 
 	pq     *priorityQueue
 	maxlen int
 	closed bool
+
+	persistenceManager *PersistenceManager
 }
 
 type Transmission struct {
-	Req       *pb.TransmitRequest    // the payload to transmit
-	ReportCtx ocrtypes.ReportContext // contains priority information (latest epoch/round wins)
+	Req                   *pb.TransmitRequest    // the payload to transmit
+	ReportCtx             ocrtypes.ReportContext // contains priority information (latest epoch/round wins)
+	ObservationsTimestamp time.Time              // of the report, the transmission expires by its age
+	CreatedAt             time.Time              // when the transmission was first enqueued
 
 	// The index is needed by update and is maintained by the heap.Interface
 	// methods
@@ -40,14 +47,47 @@ type Transmission struct {
 
 // maxlen controls how many items will be stored in the queue
 // 0 means unlimited - be careful, this can cause memory leaks
-func NewTransmitQueue(lggr logger.Logger, maxlen int) *TransmitQueue {
+// If persistenceManager is set, the transmissions evicted from the queue are deleted from the db
+func NewTransmitQueue(lggr logger.Logger, serverURL, feedID string, maxlen int, persistenceManager *PersistenceManager) *TransmitQueue {
 	pq := new(priorityQueue)
 	heap.Init(pq) // for completeness
 	mu := new(sync.RWMutex)
-	return &TransmitQueue{sync.Cond{L: mu}, lggr.Named("TransmitQueue"), mu, pq, maxlen, false}
+	return &TransmitQueue{
+		cond:               sync.Cond{L: mu},
+		lggr:               lggr.Named("TransmitQueue"),
+		mu:                 mu,
+		serverURL:          serverURL,
+		feedID:             feedID,
+		pq:                 pq,
+		maxlen:             maxlen,
+		persistenceManager: persistenceManager,
+	}
+}
+
+// Init pushes the transmissions loaded from the db, keeping their timestamps
+func (tq *TransmitQueue) Init(transmissions []*Transmission) {
+	for _, t := range transmissions {
+		tq.push(t)
+	}
 }
 
+// Push enqueues req as a transmission of a report observed now
 func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) (ok bool) {
+	now := time.Now()
+	return tq.push(&Transmission{Req: req, ReportCtx: reportCtx, ObservationsTimestamp: now, CreatedAt: now, index: -1})
+}
+
+func (tq *TransmitQueue) push(t *Transmission) (ok bool) {
+	var evicted *Transmission
+	defer func() {
+		if evicted != nil {
+			incTransmitQueueDropMetric(tq.serverURL, tq.feedID, dropReasonFull)
+			if tq.persistenceManager != nil {
+				tq.persistenceManager.AsyncDelete(evicted.Req)
+			}
+		}
+	}()
+
 	tq.cond.L.Lock()
 	defer tq.cond.L.Unlock()
 
@@ -58,10 +98,11 @@ func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.Report
 	if tq.maxlen != 0 && tq.pq.Len() == tq.maxlen {
 		// evict oldest entry to make room
 		tq.lggr.Criticalf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen)
-		heap.Remove(tq.pq, tq.pq.Len()-1)
+		evicted = heap.Remove(tq.pq, tq.pq.Len()-1).(*Transmission)
 	}
 
-	heap.Push(tq.pq, &Transmission{req, reportCtx, -1})
+	heap.Push(tq.pq, t)
+	setTransmitQueueDepthMetric(tq.serverURL, tq.feedID, tq.pq.Len())
 	tq.cond.Signal()
 
 	return true
@@ -130,7 +171,9 @@ func (tq *TransmitQueue) pop() *Transmission {
 	if tq.pq.Len() == 0 {
 		return nil
 	}
-	return heap.Pop(tq.pq).(*Transmission)
+	t := heap.Pop(tq.pq).(*Transmission)
+	setTransmitQueueDepthMetric(tq.serverURL, tq.feedID, tq.pq.Len())
+	return t
 }
 
 // HEAP
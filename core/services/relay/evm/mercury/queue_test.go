@@ -66,7 +66,7 @@ func Test_Queue(t *testing.T) {
 	t.Parallel()
 	lggr, observedLogs := logger.TestLoggerObserved(t, zapcore.ErrorLevel)
 	testTransmissions := createTestTransmissions(t)
-	transmitQueue := NewTransmitQueue(lggr, 7)
+	transmitQueue := NewTransmitQueue(lggr, "", "", 7, nil)
 
 	t.Run("successfully add transmissions to transmit queue", func(t *testing.T) {
 		for _, tt := range testTransmissions {
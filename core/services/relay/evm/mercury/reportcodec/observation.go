@@ -0,0 +1,87 @@
+package reportcodec
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ParseObservationV1 expects three results in the following order:
+// 1. benchmark price
+// 2. bid
+// 3. ask
+//
+// returns error on parse errors: if something is the wrong type
+func ParseObservationV1(trrs pipeline.TaskRunResults) (obs relaymercury.Observation, merr error) {
+	if len(trrs) != 3 {
+		return obs, fmt.Errorf("invalid number of results, expected: 3, got: %d", len(trrs))
+	}
+	merr = errors.Join(
+		setBenchmarkPrice(&obs, trrs[0].Result),
+		setBid(&obs, trrs[1].Result),
+		setAsk(&obs, trrs[2].Result),
+	)
+	return obs, merr
+}
+
+// ParseObservationV2 expects a single result, the value. See ReportSchemaV2
+// for why it is also observed as the bid and ask.
+//
+// returns error on parse errors: if something is the wrong type
+func ParseObservationV2(trrs pipeline.TaskRunResults) (obs relaymercury.Observation, merr error) {
+	if len(trrs) != 1 {
+		return obs, fmt.Errorf("invalid number of results, expected: 1, got: %d", len(trrs))
+	}
+	merr = errors.Join(
+		setBenchmarkPrice(&obs, trrs[0].Result),
+		setBid(&obs, trrs[0].Result),
+		setAsk(&obs, trrs[0].Result),
+	)
+	return obs, merr
+}
+
+func toBigInt(val interface{}) (*big.Int, error) {
+	dec, err := utils.ToDecimal(val)
+	if err != nil {
+		return nil, err
+	}
+	return dec.BigInt(), nil
+}
+
+func setBenchmarkPrice(obs *relaymercury.Observation, res pipeline.Result) error {
+	if res.Error != nil {
+		obs.BenchmarkPrice.Err = res.Error
+	} else if val, err := toBigInt(res.Value); err != nil {
+		return fmt.Errorf("failed to parse BenchmarkPrice: %w", err)
+	} else {
+		obs.BenchmarkPrice.Val = val
+	}
+	return nil
+}
+
+func setBid(obs *relaymercury.Observation, res pipeline.Result) error {
+	if res.Error != nil {
+		obs.Bid.Err = res.Error
+	} else if val, err := toBigInt(res.Value); err != nil {
+		return fmt.Errorf("failed to parse Bid: %w", err)
+	} else {
+		obs.Bid.Val = val
+	}
+	return nil
+}
+
+func setAsk(obs *relaymercury.Observation, res pipeline.Result) error {
+	if res.Error != nil {
+		obs.Ask.Err = res.Error
+	} else if val, err := toBigInt(res.Value); err != nil {
+		return fmt.Errorf("failed to parse Ask: %w", err)
+	} else {
+		obs.Ask.Val = val
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package reportcodec
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ReportSchemaVersion identifies the layout of the reports of a mercury feed.
+type ReportSchemaVersion uint32
+
+const (
+	// ReportSchemaV1 is the price report of EVMReportCodec, with benchmark
+	// price, bid and ask.
+	ReportSchemaV1 ReportSchemaVersion = 1
+	// ReportSchemaV2 is the single value report of EVMReportCodecV2, e.g. for
+	// non-price data. Its reports are valid at their current block only, so
+	// they have no validFromBlockNum.
+	//
+	// The mercury plugin validates the bid and ask of every observation,
+	// which this schema does not have, so ParseObservationV2 observes the
+	// value as the benchmark price, bid and ask alike; the bid and ask are
+	// not reported.
+	ReportSchemaV2 ReportSchemaVersion = 2
+
+	// DefaultReportSchemaVersion is the version of the jobs which do not
+	// specify one.
+	DefaultReportSchemaVersion = ReportSchemaV1
+)
+
+// ReportCodec is a relaymercury.ReportCodec which also decodes the
+// observations timestamp of its reports, by which the transmitter expires
+// them.
+type ReportCodec interface {
+	relaymercury.ReportCodec
+	ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error)
+}
+
+// Factory returns the ReportCodec of the feed feedID.
+type Factory func(feedID [32]byte, lggr logger.Logger) ReportCodec
+
+// ObservationParser parses the terminal results of a pipeline run, ordered by
+// task index, into an observation.
+type ObservationParser func(trrs pipeline.TaskRunResults) (relaymercury.Observation, error)
+
+// Schema is what a report schema version plugs into the mercury plugin: how
+// its reports are encoded and how observations are parsed from the pipeline.
+type Schema struct {
+	NewReportCodec   Factory
+	ParseObservation ObservationParser
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[ReportSchemaVersion]Schema{
+		ReportSchemaV1: {
+			NewReportCodec: func(feedID [32]byte, lggr logger.Logger) ReportCodec {
+				return NewEVMReportCodec(feedID, lggr)
+			},
+			ParseObservation: ParseObservationV1,
+		},
+		ReportSchemaV2: {
+			NewReportCodec: func(feedID [32]byte, lggr logger.Logger) ReportCodec {
+				return NewEVMReportCodecV2(feedID, lggr)
+			},
+			ParseObservation: ParseObservationV2,
+		},
+	}
+)
+
+// Register adds the Schema of version. Registering a version twice is an
+// error.
+func Register(version ReportSchemaVersion, schema Schema) error {
+	if version == 0 {
+		return errors.New("report schema version must be positive")
+	}
+	if schema.NewReportCodec == nil || schema.ParseObservation == nil {
+		return errors.Errorf("report schema version %d is incomplete", version)
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[version]; ok {
+		return errors.Errorf("report schema version %d is already registered", version)
+	}
+	registry[version] = schema
+	return nil
+}
+
+// IsSupported returns whether a Schema is registered for version.
+func IsSupported(version ReportSchemaVersion) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	_, ok := registry[version]
+	return ok
+}
+
+// SupportedVersions returns the registered versions, in ascending order.
+func SupportedVersions() []ReportSchemaVersion {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	versions := make([]ReportSchemaVersion, 0, len(registry))
+	for v := range registry {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// GetSchema returns the Schema of version.
+func GetSchema(version ReportSchemaVersion) (Schema, error) {
+	registryMu.RLock()
+	schema, ok := registry[version]
+	registryMu.RUnlock()
+	if !ok {
+		return Schema{}, errors.Errorf("unsupported report schema version %d, supported: %v", version, SupportedVersions())
+	}
+	return schema, nil
+}
+
+// NewReportCodec returns the ReportCodec of version for the feed feedID.
+func NewReportCodec(version ReportSchemaVersion, feedID [32]byte, lggr logger.Logger) (ReportCodec, error) {
+	schema, err := GetSchema(version)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewReportCodec(feedID, lggr), nil
+}
@@ -0,0 +1,52 @@
+package reportcodec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func Test_Registry(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	feedID := [32]byte{'f', 'o', 'o'}
+
+	t.Run("NewReportCodec returns the codec of the version", func(t *testing.T) {
+		c, err := NewReportCodec(ReportSchemaV1, feedID, lggr)
+		require.NoError(t, err)
+		assert.IsType(t, &EVMReportCodec{}, c)
+		assert.Equal(t, feedID, c.(*EVMReportCodec).feedID)
+
+		c, err = NewReportCodec(ReportSchemaV2, feedID, lggr)
+		require.NoError(t, err)
+		assert.IsType(t, &EVMReportCodecV2{}, c)
+	})
+
+	t.Run("NewReportCodec errors on unsupported versions", func(t *testing.T) {
+		_, err := NewReportCodec(0, feedID, lggr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported report schema version 0, supported: [1 2")
+		assert.False(t, IsSupported(0))
+	})
+
+	t.Run("Register adds versions once", func(t *testing.T) {
+		const version ReportSchemaVersion = 1000
+		schema := Schema{
+			NewReportCodec: func(feedID [32]byte, lggr logger.Logger) ReportCodec {
+				return NewEVMReportCodecV2(feedID, lggr)
+			},
+			ParseObservation: ParseObservationV2,
+		}
+		require.Error(t, Register(version, Schema{NewReportCodec: schema.NewReportCodec}))
+		require.NoError(t, Register(version, schema))
+		assert.True(t, IsSupported(version))
+		assert.Contains(t, SupportedVersions(), version)
+
+		err := Register(version, schema)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "report schema version 1000 is already registered")
+		require.Error(t, Register(0, schema))
+	})
+}
@@ -41,7 +41,7 @@ func getReportTypes() abi.Arguments {
 	})
 }
 
-var _ relaymercury.ReportCodec = &EVMReportCodec{}
+var _ ReportCodec = &EVMReportCodec{}
 
 type EVMReportCodec struct {
 	logger logger.Logger
@@ -123,3 +123,22 @@ func (r *EVMReportCodec) CurrentBlockNumFromReport(report ocrtypes.Report) (int6
 
 	return int64(blockNum), nil
 }
+
+func (r *EVMReportCodec) ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error) {
+	reportElems := map[string]interface{}{}
+	if err := ReportTypes.UnpackIntoMap(reportElems, report); err != nil {
+		return 0, errors.Errorf("error during unpack: %v", err)
+	}
+
+	timestampIface, ok := reportElems["observationsTimestamp"]
+	if !ok {
+		return 0, errors.Errorf("unpacked report has no 'observationsTimestamp' field")
+	}
+
+	timestamp, ok := timestampIface.(uint32)
+	if !ok {
+		return 0, errors.Errorf("cannot cast observationsTimestamp to uint32, type is %T", timestampIface)
+	}
+
+	return timestamp, nil
+}
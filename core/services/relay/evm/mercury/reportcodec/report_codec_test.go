@@ -134,6 +134,17 @@ func Test_ReportCodec_CurrentBlockNumFromReport(t *testing.T) {
 	})
 }
 
+func Test_ReportCodec_ObservationTimestampFromReport(t *testing.T) {
+	r := EVMReportCodec{}
+
+	ts, err := r.ObservationTimestampFromReport(buildSampleReport(42))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), ts)
+
+	_, err = r.ObservationTimestampFromReport([]byte("foo"))
+	require.Error(t, err)
+}
+
 func buildSampleReport(bn int64) []byte {
 	feedID := [32]byte{'f', 'o', 'o'}
 	timestamp := uint32(42)
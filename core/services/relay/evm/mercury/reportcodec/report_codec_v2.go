@@ -0,0 +1,131 @@
+package reportcodec
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ReportTypesV2 is the layout of the reports of ReportSchemaV2, which carry a
+// single value instead of a price with bid and ask. The value is as of
+// currentBlockNum, rather than valid over a range of blocks, so there is no
+// validFromBlockNum.
+var ReportTypesV2 = getReportTypesV2()
+
+func getReportTypesV2() abi.Arguments {
+	mustNewType := func(t string) abi.Type {
+		result, err := abi.NewType(t, "", []abi.ArgumentMarshaling{})
+		if err != nil {
+			panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+		}
+		return result
+	}
+	return abi.Arguments([]abi.Argument{
+		{Name: "feedId", Type: mustNewType("bytes32")},
+		{Name: "observationsTimestamp", Type: mustNewType("uint32")},
+		{Name: "value", Type: mustNewType("int192")},
+		{Name: "currentBlockNum", Type: mustNewType("uint64")},
+		{Name: "currentBlockHash", Type: mustNewType("bytes32")},
+		{Name: "currentBlockTimestamp", Type: mustNewType("uint64")},
+	})
+}
+
+var _ ReportCodec = &EVMReportCodecV2{}
+
+// EVMReportCodecV2 is the ReportCodec of ReportSchemaV2. The value is the
+// consensus benchmark price of the observations.
+type EVMReportCodecV2 struct {
+	logger logger.Logger
+	feedID [32]byte
+}
+
+func NewEVMReportCodecV2(feedID [32]byte, lggr logger.Logger) *EVMReportCodecV2 {
+	return &EVMReportCodecV2{lggr, feedID}
+}
+
+func (r *EVMReportCodecV2) BuildReport(paos []relaymercury.ParsedAttributedObservation, f int) (ocrtypes.Report, error) {
+	if len(paos) == 0 {
+		return nil, errors.Errorf("cannot build report from empty attributed observations")
+	}
+
+	// copy so we can safely sort in place
+	paos = append([]relaymercury.ParsedAttributedObservation{}, paos...)
+
+	timestamp := relaymercury.GetConsensusTimestamp(paos)
+	value := relaymercury.GetConsensusBenchmarkPrice(paos)
+
+	currentBlockHash, currentBlockNum, currentBlockTimestamp, err := relaymercury.GetConsensusCurrentBlock(paos, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetConsensusCurrentBlock failed")
+	}
+
+	if len(currentBlockHash) != 32 {
+		return nil, errors.Errorf("invalid length for currentBlockHash, expected: 32, got: %d", len(currentBlockHash))
+	}
+	currentBlockHashArray := [32]byte{}
+	copy(currentBlockHashArray[:], currentBlockHash)
+
+	reportBytes, err := ReportTypesV2.Pack(r.feedID, timestamp, value, uint64(currentBlockNum), currentBlockHashArray, currentBlockTimestamp)
+	return ocrtypes.Report(reportBytes), errors.Wrap(err, "failed to pack report blob")
+}
+
+// MaxReportLength is the length of the ABI encoding of ReportTypesV2, which
+// only has static types of one 32 byte word each.
+func (r *EVMReportCodecV2) MaxReportLength(n int) int {
+	return len(ReportTypesV2) * 32
+}
+
+func (r *EVMReportCodecV2) CurrentBlockNumFromReport(report ocrtypes.Report) (int64, error) {
+	if len(report) != r.MaxReportLength(0) {
+		return 0, errors.Errorf("invalid report length, expected: %d, got: %d", r.MaxReportLength(0), len(report))
+	}
+	reportElems := map[string]interface{}{}
+	if err := ReportTypesV2.UnpackIntoMap(reportElems, report); err != nil {
+		return 0, errors.Errorf("error during unpack: %v", err)
+	}
+
+	blockNumIface, ok := reportElems["currentBlockNum"]
+	if !ok {
+		return 0, errors.Errorf("unpacked report has no 'currentBlockNum' field")
+	}
+
+	blockNum, ok := blockNumIface.(uint64)
+	if !ok {
+		return 0, errors.Errorf("cannot cast blockNum to int64, type is %T", blockNumIface)
+	}
+
+	if blockNum > math.MaxInt64 {
+		return 0, errors.Errorf("blockNum overflows max int64, got: %d", blockNum)
+	}
+
+	return int64(blockNum), nil
+}
+
+func (r *EVMReportCodecV2) ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error) {
+	if len(report) != r.MaxReportLength(0) {
+		return 0, errors.Errorf("invalid report length, expected: %d, got: %d", r.MaxReportLength(0), len(report))
+	}
+	reportElems := map[string]interface{}{}
+	if err := ReportTypesV2.UnpackIntoMap(reportElems, report); err != nil {
+		return 0, errors.Errorf("error during unpack: %v", err)
+	}
+
+	timestampIface, ok := reportElems["observationsTimestamp"]
+	if !ok {
+		return 0, errors.Errorf("unpacked report has no 'observationsTimestamp' field")
+	}
+
+	timestamp, ok := timestampIface.(uint32)
+	if !ok {
+		return 0, errors.Errorf("cannot cast observationsTimestamp to uint32, type is %T", timestampIface)
+	}
+
+	return timestamp, nil
+}
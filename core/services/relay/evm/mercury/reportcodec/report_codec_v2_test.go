@@ -0,0 +1,98 @@
+package reportcodec
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	relaymercury "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func Test_ReportCodecV2_BuildReport(t *testing.T) {
+	feedID := [32]byte{'f', 'o', 'o'}
+	r := NewEVMReportCodecV2(feedID, nil)
+
+	f := 1
+
+	t.Run("BuildReport errors if observations are empty", func(t *testing.T) {
+		_, err := r.BuildReport([]relaymercury.ParsedAttributedObservation{}, f)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot build report from empty attributed observation")
+	})
+
+	t.Run("BuildReport constructs a report from observations", func(t *testing.T) {
+		hash := hexutil.MustDecode("0x552c2cea3ab43bae137d89ee6142a01db3ae2b5678bc3c9bd5f509f537bea57b")
+
+		var paos []relaymercury.ParsedAttributedObservation
+		for i := int64(0); i < 4; i++ {
+			paos = append(paos, relaymercury.ParsedAttributedObservation{
+				Timestamp:             uint32(42 + 100*i),
+				BenchmarkPrice:        big.NewInt(43 + 100*i),
+				Bid:                   big.NewInt(43 + 100*i),
+				Ask:                   big.NewInt(43 + 100*i),
+				CurrentBlockNum:       48,
+				CurrentBlockHash:      hash,
+				CurrentBlockTimestamp: uint64(123),
+				ValidFromBlockNum:     46,
+				Observer:              commontypes.OracleID(i),
+			})
+		}
+		report, err := r.BuildReport(paos, f)
+		require.NoError(t, err)
+
+		reportElems := make(map[string]interface{})
+		require.NoError(t, ReportTypesV2.UnpackIntoMap(reportElems, report))
+
+		assert.Equal(t, feedID, reportElems["feedId"].([32]byte))
+		assert.Equal(t, 242, int(reportElems["observationsTimestamp"].(uint32)))
+		assert.Equal(t, int64(243), reportElems["value"].(*big.Int).Int64())
+		assert.Equal(t, uint64(48), reportElems["currentBlockNum"].(uint64))
+		assert.Equal(t, common.BytesToHash(hash), common.Hash(reportElems["currentBlockHash"].([32]byte)))
+		assert.Equal(t, uint64(123), reportElems["currentBlockTimestamp"].(uint64))
+		assert.NotContains(t, reportElems, "bid")
+		assert.NotContains(t, reportElems, "validFromBlockNum")
+		assert.Equal(t, 6*32, len(report))
+		assert.Equal(t, len(report), r.MaxReportLength(4))
+
+		bn, err := r.CurrentBlockNumFromReport(report)
+		require.NoError(t, err)
+		assert.Equal(t, int64(48), bn)
+
+		ts, err := r.ObservationTimestampFromReport(report)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(242), ts)
+	})
+}
+
+func Test_ReportCodecV2_CurrentBlockNumFromReport(t *testing.T) {
+	r := EVMReportCodecV2{}
+
+	report, err := ReportTypesV2.Pack([32]byte{'f', 'o', 'o'}, uint32(42), big.NewInt(242), uint64(1<<63), utils.NewHash(), uint64(123))
+	require.NoError(t, err)
+
+	_, err = r.CurrentBlockNumFromReport(report)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blockNum overflows max int64, got: 9223372036854775808")
+
+	// A V1 report is not a V2 one.
+	_, err = r.CurrentBlockNumFromReport(buildSampleReport(42))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid report length, expected: 192, got: 288")
+}
+
+func Test_ReportCodecV2_ObservationTimestampFromReport(t *testing.T) {
+	r := EVMReportCodecV2{}
+
+	// A V1 report is not a V2 one.
+	_, err := r.ObservationTimestampFromReport(buildSampleReport(42))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid report length, expected: 192, got: 288")
+}
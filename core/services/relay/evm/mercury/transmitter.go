@@ -20,18 +20,30 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
-const MaxTransmitQueueSize = 10_000
+const (
+	MaxTransmitQueueSize = 10_000
+	// MaxTransmissionAge is the age of the observations of a report after
+	// which its pending transmissions are dropped
+	MaxTransmissionAge = 24 * time.Hour
+)
 
 type Transmitter interface {
 	relaymercury.Transmitter
 	services.ServiceCtx
 }
 
+// ReportCodec decodes the reports to transmit. It is implemented by the
+// ReportCodec of the feed's report schema, see reportcodec.ReportCodec.
+type ReportCodec interface {
+	ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error)
+}
+
 type ConfigTracker interface {
 	LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest ocrtypes.ConfigDigest, err error)
 }
@@ -41,17 +53,33 @@ var _ Transmitter = &mercuryTransmitter{}
 type mercuryTransmitter struct {
 	utils.StartStopOnce
 	lggr       logger.Logger
-	rpcClient  wsrpc.Client
 	cfgTracker ConfigTracker
 
+	// servers are the mercury servers every report is transmitted to, by URL
+	servers map[string]*server
+	policy  mercuryconfig.ServerPolicy
+
 	feedID      [32]byte
+	feedIDHex   string
 	fromAccount string
+	codec       ReportCodec
 
 	stopCh utils.StopChan
-	queue  *TransmitQueue
 	wg     sync.WaitGroup
 }
 
+// server is a mercury server with its own queue of pending transmissions and
+// retry loop, so that a slow or unavailable server does not delay the others.
+type server struct {
+	lggr      logger.Logger
+	url       string
+	feedIDHex string
+
+	c  wsrpc.Client
+	pm *PersistenceManager
+	q  *TransmitQueue
+}
+
 var PayloadTypes = getPayloadTypes()
 
 func getPayloadTypes() abi.Arguments {
@@ -71,37 +99,71 @@ func getPayloadTypes() abi.Arguments {
 	})
 }
 
-func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey, feedID [32]byte) *mercuryTransmitter {
+// NewTransmitter returns a transmitter for the reports of the job jobID to
+// each of clients, by server URL. Pending transmissions are persisted with
+// orm, and dropped once the observations of their report, decoded with codec,
+// are older than MaxTransmissionAge. The latest reports of the servers are
+// combined according to policy.
+func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, clients map[string]wsrpc.Client, policy mercuryconfig.ServerPolicy, fromAccount ed25519.PublicKey, jobID int32, feedID [32]byte, codec ReportCodec, orm ORM) *mercuryTransmitter {
+	feedIDHex := fmt.Sprintf("0x%x", feedID[:])
+	lggr = lggr.Named("MercuryTransmitter").With("feedID", feedIDHex)
+	servers := make(map[string]*server, len(clients))
+	for serverURL, client := range clients {
+		sLggr := lggr.Named(serverURL).With("serverURL", serverURL)
+		persistenceManager := NewPersistenceManager(sLggr, orm, jobID, serverURL, MaxTransmitQueueSize, MaxTransmissionAge)
+		servers[serverURL] = &server{
+			lggr:      sLggr,
+			url:       serverURL,
+			feedIDHex: feedIDHex,
+			c:         client,
+			pm:        persistenceManager,
+			q:         NewTransmitQueue(sLggr, serverURL, feedIDHex, MaxTransmitQueueSize, persistenceManager),
+		}
+	}
 	return &mercuryTransmitter{
-		utils.StartStopOnce{},
-		lggr.Named("MercuryTransmitter"),
-		rpcClient,
-		cfgTracker,
-		feedID,
-		fmt.Sprintf("%x", fromAccount),
-		make(chan (struct{})),
-		NewTransmitQueue(lggr, MaxTransmitQueueSize),
-		sync.WaitGroup{},
+		lggr:        lggr,
+		cfgTracker:  cfgTracker,
+		servers:     servers,
+		policy:      policy,
+		feedID:      feedID,
+		feedIDHex:   feedIDHex,
+		fromAccount: fmt.Sprintf("%x", fromAccount),
+		codec:       codec,
+		stopCh:      make(chan (struct{})),
 	}
 }
 
 func (mt *mercuryTransmitter) Start(ctx context.Context) (err error) {
 	return mt.StartOnce("MercuryTransmitter", func() error {
-		if err := mt.rpcClient.Start(ctx); err != nil {
-			return err
+		var started []*server
+		for _, s := range mt.servers {
+			if err := s.start(ctx); err != nil {
+				for _, s := range started {
+					err = errors.Join(err, s.close())
+				}
+				return err
+			}
+			started = append(started, s)
+		}
+		for _, s := range mt.servers {
+			mt.wg.Add(1)
+			go s.runloop(&mt.wg, mt.stopCh)
 		}
-		mt.wg.Add(1)
-		go mt.runloop()
 		return nil
 	})
 }
 
 func (mt *mercuryTransmitter) Close() error {
-	return mt.StopOnce("MercuryTransmitter", func() error {
-		mt.queue.Close()
+	return mt.StopOnce("MercuryTransmitter", func() (merr error) {
+		for _, s := range mt.servers {
+			s.q.Close()
+		}
 		close(mt.stopCh)
 		mt.wg.Wait()
-		return mt.rpcClient.Close()
+		for _, s := range mt.servers {
+			merr = errors.Join(merr, s.close())
+		}
+		return merr
 	})
 }
 func (mt *mercuryTransmitter) Ready() error { return mt.StartStopOnce.Ready() }
@@ -109,13 +171,38 @@ func (mt *mercuryTransmitter) Name() string { return mt.lggr.Name() }
 
 func (mt *mercuryTransmitter) HealthReport() map[string]error {
 	report := map[string]error{mt.Name(): mt.StartStopOnce.Healthy()}
-	maps.Copy(report, mt.rpcClient.HealthReport())
-	maps.Copy(report, mt.queue.HealthReport())
+	for _, s := range mt.servers {
+		maps.Copy(report, s.c.HealthReport())
+		maps.Copy(report, s.q.HealthReport())
+	}
 	return report
 }
 
-func (mt *mercuryTransmitter) runloop() {
-	defer mt.wg.Done()
+func (s *server) start(ctx context.Context) error {
+	if err := s.c.Start(ctx); err != nil {
+		return err
+	}
+	if err := s.pm.Start(ctx); err != nil {
+		return errors.Join(err, s.c.Close())
+	}
+	transmissions, err := s.pm.Load(ctx)
+	if err != nil {
+		// Transmitting new reports matters more than the pending ones.
+		s.lggr.Errorw("Failed to load pending transmissions", "err", err)
+	} else if len(transmissions) > 0 {
+		s.lggr.Infow("Loaded pending transmissions", "count", len(transmissions))
+		s.q.Init(transmissions)
+	}
+	return nil
+}
+
+// close stops the server, which must have been started.
+func (s *server) close() error {
+	return errors.Join(s.pm.Close(), s.c.Close())
+}
+
+func (s *server) runloop(wg *sync.WaitGroup, stopCh utils.StopChan) {
+	defer wg.Done()
 	// Exponential backoff with very short retry interval (since latency is a priority)
 	// 5ms, 10ms, 20ms, 40ms etc
 	b := backoff.Backoff{
@@ -124,23 +211,30 @@ func (mt *mercuryTransmitter) runloop() {
 		Factor: 2,
 		Jitter: true,
 	}
-	ctx, cancel := mt.stopCh.Ctx(context.Background())
+	ctx, cancel := stopCh.Ctx(context.Background())
 	defer cancel()
 	for {
-		t := mt.queue.BlockingPop()
+		t := s.q.BlockingPop()
 		if t == nil {
 			// queue was closed
 			return
 		}
-		res, err := mt.rpcClient.Transmit(ctx, t.Req)
+		if s.pm.Expired(t) {
+			s.lggr.Warnw("Dropping expired transmission", "reportCtx", t.ReportCtx, "createdAt", t.CreatedAt)
+			incTransmitQueueDropMetric(s.url, s.feedIDHex, dropReasonExpired)
+			s.pm.AsyncDelete(t.Req)
+			continue
+		}
+		res, err := s.c.Transmit(ctx, t.Req)
 		if ctx.Err() != nil {
 			// context only canceled on transmitter close so we can exit
 			// the runloop here
 			return
 		} else if err != nil {
-			mt.lggr.Errorw("Transmit report failed", "req", t.Req, "error", err, "reportCtx", t.ReportCtx)
-			if ok := mt.queue.Push(t.Req, t.ReportCtx); !ok {
-				mt.lggr.Error("Failed to push report to transmit queue; queue is closed")
+			s.lggr.Errorw("Transmit report failed", "req", t.Req, "error", err, "reportCtx", t.ReportCtx)
+			incTransmitServerErrorMetric(s.url, s.feedIDHex)
+			if ok := s.q.push(t); !ok {
+				s.lggr.Error("Failed to push report to transmit queue; queue is closed")
 				return
 			}
 			// Wait a backoff duration before pulling the latest back off
@@ -148,26 +242,35 @@ func (mt *mercuryTransmitter) runloop() {
 			select {
 			case <-time.After(b.Duration()):
 				continue
-			case <-mt.stopCh:
+			case <-stopCh:
 				return
 			}
 		}
 
 		b.Reset()
+		s.pm.AsyncDelete(t.Req)
 		if res.Error == "" {
-			mt.lggr.Debugw("Transmit report success", "req", t.Req, "response", res, "reportCtx", t.ReportCtx)
+			s.lggr.Debugw("Transmit report success", "req", t.Req, "response", res, "reportCtx", t.ReportCtx)
+			observeTransmitDeliveryLatencyMetric(s.url, s.feedIDHex, time.Since(t.CreatedAt).Seconds())
 		} else {
+			incTransmitQueueDropMetric(s.url, s.feedIDHex, dropReasonRejected)
 			// We don't need to retry here because the mercury server
 			// has confirmed it received the report. We only need to retry
 			// on networking/unknown errors
 			err := errors.New(res.Error)
-			mt.lggr.Errorw("Transmit report failed; mercury server returned error", "req", t.Req, "response", res, "reportCtx", t.ReportCtx, "err", err)
+			s.lggr.Errorw("Transmit report failed; mercury server returned error", "req", t.Req, "response", res, "reportCtx", t.ReportCtx, "err", err)
 		}
 	}
 }
 
 // Transmit sends the report to the on-chain smart contract's Transmit method.
 func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	ts, err := mt.codec.ObservationTimestampFromReport(report)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to decode observations timestamp from report")
+	}
+	observationsTimestamp := time.Unix(int64(ts), 0)
+
 	var rs [][32]byte
 	var ss [][32]byte
 	var vs [32]byte
@@ -193,8 +296,17 @@ func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.R
 
 	mt.lggr.Debugw("Transmit enqueue", "req", req, "report", report, "reportCtx", reportCtx, "signatures", signatures)
 
-	if ok := mt.queue.Push(req, reportCtx); !ok {
-		return errors.New("transmit queue is closed")
+	createdAt := time.Now()
+	for _, s := range mt.servers {
+		// Every queue needs its own transmission, as it keeps its heap index.
+		t := &Transmission{Req: req, ReportCtx: reportCtx, ObservationsTimestamp: observationsTimestamp, CreatedAt: createdAt, index: -1}
+		if err := s.pm.Insert(ctx, t); err != nil {
+			// Still transmit it, it will only be lost on restart.
+			s.lggr.Errorw("Failed to persist transmission", "err", err, "reportCtx", reportCtx)
+		}
+		if ok := s.q.push(t); !ok {
+			return pkgerrors.Errorf("transmit queue of mercury server %s is closed", s.url)
+		}
 	}
 	return nil
 }
@@ -204,51 +316,75 @@ func (mt *mercuryTransmitter) FromAccount() ocrtypes.Account {
 	return ocrtypes.Account(mt.fromAccount)
 }
 
-// LatestConfigDigestAndEpoch retrieves the latest config digest and epoch from the OCR2 contract.
+type configDigestAndEpoch struct {
+	cd    ocrtypes.ConfigDigest
+	epoch uint32
+}
+
+// LatestConfigDigestAndEpoch retrieves the latest config digest and epoch from the mercury servers, according to the
+// server policy.
 func (mt *mercuryTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (cd ocrtypes.ConfigDigest, epoch uint32, err error) {
 	mt.lggr.Debug("LatestConfigDigestAndEpoch")
+	res, err := queryServers(ctx, mt.servers, mt.policy, mt.latestConfigDigestAndEpoch)
+	if err != nil {
+		return cd, epoch, err
+	}
+	mt.lggr.Debugw("LatestConfigDigestAndEpoch success", "cd", res.cd, "epoch", res.epoch)
+	return res.cd, res.epoch, nil
+}
+
+func (mt *mercuryTransmitter) latestConfigDigestAndEpoch(ctx context.Context, s *server) (res configDigestAndEpoch, err error) {
 	req := &pb.LatestReportRequest{
 		FeedId: mt.feedID[:],
 	}
-	resp, err := mt.rpcClient.LatestReport(ctx, req)
+	resp, err := s.c.LatestReport(ctx, req)
 	if err != nil {
-		mt.lggr.Errorw("LatestConfigDigestAndEpoch failed", "err", err)
-		return cd, epoch, pkgerrors.Wrap(err, "LatestConfigDigestAndEpoch failed to fetch LatestReport")
+		s.lggr.Errorw("LatestConfigDigestAndEpoch failed", "err", err)
+		return res, pkgerrors.Wrap(err, "LatestConfigDigestAndEpoch failed to fetch LatestReport")
 	}
 	if resp == nil {
-		return cd, epoch, errors.New("LatestConfigDigestAndEpoch expected LatestReport to return non-nil response")
+		return res, errors.New("LatestConfigDigestAndEpoch expected LatestReport to return non-nil response")
 	}
 	if resp.Error != "" {
 		err = errors.New(resp.Error)
-		mt.lggr.Errorw("LatestConfigDigestAndEpoch failed; mercury server returned error", "err", err)
-		return cd, epoch, err
+		s.lggr.Errorw("LatestConfigDigestAndEpoch failed; mercury server returned error", "err", err)
+		return res, err
 	}
 	if resp.Report == nil {
-		_, cd, err = mt.cfgTracker.LatestConfigDetails(ctx)
-		mt.lggr.Info("LatestConfigDigestAndEpoch returned empty LatestReport, this is a brand new feed")
-		return cd, epoch, pkgerrors.Wrap(err, "fallback to LatestConfigDetails on empty LatestReport failed")
+		_, res.cd, err = mt.cfgTracker.LatestConfigDetails(ctx)
+		s.lggr.Info("LatestConfigDigestAndEpoch returned empty LatestReport, this is a brand new feed")
+		return res, pkgerrors.Wrap(err, "fallback to LatestConfigDetails on empty LatestReport failed")
 	}
-	cd, err = ocrtypes.BytesToConfigDigest(resp.Report.ConfigDigest)
+	res.cd, err = ocrtypes.BytesToConfigDigest(resp.Report.ConfigDigest)
 	if err != nil {
-		return cd, epoch, pkgerrors.Wrapf(err, "LatestConfigDigestAndEpoch failed; response contained invalid config digest, got: 0x%x", resp.Report.ConfigDigest)
+		return res, pkgerrors.Wrapf(err, "LatestConfigDigestAndEpoch failed; response contained invalid config digest, got: 0x%x", resp.Report.ConfigDigest)
 	}
 	if !bytes.Equal(resp.Report.FeedId, mt.feedID[:]) {
-		return cd, epoch, fmt.Errorf("LatestConfigDigestAndEpoch failed; mismatched feed IDs, expected: 0x%x, got: 0x%x", mt.feedID, resp.Report.FeedId)
+		return res, fmt.Errorf("LatestConfigDigestAndEpoch failed; mismatched feed IDs, expected: 0x%x, got: 0x%x", mt.feedID, resp.Report.FeedId)
 	}
-
-	mt.lggr.Debugw("LatestConfigDigestAndEpoch success", "cd", cd, "epoch", epoch)
-
-	return cd, resp.Report.Epoch, nil
+	res.epoch = resp.Report.Epoch
+	return res, nil
 }
 
+// FetchInitialMaxFinalizedBlockNumber retrieves the block number of the latest report from the mercury servers,
+// according to the server policy.
 func (mt *mercuryTransmitter) FetchInitialMaxFinalizedBlockNumber(ctx context.Context) (int64, error) {
 	mt.lggr.Debug("FetchInitialMaxFinalizedBlockNumber")
+	blockNum, err := queryServers(ctx, mt.servers, mt.policy, mt.fetchInitialMaxFinalizedBlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	mt.lggr.Debugw("FetchInitialMaxFinalizedBlockNumber success", "currentBlockNum", blockNum)
+	return blockNum, nil
+}
+
+func (mt *mercuryTransmitter) fetchInitialMaxFinalizedBlockNumber(ctx context.Context, s *server) (int64, error) {
 	req := &pb.LatestReportRequest{
 		FeedId: mt.feedID[:],
 	}
-	resp, err := mt.rpcClient.LatestReport(ctx, req)
+	resp, err := s.c.LatestReport(ctx, req)
 	if err != nil {
-		mt.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed", "err", err)
+		s.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed", "err", err)
 		return 0, pkgerrors.Wrap(err, "FetchInitialMaxFinalizedBlockNumber failed to fetch LatestReport")
 	}
 	if resp == nil {
@@ -256,17 +392,61 @@ func (mt *mercuryTransmitter) FetchInitialMaxFinalizedBlockNumber(ctx context.Co
 	}
 	if resp.Error != "" {
 		err = errors.New(resp.Error)
-		mt.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed; mercury server returned error", "err", err)
+		s.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed; mercury server returned error", "err", err)
 		return 0, err
 	}
 	if resp.Report == nil {
-		mt.lggr.Infow("FetchInitialMaxFinalizedBlockNumber returned empty LatestReport; this is a new feed so initial block number is 0", "currentBlockNum", 0)
+		s.lggr.Infow("FetchInitialMaxFinalizedBlockNumber returned empty LatestReport; this is a new feed so initial block number is 0", "currentBlockNum", 0)
 		return 0, nil
 	} else if !bytes.Equal(resp.Report.FeedId, mt.feedID[:]) {
 		return 0, fmt.Errorf("FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x%x, got: 0x%x", mt.feedID, resp.Report.FeedId)
 	}
+	return resp.Report.CurrentBlockNumber, nil
+}
 
-	mt.lggr.Debugw("FetchInitialMaxFinalizedBlockNumber success", "currentBlockNum", resp.Report.CurrentBlockNumber)
+// queryServers runs query against every server concurrently. With
+// ServerPolicyConsensus, it returns the result of a strict majority of the
+// servers, otherwise the first successful one.
+func queryServers[T comparable](ctx context.Context, servers map[string]*server, policy mercuryconfig.ServerPolicy, query func(context.Context, *server) (T, error)) (res T, merr error) {
+	if len(servers) == 0 {
+		return res, errors.New("no mercury servers")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	return resp.Report.CurrentBlockNumber, nil
+	type answer struct {
+		url string
+		res T
+		err error
+	}
+	answers := make(chan answer, len(servers))
+	for _, s := range servers {
+		go func(s *server) {
+			res, err := query(ctx, s)
+			answers <- answer{url: s.url, res: res, err: err}
+		}(s)
+	}
+
+	counts := make(map[T]int)
+	for range servers {
+		a := <-answers
+		if a.err != nil {
+			if len(servers) > 1 {
+				a.err = pkgerrors.Wrapf(a.err, "mercury server %s", a.url)
+			}
+			merr = errors.Join(merr, a.err)
+			continue
+		}
+		if policy != mercuryconfig.ServerPolicyConsensus {
+			return a.res, nil
+		}
+		counts[a.res]++
+		if counts[a.res] > len(servers)/2 {
+			return a.res, nil
+		}
+	}
+	if len(counts) > 0 {
+		merr = errors.Join(pkgerrors.Errorf("no consensus among %d mercury servers: %d distinct results", len(servers), len(counts)), merr)
+	}
+	return res, merr
 }
@@ -3,6 +3,7 @@ package mercury
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -37,6 +39,27 @@ func (m MockWSRPCClient) LatestReport(ctx context.Context, in *pb.LatestReportRe
 
 var _ wsrpc.Client = &MockWSRPCClient{}
 
+// startStopClient is a MockWSRPCClient which fails to start with startErr,
+// and records whether it is started.
+type startStopClient struct {
+	MockWSRPCClient
+	startErr error
+	started  bool
+}
+
+func (c *startStopClient) Start(context.Context) error {
+	if c.startErr != nil {
+		return c.startErr
+	}
+	c.started = true
+	return nil
+}
+
+func (c *startStopClient) Close() error {
+	c.started = false
+	return nil
+}
+
 type MockTracker struct {
 	latestConfigDetails func(ctx context.Context) (changedInBlock uint64, configDigest ocrtypes.ConfigDigest, err error)
 }
@@ -47,6 +70,8 @@ func (m MockTracker) LatestConfigDetails(ctx context.Context) (changedInBlock ui
 
 var _ ConfigTracker = &MockTracker{}
 
+const sURL = "example.com:80"
+
 func Test_MercuryTransmitter_Transmit(t *testing.T) {
 	t.Parallel()
 
@@ -63,11 +88,195 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
 
 		require.NoError(t, err)
 	})
+
+	t.Run("pending transmissions are loaded on start and deleted once delivered", func(t *testing.T) {
+		orm := NewMockORM()
+		pending := &Transmission{Req: &pb.TransmitRequest{Payload: []byte("pending")}, ReportCtx: sampleReportContext, ObservationsTimestamp: time.Now(), CreatedAt: time.Now()}
+		require.NoError(t, orm.InsertTransmitRequest(testutils.Context(t), 1, sURL, pending))
+
+		transmitted := make(chan []byte, 2)
+		c := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				transmitted <- in.Payload
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, orm)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		assert.Equal(t, []byte("pending"), <-transmitted)
+
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+		assert.Equal(t, samplePayloadHex, hexutil.Encode(<-transmitted))
+
+		require.NoError(t, mt.Close())
+		assert.Equal(t, 0, orm.len())
+	})
+
+	t.Run("expired transmissions are dropped", func(t *testing.T) {
+		orm := NewMockORM()
+		// Expired by the age of its report, though it was only just enqueued.
+		expired := &Transmission{Req: &pb.TransmitRequest{Payload: []byte("expired")}, ReportCtx: sampleReportContext, ObservationsTimestamp: time.Now().Add(-MaxTransmissionAge), CreatedAt: time.Now()}
+		c := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				t.Errorf("unexpected transmission of %s", in.Payload)
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, orm)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		require.NoError(t, orm.InsertTransmitRequest(testutils.Context(t), 1, sURL, expired))
+		require.True(t, mt.servers[sURL].q.push(expired))
+		require.Eventually(t, mt.servers[sURL].q.IsEmpty, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		require.NoError(t, mt.Close())
+		assert.Equal(t, 0, orm.len())
+	})
+
+	t.Run("reports with old observations are dropped", func(t *testing.T) {
+		orm := NewMockORM()
+		c := MockWSRPCClient{
+			transmit: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				t.Errorf("unexpected transmission of %x", in.Payload)
+				return &pb.TransmitResponse{}, nil
+			},
+		}
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, orm)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		oldReport := buildSampleReport(uint32(time.Now().Add(-MaxTransmissionAge - time.Minute).Unix()))
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, oldReport, sampleSigs))
+		require.Eventually(t, func() bool { return orm.len() == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		require.NoError(t, mt.Close())
+	})
+
+	t.Run("undecodable reports are not enqueued", func(t *testing.T) {
+		orm := NewMockORM()
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: MockWSRPCClient{}}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, orm)
+		err := mt.Transmit(testutils.Context(t), sampleReportContext, []byte("foo"), sampleSigs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode observations timestamp from report")
+		assert.Equal(t, 0, orm.len())
+	})
+}
+
+func Test_MercuryTransmitter_MultipleServers(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	const sURL2, sURL3 = "example.org:80", "example.net:80"
+
+	latestReport := func(blockNum int64, err error) func(ctx context.Context, in *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+		return func(ctx context.Context, in *pb.LatestReportRequest) (*pb.LatestReportResponse, error) {
+			if err != nil {
+				return nil, err
+			}
+			return &pb.LatestReportResponse{Report: &pb.Report{FeedId: sampleFeedID[:], CurrentBlockNumber: blockNum}}, nil
+		}
+	}
+
+	t.Run("transmits every report to each server", func(t *testing.T) {
+		orm := NewMockORM()
+		transmitted := make(chan string, 2)
+		transmit := func(url string, err error) func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+			var failed bool
+			return func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				if err != nil && !failed {
+					// The other server is not delayed by the retries.
+					failed = true
+					return nil, err
+				}
+				transmitted <- url
+				return &pb.TransmitResponse{}, nil
+			}
+		}
+		clients := map[string]wsrpc.Client{
+			sURL:  MockWSRPCClient{transmit: transmit(sURL, nil)},
+			sURL2: MockWSRPCClient{transmit: transmit(sURL2, errors.New("something exploded"))},
+		}
+		mt := NewTransmitter(lggr, nil, clients, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, orm)
+		require.NoError(t, mt.Start(testutils.Context(t)))
+		require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+		assert.ElementsMatch(t, []string{sURL, sURL2}, []string{<-transmitted, <-transmitted})
+
+		report := mt.HealthReport()
+		assert.Contains(t, report, mt.servers[sURL].q.Name())
+		assert.Contains(t, report, mt.servers[sURL2].q.Name())
+		assert.NotEqual(t, mt.servers[sURL].q.Name(), mt.servers[sURL2].q.Name())
+
+		require.NoError(t, mt.Close())
+		assert.Equal(t, 0, orm.len())
+	})
+
+	t.Run("first success", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{
+			sURL:  MockWSRPCClient{latestReport: latestReport(0, errors.New("something exploded"))},
+			sURL2: MockWSRPCClient{latestReport: latestReport(42, nil)},
+		}
+		mt := NewTransmitter(lggr, nil, clients, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
+		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, 42, int(bn))
+	})
+
+	t.Run("first success fails if every server fails", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{
+			sURL:  MockWSRPCClient{latestReport: latestReport(0, errors.New("something exploded"))},
+			sURL2: MockWSRPCClient{latestReport: latestReport(0, errors.New("something else exploded"))},
+		}
+		mt := NewTransmitter(lggr, nil, clients, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
+		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mercury server example.com:80: FetchInitialMaxFinalizedBlockNumber failed to fetch LatestReport: something exploded")
+		assert.Contains(t, err.Error(), "mercury server example.org:80: FetchInitialMaxFinalizedBlockNumber failed to fetch LatestReport: something else exploded")
+	})
+
+	t.Run("consensus", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{
+			sURL:  MockWSRPCClient{latestReport: latestReport(42, nil)},
+			sURL2: MockWSRPCClient{latestReport: latestReport(41, nil)},
+			sURL3: MockWSRPCClient{latestReport: latestReport(42, nil)},
+		}
+		mt := NewTransmitter(lggr, nil, clients, mercuryconfig.ServerPolicyConsensus, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
+		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, 42, int(bn))
+	})
+
+	t.Run("no consensus", func(t *testing.T) {
+		clients := map[string]wsrpc.Client{
+			sURL:  MockWSRPCClient{latestReport: latestReport(42, nil)},
+			sURL2: MockWSRPCClient{latestReport: latestReport(41, nil)},
+			sURL3: MockWSRPCClient{latestReport: latestReport(0, errors.New("something exploded"))},
+		}
+		mt := NewTransmitter(lggr, nil, clients, mercuryconfig.ServerPolicyConsensus, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
+		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no consensus among 3 mercury servers: 2 distinct results")
+		assert.Contains(t, err.Error(), "something exploded")
+	})
+}
+
+func Test_MercuryTransmitter_StartFailure(t *testing.T) {
+	t.Parallel()
+
+	const sURL2 = "example.org:80"
+	healthy := &startStopClient{}
+	clients := map[string]wsrpc.Client{
+		sURL:  healthy,
+		sURL2: &startStopClient{startErr: errors.New("something exploded")},
+	}
+	mt := NewTransmitter(logger.TestLogger(t), nil, clients, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 1, sampleFeedID, sampleCodec, NewMockORM())
+
+	err := mt.Start(testutils.Context(t))
+	require.ErrorContains(t, err, "something exploded")
+
+	// The server started before the failing one, if any, is stopped.
+	assert.False(t, healthy.started)
+	assert.Error(t, mt.servers[sURL].pm.Ready())
 }
 
 func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
@@ -91,7 +300,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		cd, epoch, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -104,7 +313,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return nil, errors.New("something exploded")
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "something exploded")
@@ -122,7 +331,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "LatestConfigDigestAndEpoch failed; mismatched feed IDs, expected: 0x1c916b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472, got: 0x01020304")
@@ -134,7 +343,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 			},
 		}
 		tracker := &MockTracker{}
-		mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "LatestConfigDigestAndEpoch expected LatestReport to return non-nil response")
@@ -153,7 +362,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 					return 123, ocrtypes.ConfigDigest(sampleConfigDigest), nil
 				},
 			}
-			mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+			mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 			cd, epoch, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 			require.NoError(t, err)
 
@@ -166,7 +375,7 @@ func Test_MercuryTransmitter_LatestConfigDigestAndEpoch(t *testing.T) {
 					return changedInBlock, configDigest, errors.New("something exploded")
 				},
 			}
-			mt := NewTransmitter(lggr, tracker, c, sampleClientPubKey, sampleFeedID)
+			mt := NewTransmitter(lggr, tracker, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 			_, _, err := mt.LatestConfigDigestAndEpoch(testutils.Context(t))
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), "something exploded")
@@ -191,7 +400,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -203,7 +412,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return nil, errors.New("something exploded")
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "something exploded")
@@ -220,7 +429,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, mercuryconfig.ServerPolicyFirstSuccess, sampleClientPubKey, 0, sampleFeedID, sampleCodec, NewMockORM())
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x1c916b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472, got: 0x")
@@ -43,7 +43,7 @@ func newClient(lggr logger.Logger, clientPrivKey csakey.KeyV2, serverPubKey []by
 		csaKey:       clientPrivKey,
 		serverPubKey: serverPubKey,
 		serverURL:    serverURL,
-		logger:       lggr.Named("WSRPC"),
+		logger:       lggr.Named("WSRPC").Named(serverURL),
 	}
 }
 
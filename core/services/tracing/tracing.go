@@ -0,0 +1,67 @@
+// Package tracing exports OpenTelemetry traces of the node's work, such as job
+// pipeline runs, to a collector.
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+)
+
+// Config is the configuration of trace exporting.
+type Config interface {
+	TracingEnabled() bool
+	TracingCollectorTarget() string
+	TracingSamplingRatio() float64
+	TracingTLSCertPath() string
+}
+
+// Start sets up the global tracer provider to export spans over OTLP/gRPC to
+// the configured collector, and to propagate trace context in W3C Trace Context
+// headers. Traces are sampled by ratio unless a remote parent was sampled.
+//
+// The returned provider must be shut down to flush any spans not yet exported.
+func Start(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.TracingCollectorTarget())}
+	if certPath := cfg.TracingTLSCertPath(); certPath != "" {
+		creds, err := credentials.NewClientTLSFromFile(certPath, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load collector TLS certificate")
+		}
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(creds))
+	} else {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create OTLP trace exporter")
+	}
+
+	_, ver := static.Short()
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("chainlink-node"),
+		semconv.ServiceVersion(ver),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create trace resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSamplingRatio()))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
@@ -47,6 +48,7 @@ type Config interface {
 	EvmFinalityDepth() uint32
 	EvmGasLimitDefault() uint32
 	EvmGasLimitVRFJobType() *uint32
+	EvmTxPriority(jobType string) txmgrtypes.TxPriority
 	KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
 	MinIncomingConfirmations() uint32
 }
@@ -829,7 +829,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 						SubID:         &p.req.req.SubId,
 						RequestTxHash: &p.req.req.Raw.TxHash,
 					},
-					Strategy: txmgr.NewSendEveryStrategy(),
+					Strategy: txmgr.NewPriorityTxStrategy(txmgr.NewSendEveryStrategy(), lsn.cfg.EvmTxPriority(string(job.VRF))),
 					Checker: txmgr.EvmTransmitCheckerSpec{
 						CheckerType:           txmgr.TransmitCheckerTypeVRFV2,
 						VRFCoordinatorAddress: &coordinatorAddress,
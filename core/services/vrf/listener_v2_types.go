@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	bigmath "github.com/smartcontractkit/chainlink/v2/core/utils/big_math"
@@ -163,7 +164,7 @@ func (lsn *listenerV2) processBatch(
 			ToAddress:      lsn.batchCoordinator.Address(),
 			EncodedPayload: payload,
 			FeeLimit:       totalGasLimitBumped,
-			Strategy:       txmgr.NewSendEveryStrategy(),
+			Strategy:       txmgr.NewPriorityTxStrategy(txmgr.NewSendEveryStrategy(), lsn.cfg.EvmTxPriority(string(job.VRF))),
 			Meta: &txmgr.EthTxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLinkStr,
@@ -7,6 +7,8 @@ import (
 	assets "github.com/smartcontractkit/chainlink/v2/core/assets"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -58,6 +60,20 @@ func (_m *Config) EvmGasLimitVRFJobType() *uint32 {
 	return r0
 }
 
+// EvmTxPriority provides a mock function with given fields: jobType
+func (_m *Config) EvmTxPriority(jobType string) types.TxPriority {
+	ret := _m.Called(jobType)
+
+	var r0 types.TxPriority
+	if rf, ok := ret.Get(0).(func(string) types.TxPriority); ok {
+		r0 = rf(jobType)
+	} else {
+		r0 = ret.Get(0).(types.TxPriority)
+	}
+
+	return r0
+}
+
 // KeySpecificMaxGasPriceWei provides a mock function with given fields: addr
 func (_m *Config) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei {
 	ret := _m.Called(addr)
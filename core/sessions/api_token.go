@@ -0,0 +1,188 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// Resource is a group of API endpoints which a scoped API token can be
+// granted access to.
+type Resource string
+
+const (
+	ResourceBridges            Resource = "bridges"
+	ResourceChains             Resource = "chains"
+	ResourceConfig             Resource = "config"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceFeeds              Resource = "feeds"
+	ResourceJobs               Resource = "jobs"
+	ResourceKeys               Resource = "keys"
+	ResourceRuns               Resource = "runs"
+	ResourceTxs                Resource = "txs"
+	ResourceUsers              Resource = "users"
+
+	// ResourceAll matches every resource which is not named by another scope
+	// of the same token.
+	ResourceAll Resource = "*"
+)
+
+var resources = map[Resource]struct{}{
+	ResourceBridges:            {},
+	ResourceChains:             {},
+	ResourceConfig:             {},
+	ResourceExternalInitiators: {},
+	ResourceFeeds:              {},
+	ResourceJobs:               {},
+	ResourceKeys:               {},
+	ResourceRuns:               {},
+	ResourceTxs:                {},
+	ResourceUsers:              {},
+	ResourceAll:                {},
+}
+
+// Access is the level of access a scope grants to a resource. Write access
+// implies read access. Neither can exceed the role of the token's user.
+type Access string
+
+const (
+	AccessNone  Access = "none"
+	AccessRead  Access = "read"
+	AccessWrite Access = "write"
+)
+
+var accessLevel = map[Access]int{
+	AccessNone:  0,
+	AccessRead:  1,
+	AccessWrite: 2,
+}
+
+// Scopes limit the access of an API token per resource, for example
+// "jobs:read,bridges:write". Resources which are not listed can not be
+// accessed, unless a "*" scope is given.
+type Scopes map[Resource]Access
+
+// ParseScopes parses a comma separated list of resource:access pairs.
+func ParseScopes(s string) (Scopes, error) {
+	scopes := make(Scopes)
+	for _, scope := range strings.Split(s, ",") {
+		scope = strings.TrimSpace(scope)
+		if scope == "" {
+			continue
+		}
+		resource, access, ok := strings.Cut(scope, ":")
+		if !ok {
+			return nil, errors.Errorf("invalid scope %q: must be of the form resource:access", scope)
+		}
+		r, a := Resource(strings.TrimSpace(resource)), Access(strings.TrimSpace(access))
+		if _, ok := resources[r]; !ok {
+			return nil, errors.Errorf("invalid scope %q: unknown resource %q", scope, r)
+		}
+		if _, ok := accessLevel[a]; !ok {
+			return nil, errors.Errorf("invalid scope %q: access must be one of none, read or write", scope)
+		}
+		if _, ok := scopes[r]; ok {
+			return nil, errors.Errorf("invalid scope %q: duplicate resource %q", scope, r)
+		}
+		scopes[r] = a
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	return scopes, nil
+}
+
+// Allows returns true if the scopes grant at least access to resource.
+func (s Scopes) Allows(resource Resource, access Access) bool {
+	granted, ok := s[resource]
+	if !ok {
+		granted, ok = s[ResourceAll]
+	}
+	return ok && accessLevel[granted] >= accessLevel[access]
+}
+
+// String returns the scopes sorted by resource, in the format accepted by
+// ParseScopes.
+func (s Scopes) String() string {
+	scopes := make([]string, 0, len(s))
+	for r, a := range s {
+		scopes = append(scopes, fmt.Sprintf("%s:%s", r, a))
+	}
+	sort.Strings(scopes)
+	return strings.Join(scopes, ",")
+}
+
+func (s Scopes) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+func (s *Scopes) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case string:
+		*s, err = ParseScopes(v)
+	case []byte:
+		*s, err = ParseScopes(string(v))
+	default:
+		return errors.Errorf("unable to convert %v of %T to Scopes", value, value)
+	}
+	return err
+}
+
+// APIToken is an API token limited to Scopes, which belongs to a user and
+// expires after a TTL. Unlike the single token of each user, which has the
+// full role of the user, any number of them can be created.
+type APIToken struct {
+	ID           int64
+	AccessKey    string
+	Salt         string
+	HashedSecret string
+	UserEmail    string
+	Name         string
+	Scopes       Scopes
+	ExpiresAt    *time.Time
+	LastUsed     *time.Time
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+var (
+	ErrAPITokenExpired = errors.New("API token has expired")
+	ErrAPITokenRevoked = errors.New("API token has been revoked")
+)
+
+// Valid returns an error if the token has been revoked or has expired.
+func (t *APIToken) Valid(now time.Time) error {
+	if t.RevokedAt != nil {
+		return ErrAPITokenRevoked
+	}
+	if t.ExpiresAt != nil && !now.Before(*t.ExpiresAt) {
+		return ErrAPITokenExpired
+	}
+	return nil
+}
+
+// Authenticate returns true if token matches the hashed secret.
+func (t *APIToken) Authenticate(token *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.Salt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.HashedSecret)) == 1, nil
+}
+
+// CreateAPITokenRequest is sent to create a scoped API token. A zero TTL
+// creates a token which never expires.
+type CreateAPITokenRequest struct {
+	Password string          `json:"password"`
+	Name     string          `json:"name"`
+	Scopes   string          `json:"scopes"`
+	TTL      models.Duration `json:"ttl"`
+}
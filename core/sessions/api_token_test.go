@@ -0,0 +1,90 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParseScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    sessions.Scopes
+		wantErr string
+	}{
+		{"jobs:read, bridges:write", sessions.Scopes{sessions.ResourceJobs: sessions.AccessRead, sessions.ResourceBridges: sessions.AccessWrite}, ""},
+		{"keys:none,*:read", sessions.Scopes{sessions.ResourceKeys: sessions.AccessNone, sessions.ResourceAll: sessions.AccessRead}, ""},
+		{"", nil, "at least one scope is required"},
+		{"jobs", nil, "must be of the form resource:access"},
+		{"widgets:read", nil, `unknown resource "widgets"`},
+		{"jobs:admin", nil, "access must be one of none, read or write"},
+		{"jobs:read,jobs:write", nil, `duplicate resource "jobs"`},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			scopes, err := sessions.ParseScopes(test.input)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, scopes)
+		})
+	}
+}
+
+func TestScopes_Allows(t *testing.T) {
+	t.Parallel()
+
+	scopes, err := sessions.ParseScopes("jobs:read,bridges:write,keys:none")
+	require.NoError(t, err)
+
+	assert.True(t, scopes.Allows(sessions.ResourceJobs, sessions.AccessRead))
+	assert.False(t, scopes.Allows(sessions.ResourceJobs, sessions.AccessWrite))
+	assert.True(t, scopes.Allows(sessions.ResourceBridges, sessions.AccessRead))
+	assert.True(t, scopes.Allows(sessions.ResourceBridges, sessions.AccessWrite))
+	assert.False(t, scopes.Allows(sessions.ResourceKeys, sessions.AccessRead))
+	assert.False(t, scopes.Allows(sessions.ResourceTxs, sessions.AccessRead))
+	assert.Equal(t, "bridges:write,jobs:read,keys:none", scopes.String())
+
+	scopes[sessions.ResourceAll] = sessions.AccessRead
+	assert.True(t, scopes.Allows(sessions.ResourceTxs, sessions.AccessRead))
+	assert.False(t, scopes.Allows(sessions.ResourceTxs, sessions.AccessWrite))
+	assert.False(t, scopes.Allows(sessions.ResourceKeys, sessions.AccessRead))
+}
+
+func TestAPIToken_Valid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	assert.NoError(t, (&sessions.APIToken{}).Valid(now))
+	assert.NoError(t, (&sessions.APIToken{ExpiresAt: &future}).Valid(now))
+	assert.ErrorIs(t, (&sessions.APIToken{ExpiresAt: &past}).Valid(now), sessions.ErrAPITokenExpired)
+	assert.ErrorIs(t, (&sessions.APIToken{RevokedAt: &past, ExpiresAt: &future}).Valid(now), sessions.ErrAPITokenRevoked)
+}
+
+func TestAPIToken_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	token := auth.NewToken()
+	hashedSecret, err := auth.HashedSecret(token, "salt")
+	require.NoError(t, err)
+	apiToken := sessions.APIToken{AccessKey: token.AccessKey, Salt: "salt", HashedSecret: hashedSecret}
+
+	ok, err := apiToken.Authenticate(token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = apiToken.Authenticate(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+
+	time "time"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -16,6 +18,37 @@ type ORM struct {
 	mock.Mock
 }
 
+// AuthorizedUserWithAPIToken provides a mock function with given fields: token
+func (_m *ORM) AuthorizedUserWithAPIToken(token *auth.Token) (sessions.User, sessions.APIToken, error) {
+	ret := _m.Called(token)
+
+	var r0 sessions.User
+	var r1 sessions.APIToken
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*auth.Token) (sessions.User, sessions.APIToken, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(*auth.Token) sessions.User); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(*auth.Token) sessions.APIToken); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Get(1).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(2).(func(*auth.Token) error); ok {
+		r2 = rf(token)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // AuthorizedUserWithSession provides a mock function with given fields: sessionID
 func (_m *ORM) AuthorizedUserWithSession(sessionID string) (sessions.User, error) {
 	ret := _m.Called(sessionID)
@@ -54,6 +87,39 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// CreateAPIToken provides a mock function with given fields: user, name, scopes, ttl
+func (_m *ORM) CreateAPIToken(user *sessions.User, name string, scopes sessions.Scopes, ttl time.Duration) (*auth.Token, sessions.APIToken, error) {
+	ret := _m.Called(user, name, scopes, ttl)
+
+	var r0 *auth.Token
+	var r1 sessions.APIToken
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*sessions.User, string, sessions.Scopes, time.Duration) (*auth.Token, sessions.APIToken, error)); ok {
+		return rf(user, name, scopes, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(*sessions.User, string, sessions.Scopes, time.Duration) *auth.Token); ok {
+		r0 = rf(user, name, scopes, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sessions.User, string, sessions.Scopes, time.Duration) sessions.APIToken); ok {
+		r1 = rf(user, name, scopes, ttl)
+	} else {
+		r1 = ret.Get(1).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(2).(func(*sessions.User, string, sessions.Scopes, time.Duration) error); ok {
+		r2 = rf(user, name, scopes, ttl)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -80,6 +146,44 @@ func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	return r0, r1
 }
 
+// CreateCustomRole provides a mock function with given fields: role
+func (_m *ORM) CreateCustomRole(role *sessions.Role) error {
+	ret := _m.Called(role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sessions.Role) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSSOSession provides a mock function with given fields: email, roles, provider
+func (_m *ORM) CreateSSOSession(email string, roles []sessions.UserRole, provider string) (string, error) {
+	ret := _m.Called(email, roles, provider)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []sessions.UserRole, string) (string, error)); ok {
+		return rf(email, roles, provider)
+	}
+	if rf, ok := ret.Get(0).(func(string, []sessions.UserRole, string) string); ok {
+		r0 = rf(email, roles, provider)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []sessions.UserRole, string) error); ok {
+		r1 = rf(email, roles, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSession provides a mock function with given fields: sr
 func (_m *ORM) CreateSession(sr sessions.SessionRequest) (string, error) {
 	ret := _m.Called(sr)
@@ -132,6 +236,20 @@ func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	return r0
 }
 
+// DeleteCustomRole provides a mock function with given fields: name
+func (_m *ORM) DeleteCustomRole(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: email
 func (_m *ORM) DeleteUser(email string) error {
 	ret := _m.Called(email)
@@ -160,6 +278,30 @@ func (_m *ORM) DeleteUserSession(sessionID string) error {
 	return r0
 }
 
+// FindAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) FindAPIToken(accessKey string) (sessions.APIToken, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (sessions.APIToken, error)); ok {
+		return rf(accessKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) sessions.APIToken); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindExternalInitiator provides a mock function with given fields: eia
 func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(eia)
@@ -186,6 +328,30 @@ func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiato
 	return r0, r1
 }
 
+// FindRole provides a mock function with given fields: name
+func (_m *ORM) FindRole(name string) (sessions.Role, error) {
+	ret := _m.Called(name)
+
+	var r0 sessions.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (sessions.Role, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) sessions.Role); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(sessions.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindUser provides a mock function with given fields: email
 func (_m *ORM) FindUser(email string) (sessions.User, error) {
 	ret := _m.Called(email)
@@ -260,6 +426,58 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// ListAPITokens provides a mock function with given fields: email
+func (_m *ORM) ListAPITokens(email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(email)
+
+	var r0 []sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]sessions.APIToken, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) []sessions.APIToken); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields:
+func (_m *ORM) ListRoles() ([]sessions.Role, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]sessions.Role, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []sessions.Role); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields:
 func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	ret := _m.Called()
@@ -286,6 +504,30 @@ func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	return r0, r1
 }
 
+// RevokeAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) RevokeAPIToken(accessKey string) (sessions.APIToken, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (sessions.APIToken, error)); ok {
+		return rf(accessKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) sessions.APIToken); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -354,6 +596,20 @@ func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 	return r0
 }
 
+// UpdateCustomRole provides a mock function with given fields: role
+func (_m *ORM) UpdateCustomRole(role *sessions.Role) error {
+	ret := _m.Called(role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sessions.Role) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateRole provides a mock function with given fields: email, newRole
 func (_m *ORM) UpdateRole(email string, newRole string) (sessions.User, error) {
 	ret := _m.Called(email, newRole)
@@ -0,0 +1,200 @@
+package sessions
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+// Authentication providers, as recorded in the audit log.
+const (
+	AuthProviderLocal = "local"
+	AuthProviderOIDC  = "oidc"
+)
+
+type OIDCConfig interface {
+	OIDCIssuerURL() *url.URL
+	OIDCClientID() string
+	OIDCClientSecret() string
+	OIDCRedirectURL() *url.URL
+	OIDCRolesClaim() string
+	OIDCRoles() map[string]string
+}
+
+// OIDCIdentity is a user authenticated by an OpenID Connect provider.
+type OIDCIdentity struct {
+	Subject string
+	Email   string
+	// Roles are the names of the roles mapped to the values of the roles
+	// claim. They may be custom roles, so they are only resolved at login, by
+	// CreateSSOSession.
+	Roles []UserRole
+}
+
+// OIDCProvider authenticates users with the authorization code flow of an
+// OpenID Connect provider, and maps the values of their roles claim to the
+// names of built-in or custom roles.
+type OIDCProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	rolesClaim   string
+	roleNames    map[string]UserRole
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// NewOIDCProvider returns a provider for cfg. The provider's discovery
+// document is only fetched on first use, so that the node can start while the
+// provider is unavailable.
+func NewOIDCProvider(cfg OIDCConfig) (*OIDCProvider, error) {
+	if cfg.OIDCIssuerURL() == nil || cfg.OIDCRedirectURL() == nil {
+		return nil, errors.New("OIDC issuer and redirect URLs are required")
+	}
+	if err := ValidateOIDCRoles(cfg.OIDCRoles()); err != nil {
+		return nil, err
+	}
+	roleNames := make(map[string]UserRole)
+	for value, name := range cfg.OIDCRoles() {
+		roleNames[value] = UserRole(name)
+	}
+	return &OIDCProvider{
+		issuer:       strings.TrimSuffix(cfg.OIDCIssuerURL().String(), "/"),
+		clientID:     cfg.OIDCClientID(),
+		clientSecret: cfg.OIDCClientSecret(),
+		redirectURL:  cfg.OIDCRedirectURL().String(),
+		rolesClaim:   cfg.OIDCRolesClaim(),
+		roleNames:    roleNames,
+	}, nil
+}
+
+// ValidateOIDCRoles returns an error if roles, from the values of the roles
+// claim to role names, maps a value to a name which can be neither a built-in
+// nor a custom role. Whether a custom role exists is only known at login.
+func ValidateOIDCRoles(roles map[string]string) error {
+	for value, name := range roles {
+		if IsBuiltInRole(UserRole(name)) {
+			continue
+		}
+		if err := ValidateRoleName(name); err != nil {
+			return errors.Wrapf(err, "invalid role of %q", value)
+		}
+	}
+	return nil
+}
+
+// Issuer returns the URL of the provider.
+func (p *OIDCProvider) Issuer() string {
+	return p.issuer
+}
+
+// AuthCodeURL returns the URL of the provider's login page, which redirects
+// back to the callback with state once the user logs in. The nonce is
+// included in the ID token.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	config, _, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	return config.AuthCodeURL(state, oidc.Nonce(nonce)), nil
+}
+
+// Exchange trades the authorization code received by the callback for an ID
+// token, and returns the identity it asserts.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce string) (OIDCIdentity, error) {
+	config, verifier, err := p.oauth2Config(ctx)
+	if err != nil {
+		return OIDCIdentity{}, err
+	}
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return OIDCIdentity{}, errors.Wrap(err, "failed to exchange authorization code")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return OIDCIdentity{}, errors.New("token response has no id_token")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return OIDCIdentity{}, errors.Wrap(err, "failed to verify ID token")
+	}
+	if idToken.Nonce != nonce {
+		return OIDCIdentity{}, errors.New("ID token nonce does not match")
+	}
+
+	var claims map[string]interface{}
+	if err = idToken.Claims(&claims); err != nil {
+		return OIDCIdentity{}, errors.Wrap(err, "failed to parse ID token claims")
+	}
+	email, _ := claims["email"].(string)
+	if err = ValidateEmail(email); err != nil {
+		return OIDCIdentity{}, errors.Wrap(err, "ID token has no valid email claim")
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return OIDCIdentity{}, errors.Errorf("email %s is not verified", email)
+	}
+	roles, err := p.roles(claims[p.rolesClaim])
+	if err != nil {
+		return OIDCIdentity{}, errors.Wrapf(err, "user %s", email)
+	}
+	return OIDCIdentity{
+		Subject: idToken.Subject,
+		Email:   email,
+		Roles:   roles,
+	}, nil
+}
+
+// roles returns the names of the roles mapped to the values of the roles
+// claim, which may be a string or a list of strings.
+func (p *OIDCProvider) roles(claim interface{}) (roles []UserRole, err error) {
+	var values []string
+	switch v := claim.(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	seen := make(map[UserRole]bool)
+	for _, v := range values {
+		if r, ok := p.roleNames[v]; ok && !seen[r] {
+			seen[r] = true
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) == 0 {
+		return nil, errors.Errorf("no role is mapped to the %s claim %v", p.rolesClaim, claim)
+	}
+	return roles, nil
+}
+
+func (p *OIDCProvider) oauth2Config(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.provider == nil {
+		provider, err := oidc.NewProvider(ctx, p.issuer)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to discover OIDC provider %s", p.issuer)
+		}
+		p.provider = provider
+	}
+	config := &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		RedirectURL:  p.redirectURL,
+		Endpoint:     p.provider.Endpoint(),
+		Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+	}
+	verifier := p.provider.Verifier(&oidc.Config{ClientID: p.clientID})
+	return config, verifier, nil
+}
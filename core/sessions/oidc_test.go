@@ -0,0 +1,198 @@
+package sessions_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+type oidcConfig struct {
+	issuer *url.URL
+	roles  map[string]string
+}
+
+func (c oidcConfig) OIDCIssuerURL() *url.URL  { return c.issuer }
+func (c oidcConfig) OIDCClientID() string     { return "chainlink-node" }
+func (c oidcConfig) OIDCClientSecret() string { return "secret" }
+func (c oidcConfig) OIDCRedirectURL() *url.URL {
+	return &url.URL{Scheme: "https", Host: "node.example.com", Path: "/oidc/callback"}
+}
+func (c oidcConfig) OIDCRolesClaim() string { return "roles" }
+func (c oidcConfig) OIDCRoles() map[string]string {
+	if c.roles != nil {
+		return c.roles
+	}
+	return map[string]string{"admins": "admin", "viewers": "view", "custodians": "key-custodian"}
+}
+
+// oidcIssuer is a minimal OpenID Connect provider, which answers every
+// authorization code with an ID token holding claims.
+type oidcIssuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+}
+
+func newOIDCIssuer(t *testing.T) *oidcIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	iss := &oidcIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]interface{}{
+			"issuer":                                iss.URL,
+			"authorization_endpoint":                iss.URL + "/auth",
+			"token_endpoint":                        iss.URL + "/token",
+			"jwks_uri":                              iss.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     iss.idToken(t),
+		})
+	})
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func (iss *oidcIssuer) idToken(t *testing.T) string {
+	claims := map[string]interface{}{
+		"iss": iss.URL,
+		"sub": "user-1",
+		"aud": "chainlink-node",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	}
+	for k, v := range iss.claims {
+		claims[k] = v
+	}
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, iss.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func TestOIDCProvider_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	iss := newOIDCIssuer(t)
+	p, err := sessions.NewOIDCProvider(oidcConfig{issuer: testutils.MustParseURL(t, iss.URL)})
+	require.NoError(t, err)
+
+	authURL, err := p.AuthCodeURL(testutils.Context(t), "state", "nonce")
+	require.NoError(t, err)
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/auth", u.Path)
+	q := u.Query()
+	assert.Equal(t, "chainlink-node", q.Get("client_id"))
+	assert.Equal(t, "state", q.Get("state"))
+	assert.Equal(t, "nonce", q.Get("nonce"))
+	assert.Equal(t, "https://node.example.com/oidc/callback", q.Get("redirect_uri"))
+	assert.Contains(t, q.Get("scope"), "openid")
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		claims    map[string]interface{}
+		nonce     string
+		wantRoles []sessions.UserRole
+		wantErr   string
+	}{
+		{"mapped roles", map[string]interface{}{"nonce": "n", "email": "a@example.com", "roles": []string{"viewers", "custodians", "other", "viewers"}}, "n", []sessions.UserRole{sessions.UserRoleView, "key-custodian"}, ""},
+		{"single role", map[string]interface{}{"nonce": "n", "email": "a@example.com", "roles": "viewers"}, "n", []sessions.UserRole{sessions.UserRoleView}, ""},
+		{"no mapped role", map[string]interface{}{"nonce": "n", "email": "a@example.com", "roles": []string{"other"}}, "n", nil, "no role is mapped to the roles claim"},
+		{"no roles claim", map[string]interface{}{"nonce": "n", "email": "a@example.com"}, "n", nil, "no role is mapped to the roles claim"},
+		{"nonce mismatch", map[string]interface{}{"nonce": "other", "email": "a@example.com", "roles": "admins"}, "n", nil, "nonce does not match"},
+		{"no email", map[string]interface{}{"nonce": "n", "roles": "admins"}, "n", nil, "no valid email claim"},
+		{"unverified email", map[string]interface{}{"nonce": "n", "email": "a@example.com", "email_verified": false, "roles": "admins"}, "n", nil, "is not verified"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			iss := newOIDCIssuer(t)
+			iss.claims = test.claims
+			p, err := sessions.NewOIDCProvider(oidcConfig{issuer: testutils.MustParseURL(t, iss.URL)})
+			require.NoError(t, err)
+
+			identity, err := p.Exchange(testutils.Context(t), "code", test.nonce)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", identity.Subject)
+			assert.Equal(t, "a@example.com", identity.Email)
+			assert.Equal(t, test.wantRoles, identity.Roles)
+		})
+	}
+}
+
+func TestNewOIDCProvider_InvalidRole(t *testing.T) {
+	t.Parallel()
+
+	issuerURL := testutils.MustParseURL(t, "https://issuer.example.com")
+	_, err := sessions.NewOIDCProvider(oidcConfig{issuer: issuerURL, roles: map[string]string{"custodians": "Key Custodian"}})
+	require.ErrorContains(t, err, `invalid role of "custodians"`)
+}
+
+func TestOIDCProvider_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	iss := newOIDCIssuer(t)
+	issuerURL := testutils.MustParseURL(t, iss.URL)
+	iss.Close()
+
+	// Discovery is deferred, so the provider can be created while the issuer is down.
+	p, err := sessions.NewOIDCProvider(oidcConfig{issuer: issuerURL})
+	require.NoError(t, err)
+
+	_, err = p.AuthCodeURL(testutils.Context(t), "state", "nonce")
+	require.ErrorContains(t, err, "failed to discover OIDC provider")
+}
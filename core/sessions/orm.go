@@ -2,6 +2,7 @@ package sessions
 
 import (
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
@@ -28,16 +29,27 @@ type ORM interface {
 	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
 	CreateSession(sr SessionRequest) (string, error)
+	CreateSSOSession(email string, roles []UserRole, provider string) (string, error)
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
 	UpdateRole(email, newRole string) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
 	DeleteAuthToken(user *User) error
+	CreateAPIToken(user *User, name string, scopes Scopes, ttl time.Duration) (*auth.Token, APIToken, error)
+	AuthorizedUserWithAPIToken(token *auth.Token) (User, APIToken, error)
+	FindAPIToken(accessKey string) (APIToken, error)
+	ListAPITokens(email string) ([]APIToken, error)
+	RevokeAPIToken(accessKey string) (APIToken, error)
 	SetPassword(user *User, newPassword string) error
 	Sessions(offset, limit int) ([]Session, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
 	SaveWebAuthn(token *WebAuthn) error
+	ListRoles() ([]Role, error)
+	FindRole(name string) (Role, error)
+	CreateCustomRole(role *Role) error
+	UpdateCustomRole(role *Role) error
+	DeleteCustomRole(name string) error
 
 	FindExternalInitiator(eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
@@ -69,13 +81,19 @@ func (o *orm) FindUser(email string) (User, error) {
 // FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
 func (o *orm) FindUserByAPIToken(apiToken string) (user User, err error) {
 	sql := "SELECT * FROM users WHERE token_key = $1"
-	err = o.q.Get(&user, sql, apiToken)
+	if err = o.q.Get(&user, sql, apiToken); err != nil {
+		return
+	}
+	err = loadPermissions(o.q, &user)
 	return
 }
 
 func (o *orm) findUser(email string) (user User, err error) {
 	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
-	err = o.q.Get(&user, sql, email)
+	if err = o.q.Get(&user, sql, email); err != nil {
+		return
+	}
+	err = loadPermissions(o.q, &user)
 	return
 }
 
@@ -114,6 +132,9 @@ func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 		if err := tx.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", foundSession.Email); err != nil {
 			return errors.Wrap(err, "no matching user for provided session email")
 		}
+		if err := loadPermissions(tx, &user); err != nil {
+			return err
+		}
 		// Session valid and tied to user, update last_used
 		_, err := tx.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
 		if err != nil {
@@ -197,7 +218,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := NewSession()
 		_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
-		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email})
+		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email, "provider": AuthProviderLocal})
 		return session.ID, err
 	}
 
@@ -246,12 +267,78 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	if err != nil {
 		lggr.Errorf("error in Marshal credentials: %s", err)
 	} else {
-		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": sr.Email, "credential": string(uwasj)})
+		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": sr.Email, "provider": AuthProviderLocal, "credential": string(uwasj)})
 	}
 
 	return session.ID, nil
 }
 
+// ErrPasswordUserSSO is returned by CreateSSOSession for users who log in with
+// a password.
+var ErrPasswordUserSSO = errors.New("user has a password and cannot log in with SSO")
+
+// ErrNoSSORole is returned by CreateSSOSession when none of the roles granted
+// by the identity provider exist.
+var ErrNoSSORole = errors.New("none of the roles granted by the identity provider exist")
+
+// CreateSSOSession creates a session for a user authenticated by an external
+// identity provider, which granted them the built-in or custom roles named
+// roles. The user is created on their first login, and their role is updated
+// on every login to the granted role with the most permissions. Users created
+// this way have no password, so they cannot log in with one, and users who
+// have a password cannot log in with SSO, so their role is left alone.
+func (o *orm) CreateSSOSession(email string, roles []UserRole, provider string) (string, error) {
+	session := NewSession()
+	var role UserRole
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		var err error
+		if role, err = o.mostPermissiveRole(tx, roles); err != nil {
+			return err
+		}
+		res, err := tx.Exec(`INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES (lower($1), '', $2, now(), now())
+ON CONFLICT (lower(email)) DO UPDATE SET role = EXCLUDED.role, updated_at = now() WHERE users.hashed_password = ''`, email, role)
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert user")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert user")
+		}
+		if rowsAffected == 0 {
+			return ErrPasswordUserSSO
+		}
+		_, err = tx.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, lower($2), now(), now())", session.ID, email)
+		return errors.Wrap(err, "failed to insert session")
+	})
+	if err != nil {
+		return "", err
+	}
+	o.auditLogger.Audit(audit.AuthLoginSuccessSSO, map[string]interface{}{"email": email, "provider": provider, "role": role})
+	return session.ID, nil
+}
+
+// mostPermissiveRole returns the existing role of names with the most
+// permissions.
+func (o *orm) mostPermissiveRole(q pg.Queryer, names []UserRole) (UserRole, error) {
+	var best *Role
+	for _, name := range names {
+		role, err := findRole(q, string(name))
+		if errors.Is(err, sql.ErrNoRows) {
+			o.lggr.Warnw("Ignoring role granted by the identity provider, which does not exist", "role", name)
+			continue
+		} else if err != nil {
+			return "", errors.Wrapf(err, "failed to find role %s", name)
+		}
+		if best == nil || len(role.Permissions) > len(best.Permissions) {
+			best = &role
+		}
+	}
+	if best == nil {
+		return "", ErrNoSSORole
+	}
+	return best.Name, nil
+}
+
 const constantTimeEmailLength = 256
 
 func constantTimeEmailCompare(left, right string) bool {
@@ -290,11 +377,13 @@ func (o *orm) UpdateRole(email, newRole string) (User, error) {
 		}
 
 		// Patch validated role
-		userRole, err := GetUserRole(newRole)
-		if err != nil {
+		role, err := findRole(tx, newRole)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("Invalid role: %s", newRole)
+		} else if err != nil {
 			return err
 		}
-		userToEdit.Role = userRole
+		userToEdit.Role = role.Name
 
 		_, err = tx.Exec("DELETE FROM sessions WHERE email = lower($1)", email)
 		if err != nil {
@@ -352,6 +441,179 @@ func (o *orm) DeleteAuthToken(user *User) error {
 	return o.q.Get(user, sql, user.Email)
 }
 
+// CreateAPIToken creates a token for user limited to scopes, which expires
+// after ttl, or never if ttl is zero.
+func (o *orm) CreateAPIToken(user *User, name string, scopes Scopes, ttl time.Duration) (*auth.Token, APIToken, error) {
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return nil, APIToken{}, errors.Wrap(err, "api token")
+	}
+	var expiresAt *time.Time
+	if ttl > 0 {
+		t := time.Now().Add(ttl)
+		expiresAt = &t
+	}
+	var apiToken APIToken
+	sql := `INSERT INTO api_tokens (access_key, salt, hashed_secret, user_email, name, scopes, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now()) RETURNING *`
+	err = o.q.Get(&apiToken, sql, token.AccessKey, salt, hashedSecret, user.Email, name, scopes, expiresAt)
+	if err != nil {
+		return nil, APIToken{}, errors.Wrap(err, "failed to insert api token")
+	}
+	return token, apiToken, nil
+}
+
+// AuthorizedUserWithAPIToken returns the user and the scoped API token
+// matching token, if it has not expired or been revoked, and updates the
+// token's LastUsed field.
+func (o *orm) AuthorizedUserWithAPIToken(token *auth.Token) (user User, apiToken APIToken, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&apiToken, "SELECT * FROM api_tokens WHERE access_key = $1 FOR UPDATE", token.AccessKey); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return auth.ErrorAuthFailed
+			}
+			return errors.Wrap(err, "failed to load api token")
+		}
+		ok, err := apiToken.Authenticate(token)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return auth.ErrorAuthFailed
+		}
+		if err = apiToken.Valid(time.Now()); err != nil {
+			return err
+		}
+		if err = tx.Get(&apiToken, "UPDATE api_tokens SET last_used = now() WHERE id = $1 RETURNING *", apiToken.ID); err != nil {
+			return errors.Wrap(err, "failed to update api token")
+		}
+		if err = tx.Get(&user, "SELECT * FROM users WHERE email = $1", apiToken.UserEmail); err != nil {
+			return err
+		}
+		return loadPermissions(tx, &user)
+	})
+	return
+}
+
+// FindAPIToken returns the scoped API token with accessKey.
+func (o *orm) FindAPIToken(accessKey string) (apiToken APIToken, err error) {
+	err = o.q.Get(&apiToken, "SELECT * FROM api_tokens WHERE access_key = $1", accessKey)
+	return
+}
+
+// ListAPITokens returns the scoped API tokens of the user with email, or of
+// all users if email is empty.
+func (o *orm) ListAPITokens(email string) (apiTokens []APIToken, err error) {
+	sql := "SELECT * FROM api_tokens WHERE $1 = '' OR user_email = lower($1) ORDER BY created_at, id"
+	err = o.q.Select(&apiTokens, sql, email)
+	return
+}
+
+// RevokeAPIToken revokes the scoped API token with accessKey. Revoked tokens
+// are kept, so that they can still be listed.
+func (o *orm) RevokeAPIToken(accessKey string) (apiToken APIToken, err error) {
+	sql := "UPDATE api_tokens SET revoked_at = now() WHERE access_key = $1 AND revoked_at IS NULL RETURNING *"
+	err = o.q.Get(&apiToken, sql, accessKey)
+	return
+}
+
+// ListRoles returns the built-in and custom roles, sorted by name.
+func (o *orm) ListRoles() (roles []Role, err error) {
+	if err = o.q.Select(&roles, "SELECT * FROM roles ORDER BY name"); err != nil {
+		return nil, err
+	}
+	for i := range roles {
+		setBuiltInPermissions(&roles[i])
+	}
+	return
+}
+
+// FindRole returns the role with name.
+func (o *orm) FindRole(name string) (Role, error) {
+	return findRole(o.q, name)
+}
+
+func findRole(q pg.Queryer, name string) (role Role, err error) {
+	if err = q.Get(&role, "SELECT * FROM roles WHERE name = $1", name); err != nil {
+		return
+	}
+	setBuiltInPermissions(&role)
+	return
+}
+
+func setBuiltInPermissions(role *Role) {
+	if role.BuiltIn {
+		role.Permissions = BuiltInPermissions(role.Name)
+	}
+}
+
+// loadPermissions sets the permissions of the role of user.
+func loadPermissions(q pg.Queryer, user *User) error {
+	if IsBuiltInRole(user.Role) {
+		user.Permissions = BuiltInPermissions(user.Role)
+		return nil
+	}
+	role, err := findRole(q, string(user.Role))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load role %s", user.Role)
+	}
+	user.Permissions = role.Permissions
+	if user.Permissions == nil {
+		user.Permissions = Permissions{}
+	}
+	return nil
+}
+
+// CreateCustomRole creates a role which is not built-in.
+func (o *orm) CreateCustomRole(role *Role) error {
+	if err := ValidateRoleName(string(role.Name)); err != nil {
+		return err
+	}
+	sql := "INSERT INTO roles (name, description, permissions, built_in, created_at, updated_at) VALUES ($1, $2, $3, false, now(), now()) RETURNING *"
+	return o.q.Get(role, sql, role.Name, role.Description, role.Permissions)
+}
+
+// UpdateCustomRole overwrites the description and permissions of a custom
+// role. The new permissions apply to the next request of its users.
+func (o *orm) UpdateCustomRole(role *Role) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		existing, err := findRole(tx, string(role.Name))
+		if err != nil {
+			return err
+		}
+		if existing.BuiltIn {
+			return ErrBuiltInRole
+		}
+		sql := "UPDATE roles SET description = $2, permissions = $3, updated_at = now() WHERE name = $1 RETURNING *"
+		return tx.Get(role, sql, role.Name, role.Description, role.Permissions)
+	})
+}
+
+// DeleteCustomRole deletes a custom role, which must not be assigned to any
+// user.
+func (o *orm) DeleteCustomRole(name string) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		role, err := findRole(tx, name)
+		if err != nil {
+			return err
+		}
+		if role.BuiltIn {
+			return ErrBuiltInRole
+		}
+		var users int
+		if err = tx.Get(&users, "SELECT count(*) FROM users WHERE role = $1", name); err != nil {
+			return err
+		}
+		if users > 0 {
+			return RoleInUseError{Role: role.Name, Users: users}
+		}
+		_, err = tx.Exec("DELETE FROM roles WHERE name = $1", name)
+		return err
+	})
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
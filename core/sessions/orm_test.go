@@ -1,6 +1,7 @@
 package sessions_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -184,6 +185,175 @@ func TestORM_CreateSession(t *testing.T) {
 	}
 }
 
+func TestORM_CreateSSOSession(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	sessionID, err := orm.CreateSSOSession("SSO@example.com", []sessions.UserRole{sessions.UserRoleView}, sessions.AuthProviderOIDC)
+	require.NoError(t, err)
+	assert.NotEmpty(t, sessionID)
+
+	user, err := orm.AuthorizedUserWithSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "sso@example.com", user.Email)
+	assert.Equal(t, sessions.UserRoleView, user.Role)
+
+	// The role follows the provider on every login, as the granted role with the most permissions.
+	sessionID, err = orm.CreateSSOSession("sso@example.com", []sessions.UserRole{sessions.UserRoleView, sessions.UserRoleAdmin, "missing"}, sessions.AuthProviderOIDC)
+	require.NoError(t, err)
+	user, err = orm.AuthorizedUserWithSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+
+	// Custom roles are granted too, and kept on the next login.
+	custodian := sessions.Role{Name: "key-custodian", Permissions: sessions.Permissions{sessions.PermissionKeysRead, sessions.PermissionKeysRotate}}
+	require.NoError(t, orm.CreateCustomRole(&custodian))
+	for i := 0; i < 2; i++ {
+		sessionID, err = orm.CreateSSOSession("sso@example.com", []sessions.UserRole{"key-custodian"}, sessions.AuthProviderOIDC)
+		require.NoError(t, err)
+		user, err = orm.AuthorizedUserWithSession(sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRole("key-custodian"), user.Role)
+		assert.True(t, user.HasPermission(sessions.PermissionKeysRotate))
+	}
+
+	// None of the granted roles exist.
+	_, err = orm.CreateSSOSession("sso@example.com", []sessions.UserRole{"missing"}, sessions.AuthProviderOIDC)
+	require.ErrorIs(t, err, sessions.ErrNoSSORole)
+
+	// Users created by SSO cannot log in with a password.
+	_, err = orm.CreateSession(sessions.SessionRequest{Email: "sso@example.com", Password: ""})
+	require.Error(t, err)
+
+	// Users with a password cannot log in with SSO, and keep their role.
+	local := cltest.MustNewUser(t, "local@example.com", cltest.Password)
+	local.Role = sessions.UserRoleView
+	require.NoError(t, orm.CreateUser(&local))
+	_, err = orm.CreateSSOSession("Local@example.com", []sessions.UserRole{sessions.UserRoleAdmin}, sessions.AuthProviderOIDC)
+	require.ErrorIs(t, err, sessions.ErrPasswordUserSSO)
+	local, err = orm.FindUser("local@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, local.Role)
+}
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	scopes, err := sessions.ParseScopes("jobs:read,bridges:write")
+	require.NoError(t, err)
+
+	token, apiToken, err := orm.CreateAPIToken(&user, "automation", scopes, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, token.AccessKey, apiToken.AccessKey)
+	assert.Equal(t, user.Email, apiToken.UserEmail)
+	assert.Equal(t, scopes, apiToken.Scopes)
+	require.NotNil(t, apiToken.ExpiresAt)
+	assert.Nil(t, apiToken.LastUsed)
+
+	authUser, authToken, err := orm.AuthorizedUserWithAPIToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, authUser.Email)
+	assert.Equal(t, scopes, authToken.Scopes)
+	assert.NotNil(t, authToken.LastUsed)
+
+	_, _, err = orm.AuthorizedUserWithAPIToken(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+	require.ErrorIs(t, err, auth.ErrorAuthFailed)
+	_, _, err = orm.AuthorizedUserWithAPIToken(auth.NewToken())
+	require.ErrorIs(t, err, auth.ErrorAuthFailed)
+
+	neverExpires, _, err := orm.CreateAPIToken(&user, "forever", scopes, 0)
+	require.NoError(t, err)
+	tokens, err := orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Nil(t, tokens[1].ExpiresAt)
+	tokens, err = orm.ListAPITokens("")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	revoked, err := orm.RevokeAPIToken(neverExpires.AccessKey)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+	_, err = orm.RevokeAPIToken(neverExpires.AccessKey)
+	require.Error(t, err)
+	_, _, err = orm.AuthorizedUserWithAPIToken(neverExpires)
+	require.ErrorIs(t, err, sessions.ErrAPITokenRevoked)
+
+	expired, _, err := orm.CreateAPIToken(&user, "expired", scopes, time.Nanosecond)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, _, err = orm.AuthorizedUserWithAPIToken(expired)
+	require.ErrorIs(t, err, sessions.ErrAPITokenExpired)
+
+	// Tokens are deleted with their user.
+	require.NoError(t, orm.DeleteUser(user.Email))
+	tokens, err = orm.ListAPITokens("")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestORM_CustomRoles(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	roles, err := orm.ListRoles()
+	require.NoError(t, err)
+	require.Len(t, roles, 4)
+	for _, role := range roles {
+		assert.True(t, role.BuiltIn)
+		assert.Equal(t, sessions.BuiltInPermissions(role.Name), role.Permissions)
+	}
+
+	perms, err := sessions.ParsePermissions("keys.*")
+	require.NoError(t, err)
+	role := sessions.Role{Name: "key-custodian", Description: "Key operations only", Permissions: perms}
+	require.NoError(t, orm.CreateCustomRole(&role))
+	assert.False(t, role.BuiltIn)
+	assert.False(t, role.CreatedAt.IsZero())
+	require.Error(t, orm.CreateCustomRole(&role))
+	require.Error(t, orm.CreateCustomRole(&sessions.Role{Name: sessions.UserRoleAdmin}))
+
+	found, err := orm.FindRole("key-custodian")
+	require.NoError(t, err)
+	assert.Equal(t, perms, found.Permissions)
+
+	// Users of a custom role are authorized with its permissions.
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	_, err = orm.UpdateRole(user.Email, "key-custodian")
+	require.NoError(t, err)
+	_, err = orm.UpdateRole(user.Email, "no-such-role")
+	require.EqualError(t, err, "Invalid role: no-such-role")
+	user, err = orm.FindUser(user.Email)
+	require.NoError(t, err)
+	assert.True(t, user.HasPermission(sessions.PermissionKeysExport))
+	assert.False(t, user.HasPermission(sessions.PermissionJobsRead))
+
+	role.Permissions = sessions.Permissions{sessions.PermissionKeysRead}
+	require.NoError(t, orm.UpdateCustomRole(&role))
+	user, err = orm.FindUser(user.Email)
+	require.NoError(t, err)
+	assert.False(t, user.HasPermission(sessions.PermissionKeysExport))
+	assert.True(t, user.HasPermission(sessions.PermissionKeysRead))
+
+	require.ErrorIs(t, orm.UpdateCustomRole(&sessions.Role{Name: sessions.UserRoleEdit}), sessions.ErrBuiltInRole)
+	require.ErrorIs(t, orm.UpdateCustomRole(&sessions.Role{Name: "no-such-role"}), sql.ErrNoRows)
+	require.ErrorIs(t, orm.DeleteCustomRole(string(sessions.UserRoleView)), sessions.ErrBuiltInRole)
+
+	err = orm.DeleteCustomRole("key-custodian")
+	require.ErrorAs(t, err, &sessions.RoleInUseError{})
+	require.NoError(t, orm.DeleteUser(user.Email))
+	require.NoError(t, orm.DeleteCustomRole("key-custodian"))
+	_, err = orm.FindRole("key-custodian")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestORM_WebAuthn(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,255 @@
+package sessions
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Permission names an action of a controller or resolver, which the role of
+// a user must grant for the user to perform it.
+type Permission string
+
+const (
+	PermissionBridgesRead   Permission = "bridges.read"
+	PermissionBridgesCreate Permission = "bridges.create"
+	PermissionBridgesUpdate Permission = "bridges.update"
+	PermissionBridgesDelete Permission = "bridges.delete"
+
+	PermissionChainsRead       Permission = "chains.read"
+	PermissionChainsReplay     Permission = "chains.replay"
+	PermissionChainsForwarders Permission = "chains.forwarders"
+
+	PermissionConfigRead   Permission = "config.read"
+	PermissionConfigUpdate Permission = "config.update"
+
+	PermissionExternalInitiatorsRead   Permission = "external_initiators.read"
+	PermissionExternalInitiatorsCreate Permission = "external_initiators.create"
+	PermissionExternalInitiatorsDelete Permission = "external_initiators.delete"
+
+	PermissionFeedsRead      Permission = "feeds.read"
+	PermissionFeedsManagers  Permission = "feeds.managers"
+	PermissionFeedsProposals Permission = "feeds.proposals"
+
+	PermissionJobsRead         Permission = "jobs.read"
+	PermissionJobsCreate       Permission = "jobs.create"
+	PermissionJobsUpdate       Permission = "jobs.update"
+	PermissionJobsDelete       Permission = "jobs.delete"
+	PermissionJobsSimulate     Permission = "jobs.simulate"
+	PermissionJobsDismissError Permission = "jobs.dismiss_error"
+
+	PermissionKeysRead   Permission = "keys.read"
+	PermissionKeysCreate Permission = "keys.create"
+	PermissionKeysDelete Permission = "keys.delete"
+	PermissionKeysImport Permission = "keys.import"
+	PermissionKeysExport Permission = "keys.export"
+	PermissionKeysRotate Permission = "keys.rotate"
+	PermissionKeysChain  Permission = "keys.chain"
+
+	PermissionRunsRead   Permission = "runs.read"
+	PermissionRunsCreate Permission = "runs.create"
+
+	PermissionTxsRead     Permission = "txs.read"
+	PermissionTxsTransfer Permission = "txs.transfer"
+
+	PermissionUsersRead   Permission = "users.read"
+	PermissionUsersManage Permission = "users.manage"
+	PermissionRolesRead   Permission = "roles.read"
+	PermissionRolesManage Permission = "roles.manage"
+)
+
+// Access returns the access to its resource which a scoped API token needs
+// for p.
+func (p Permission) Access() Access {
+	if strings.HasSuffix(string(p), ".read") {
+		return AccessRead
+	}
+	return AccessWrite
+}
+
+// AllPermissions lists every permission, as granted to the admin role.
+var AllPermissions = Permissions{
+	PermissionBridgesRead, PermissionBridgesCreate, PermissionBridgesUpdate, PermissionBridgesDelete,
+	PermissionChainsRead, PermissionChainsReplay, PermissionChainsForwarders,
+	PermissionConfigRead, PermissionConfigUpdate,
+	PermissionExternalInitiatorsRead, PermissionExternalInitiatorsCreate, PermissionExternalInitiatorsDelete,
+	PermissionFeedsRead, PermissionFeedsManagers, PermissionFeedsProposals,
+	PermissionJobsRead, PermissionJobsCreate, PermissionJobsUpdate, PermissionJobsDelete, PermissionJobsSimulate, PermissionJobsDismissError,
+	PermissionKeysRead, PermissionKeysCreate, PermissionKeysDelete, PermissionKeysImport, PermissionKeysExport, PermissionKeysRotate, PermissionKeysChain,
+	PermissionRunsRead, PermissionRunsCreate,
+	PermissionTxsRead, PermissionTxsTransfer,
+	PermissionUsersRead, PermissionUsersManage, PermissionRolesRead, PermissionRolesManage,
+}
+
+var (
+	viewPermissions = Permissions{
+		PermissionBridgesRead,
+		PermissionChainsRead,
+		PermissionConfigRead,
+		PermissionExternalInitiatorsRead,
+		PermissionFeedsRead,
+		PermissionJobsRead,
+		PermissionKeysRead,
+		PermissionRolesRead,
+		PermissionRunsRead,
+		PermissionTxsRead,
+	}
+	runPermissions = append(Permissions{
+		PermissionChainsReplay,
+		PermissionRunsCreate,
+	}, viewPermissions...)
+	editPermissions = append(Permissions{
+		PermissionBridgesCreate, PermissionBridgesUpdate, PermissionBridgesDelete,
+		PermissionChainsForwarders,
+		PermissionExternalInitiatorsCreate, PermissionExternalInitiatorsDelete,
+		PermissionFeedsManagers, PermissionFeedsProposals,
+		PermissionJobsCreate, PermissionJobsUpdate, PermissionJobsDelete, PermissionJobsSimulate, PermissionJobsDismissError,
+		PermissionKeysCreate,
+	}, runPermissions...)
+
+	// builtInPermissions are the permissions of the built-in roles, which
+	// are not stored in the database so that new permissions are granted to
+	// them as they are added.
+	builtInPermissions = map[UserRole]Permissions{
+		UserRoleAdmin: AllPermissions.sorted(),
+		UserRoleEdit:  editPermissions.sorted(),
+		UserRoleRun:   runPermissions.sorted(),
+		UserRoleView:  viewPermissions.sorted(),
+	}
+)
+
+// BuiltInPermissions returns the permissions of a built-in role, or nil for a
+// custom role.
+func BuiltInPermissions(role UserRole) Permissions {
+	return builtInPermissions[role]
+}
+
+// IsBuiltInRole returns true for the admin, edit, run and view roles.
+func IsBuiltInRole(role UserRole) bool {
+	_, ok := builtInPermissions[role]
+	return ok
+}
+
+// Permissions is a set of permissions, stored as a comma separated list.
+type Permissions []Permission
+
+// ParsePermissions parses a comma separated list of permissions. A
+// permission of the form "resource.*" grants every permission of resource.
+func ParsePermissions(s string) (Permissions, error) {
+	var perms Permissions
+	seen := make(map[Permission]struct{})
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		matched := false
+		for _, p := range AllPermissions {
+			if p == Permission(name) || strings.HasSuffix(name, ".*") && strings.HasPrefix(string(p), strings.TrimSuffix(name, "*")) {
+				matched = true
+				if _, ok := seen[p]; !ok {
+					seen[p] = struct{}{}
+					perms = append(perms, p)
+				}
+			}
+		}
+		if !matched {
+			return nil, errors.Errorf("unknown permission %q", name)
+		}
+	}
+	return perms.sorted(), nil
+}
+
+// Has returns true if p is in the set.
+func (ps Permissions) Has(p Permission) bool {
+	for _, granted := range ps {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (ps Permissions) sorted() Permissions {
+	sorted := make(Permissions, len(ps))
+	copy(sorted, ps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// String returns the permissions in the format accepted by ParsePermissions.
+func (ps Permissions) String() string {
+	names := make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = string(p)
+	}
+	return strings.Join(names, ",")
+}
+
+func (ps Permissions) Value() (driver.Value, error) {
+	return ps.String(), nil
+}
+
+func (ps *Permissions) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case string:
+		*ps, err = ParsePermissions(v)
+	case []byte:
+		*ps, err = ParsePermissions(string(v))
+	default:
+		return errors.Errorf("unable to convert %v of %T to Permissions", value, value)
+	}
+	return err
+}
+
+// Role is a named set of permissions which users are assigned. The built-in
+// admin, edit, run and view roles can not be changed.
+type Role struct {
+	Name        UserRole
+	Description string
+	Permissions Permissions
+	BuiltIn     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+var roleNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+// ValidateRoleName returns an error if name can not be used for a custom
+// role.
+func ValidateRoleName(name string) error {
+	if !roleNameRegexp.MatchString(name) {
+		return errors.Errorf("invalid role name %q: must start with a letter, and contain at most 63 lowercase letters, digits, dashes or underscores", name)
+	}
+	if IsBuiltInRole(UserRole(name)) {
+		return errors.Errorf("invalid role name %q: can not replace a built-in role", name)
+	}
+	return nil
+}
+
+// ErrBuiltInRole is returned when changing or deleting a built-in role.
+var ErrBuiltInRole = errors.New("built-in roles can not be changed")
+
+// RoleInUseError is returned when deleting a role which users are still
+// assigned.
+type RoleInUseError struct {
+	Role  UserRole
+	Users int
+}
+
+func (e RoleInUseError) Error() string {
+	return fmt.Sprintf("role %s is assigned to %d user(s)", e.Role, e.Users)
+}
+
+// RoleRequest is sent to create or update a custom role. Permissions are
+// comma separated, in the format accepted by ParsePermissions.
+type RoleRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Permissions string `json:"permissions"`
+}
@@ -0,0 +1,106 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParsePermissions(t *testing.T) {
+	t.Parallel()
+
+	perms, err := sessions.ParsePermissions(" keys.read, jobs.create,keys.read,,")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.Permissions{sessions.PermissionJobsCreate, sessions.PermissionKeysRead}, perms)
+	assert.Equal(t, "jobs.create,keys.read", perms.String())
+
+	perms, err = sessions.ParsePermissions("bridges.*")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.Permissions{
+		sessions.PermissionBridgesCreate,
+		sessions.PermissionBridgesDelete,
+		sessions.PermissionBridgesRead,
+		sessions.PermissionBridgesUpdate,
+	}, perms)
+
+	perms, err = sessions.ParsePermissions("")
+	require.NoError(t, err)
+	assert.Empty(t, perms)
+
+	for _, s := range []string{"keys", "keys.fly", "bridge.*", "*"} {
+		_, err = sessions.ParsePermissions(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestPermissions_Scan(t *testing.T) {
+	t.Parallel()
+
+	var perms sessions.Permissions
+	require.NoError(t, perms.Scan([]byte("runs.create,keys.*")))
+	assert.True(t, perms.Has(sessions.PermissionRunsCreate))
+	assert.True(t, perms.Has(sessions.PermissionKeysExport))
+	assert.False(t, perms.Has(sessions.PermissionJobsRead))
+
+	v, err := perms.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "keys.chain,keys.create,keys.delete,keys.export,keys.import,keys.read,keys.rotate,runs.create", v)
+
+	assert.Error(t, perms.Scan(1))
+}
+
+func TestPermission_Access(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, sessions.AccessRead, sessions.PermissionJobsRead.Access())
+	assert.Equal(t, sessions.AccessWrite, sessions.PermissionJobsCreate.Access())
+	assert.Equal(t, sessions.AccessWrite, sessions.PermissionChainsReplay.Access())
+}
+
+func TestUser_HasPermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role       sessions.UserRole
+		permission sessions.Permission
+		want       bool
+	}{
+		{sessions.UserRoleAdmin, sessions.PermissionRolesManage, true},
+		{sessions.UserRoleAdmin, sessions.PermissionKeysExport, true},
+		{sessions.UserRoleEdit, sessions.PermissionJobsCreate, true},
+		{sessions.UserRoleEdit, sessions.PermissionKeysCreate, true},
+		{sessions.UserRoleEdit, sessions.PermissionKeysDelete, false},
+		{sessions.UserRoleEdit, sessions.PermissionUsersRead, false},
+		{sessions.UserRoleRun, sessions.PermissionRunsCreate, true},
+		{sessions.UserRoleRun, sessions.PermissionChainsReplay, true},
+		{sessions.UserRoleRun, sessions.PermissionJobsCreate, false},
+		{sessions.UserRoleView, sessions.PermissionJobsRead, true},
+		{sessions.UserRoleView, sessions.PermissionRolesRead, true},
+		{sessions.UserRoleView, sessions.PermissionRunsCreate, false},
+		{"key-custodian", sessions.PermissionKeysRead, false},
+	}
+
+	for _, test := range tests {
+		user := sessions.User{Role: test.role}
+		assert.Equal(t, test.want, user.HasPermission(test.permission), "%s %s", test.role, test.permission)
+	}
+
+	// The permissions of custom roles are loaded by the ORM.
+	user := sessions.User{Role: "key-custodian", Permissions: sessions.Permissions{sessions.PermissionKeysRead, sessions.PermissionKeysExport}}
+	assert.True(t, user.HasPermission(sessions.PermissionKeysExport))
+	assert.False(t, user.HasPermission(sessions.PermissionJobsRead))
+}
+
+func TestValidateRoleName(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, sessions.ValidateRoleName("key-custodian"))
+	assert.NoError(t, sessions.ValidateRoleName("job_operator2"))
+
+	for _, name := range []string{"", "admin", "view", "Key-Custodian", "2fa", "job operator"} {
+		assert.Error(t, sessions.ValidateRoleName(name), name)
+	}
+}
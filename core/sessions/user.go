@@ -23,8 +23,13 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// Permissions are the permissions of Role, set by the ORM when the user
+	// is authorized.
+	Permissions Permissions `db:"-"`
 }
 
+// UserRole is the name of the role of a user, either one of the built-in
+// roles below or a custom Role.
 type UserRole string
 
 const (
@@ -83,7 +88,17 @@ func ValidateAndHashPassword(plainPwd string) (string, error) {
 	return pwd, nil
 }
 
-// GetUserRole is the single point of logic for mapping role string to UserRole
+// HasPermission returns true if the role of the user grants p. Users which
+// were not loaded by the ORM, like the implicit user of external
+// initiators, can only have built-in roles.
+func (u *User) HasPermission(p Permission) bool {
+	if u.Permissions != nil {
+		return u.Permissions.Has(p)
+	}
+	return BuiltInPermissions(u.Role).Has(p)
+}
+
+// GetUserRole is the single point of logic for mapping role string to a built-in UserRole
 func GetUserRole(role string) (UserRole, error) {
 	if role == string(UserRoleAdmin) {
 		return UserRoleAdmin, nil
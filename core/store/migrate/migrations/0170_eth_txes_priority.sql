@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN priority smallint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN priority;
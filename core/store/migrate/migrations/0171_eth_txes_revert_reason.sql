@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN revert_reason text;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN revert_reason;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    access_key text NOT NULL UNIQUE,
+    salt text NOT NULL,
+    hashed_secret text NOT NULL,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL,
+    scopes text NOT NULL,
+    expires_at timestamptz,
+    last_used timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_api_tokens_user_email ON api_tokens (user_email);
+
+-- +goose Down
+DROP TABLE api_tokens;
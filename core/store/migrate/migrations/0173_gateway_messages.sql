@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE gateway_messages (
+    sender bytea NOT NULL CHECK (octet_length(sender) = 20),
+    message_id text NOT NULL,
+    don_id text NOT NULL,
+    method text NOT NULL,
+    body_hash bytea NOT NULL CHECK (octet_length(body_hash) = 32),
+    expires_at timestamptz NOT NULL,
+    status text NOT NULL,
+    response bytea,
+    error_code integer NOT NULL,
+    error_message text NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (sender, message_id)
+);
+CREATE INDEX idx_gateway_messages_expires_at ON gateway_messages (expires_at);
+
+-- +goose Down
+DROP TABLE gateway_messages;
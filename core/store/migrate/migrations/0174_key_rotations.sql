@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE key_rotations (
+    id BIGSERIAL PRIMARY KEY,
+    key_type text NOT NULL,
+    old_key_id text NOT NULL,
+    new_key_id text NOT NULL,
+    status text NOT NULL,
+    created_at timestamptz NOT NULL,
+    removed_at timestamptz
+);
+CREATE UNIQUE INDEX idx_key_rotations_retiring_old_key ON key_rotations (key_type, old_key_id) WHERE status = 'retiring';
+
+-- +goose Down
+DROP TABLE key_rotations;
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE roles (
+    name text PRIMARY KEY,
+    description text NOT NULL,
+    permissions text NOT NULL,
+    built_in boolean NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- The permissions of built-in roles are defined by the node, not stored here
+INSERT INTO roles (name, description, permissions, built_in, created_at, updated_at) VALUES
+    ('admin', 'Full access, including users, roles, key import and export, and transfers', '', true, now(), now()),
+    ('edit', 'Manage jobs, bridges, external initiators, feeds managers and create keys', '', true, now(), now()),
+    ('run', 'Run jobs and replay blocks', '', true, now(), now()),
+    ('view', 'Read-only access', '', true, now(), now());
+
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE text USING role::text;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+ALTER TABLE users ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles (name);
+DROP TYPE user_roles;
+
+-- +goose Down
+UPDATE users SET role = 'view' WHERE role NOT IN ('admin', 'edit', 'run', 'view');
+ALTER TABLE users DROP CONSTRAINT users_role_fkey;
+CREATE TYPE user_roles AS ENUM ('admin', 'edit', 'run', 'view');
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE user_roles USING role::user_roles;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+DROP TABLE roles;
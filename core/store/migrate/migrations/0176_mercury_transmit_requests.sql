@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE mercury_transmit_requests (
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    payload_hash bytea NOT NULL CHECK (octet_length(payload_hash) = 32),
+    payload bytea NOT NULL,
+    config_digest bytea NOT NULL CHECK (octet_length(config_digest) = 32),
+    epoch bigint NOT NULL,
+    round bigint NOT NULL,
+    extra_hash bytea NOT NULL CHECK (octet_length(extra_hash) = 32),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (job_id, payload_hash)
+);
+CREATE INDEX idx_mercury_transmit_requests_job_id_created_at ON mercury_transmit_requests (job_id, created_at);
+
+-- +goose Down
+DROP TABLE mercury_transmit_requests;
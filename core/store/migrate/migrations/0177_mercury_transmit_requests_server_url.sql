@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE mercury_transmit_requests ADD COLUMN server_url text NOT NULL DEFAULT '';
+-- Pending transmissions so far were to the single server of their job.
+UPDATE mercury_transmit_requests r
+SET server_url = regexp_replace(o.plugin_config ->> 'serverURL', '^wss://', '')
+FROM jobs j
+JOIN ocr2_oracle_specs o ON o.id = j.ocr2_oracle_spec_id
+WHERE j.id = r.job_id AND o.plugin_config ->> 'serverURL' IS NOT NULL;
+ALTER TABLE mercury_transmit_requests ALTER COLUMN server_url DROP DEFAULT;
+ALTER TABLE mercury_transmit_requests DROP CONSTRAINT mercury_transmit_requests_pkey;
+ALTER TABLE mercury_transmit_requests ADD PRIMARY KEY (job_id, server_url, payload_hash);
+DROP INDEX idx_mercury_transmit_requests_job_id_created_at;
+CREATE INDEX idx_mercury_transmit_requests_job_id_server_url_created_at ON mercury_transmit_requests (job_id, server_url, created_at);
+
+-- +goose Down
+DROP INDEX idx_mercury_transmit_requests_job_id_server_url_created_at;
+-- Only the transmissions to the lexicographically smallest server URL of each job are kept.
+DELETE FROM mercury_transmit_requests r
+USING mercury_transmit_requests other
+WHERE r.job_id = other.job_id AND r.payload_hash = other.payload_hash AND r.server_url > other.server_url;
+ALTER TABLE mercury_transmit_requests DROP CONSTRAINT mercury_transmit_requests_pkey;
+ALTER TABLE mercury_transmit_requests ADD PRIMARY KEY (job_id, payload_hash);
+ALTER TABLE mercury_transmit_requests DROP COLUMN server_url;
+CREATE INDEX idx_mercury_transmit_requests_job_id_created_at ON mercury_transmit_requests (job_id, created_at);
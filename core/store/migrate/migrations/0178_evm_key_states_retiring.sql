@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE evm_key_states ADD COLUMN retiring boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE evm_key_states DROP COLUMN retiring;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE mercury_transmit_requests ADD COLUMN observations_timestamp timestamptz;
+-- The report is the first dynamic field of the payload, after its 7 head words and its length word, and the
+-- observationsTimestamp is the uint32 at the end of its second word, in every report schema.
+UPDATE mercury_transmit_requests
+SET observations_timestamp = CASE
+    WHEN octet_length(payload) >= 320 THEN to_timestamp(('x' || encode(substring(payload FROM 317 FOR 4), 'hex'))::bit(32)::bigint)
+    ELSE created_at
+END;
+ALTER TABLE mercury_transmit_requests ALTER COLUMN observations_timestamp SET NOT NULL;
+DROP INDEX idx_mercury_transmit_requests_job_id_server_url_created_at;
+CREATE INDEX idx_mercury_transmit_requests_job_id_server_url_observations_timestamp ON mercury_transmit_requests (job_id, server_url, observations_timestamp);
+
+-- +goose Down
+DROP INDEX idx_mercury_transmit_requests_job_id_server_url_observations_timestamp;
+ALTER TABLE mercury_transmit_requests DROP COLUMN observations_timestamp;
+CREATE INDEX idx_mercury_transmit_requests_job_id_server_url_created_at ON mercury_transmit_requests (job_id, server_url, created_at);
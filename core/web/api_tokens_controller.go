@@ -0,0 +1,137 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// APITokensController manages scoped API tokens. Users whose role grants the
+// users.manage permission manage the tokens of all users, and other users
+// their own.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// Index lists scoped API tokens, including revoked and expired ones.
+// Example:
+// "GET <application>/api_tokens"
+func (atc *APITokensController) Index(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	email := user.Email
+	if user.HasPermission(clsessions.PermissionUsersManage) {
+		email = ""
+	}
+
+	tokens, err := atc.App.SessionORM().ListAPITokens(email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// Create creates a scoped API token for the current user, and returns its
+// secret, which can not be retrieved again.
+// Example:
+// "POST <application>/api_tokens"
+func (atc *APITokensController) Create(c *gin.Context) {
+	var request clsessions.CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Name == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("name is required"))
+		return
+	}
+	scopes, err := clsessions.ParseScopes(request.Scopes)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	user, err := atc.App.SessionORM().FindUser(sessionUser.Email)
+	if err != nil {
+		atc.App.GetLogger().Errorf("failed to obtain current user record: %s", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to create API token"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		atc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	token, apiToken, err := atc.App.SessionORM().CreateAPIToken(&user, request.Name, scopes, request.TTL.Duration())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":      user.Email,
+		"name":      apiToken.Name,
+		"accessKey": apiToken.AccessKey,
+		"scopes":    apiToken.Scopes.String(),
+		"expiresAt": apiToken.ExpiresAt,
+	})
+	resource := presenters.NewAPITokenResource(apiToken)
+	resource.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, resource, "api_token", http.StatusCreated)
+}
+
+// Revoke revokes a scoped API token.
+// Example:
+// "DELETE <application>/api_tokens/:accessKey"
+func (atc *APITokensController) Revoke(c *gin.Context) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	accessKey := c.Param("accessKey")
+	apiToken, err := atc.App.SessionORM().FindAPIToken(accessKey)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !user.HasPermission(clsessions.PermissionUsersManage) && apiToken.UserEmail != user.Email) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	apiToken, err = atc.App.SessionORM().RevokeAPIToken(accessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusConflict, errors.New("API token is already revoked"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenRevoked, map[string]interface{}{
+		"user":      user.Email,
+		"owner":     apiToken.UserEmail,
+		"accessKey": apiToken.AccessKey,
+	})
+	jsonAPIResponse(c, presenters.NewAPITokenResource(apiToken), "api_token")
+}
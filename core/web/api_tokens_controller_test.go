@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAPITokensController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	create := func(password, scopes string) *http.Response {
+		req, err := json.Marshal(sessions.CreateAPITokenRequest{
+			Password: password,
+			Name:     "automation",
+			Scopes:   scopes,
+			TTL:      models.MustMakeDuration(time.Hour),
+		})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/api_tokens", bytes.NewBuffer(req))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	assert.Equal(t, http.StatusUnauthorized, create("wrong-password", "jobs:read").StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, create(cltest.Password, "jobs:admin").StatusCode)
+
+	resp := create(cltest.Password, "jobs:read,keys:none")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var token presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &token))
+	require.NotEmpty(t, token.Secret)
+	assert.Equal(t, cltest.APIEmailAdmin, token.UserEmail)
+	assert.Equal(t, "jobs:read,keys:none", token.Scopes)
+
+	request := func(path string) int {
+		req, err := http.NewRequest("GET", app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, token.ID)
+		req.Header.Set(webauth.APISecret, token.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusOK, request("/v2/jobs"))
+	assert.Equal(t, http.StatusForbidden, request("/v2/keys/eth"))
+	assert.Equal(t, http.StatusForbidden, request("/v2/bridge_types"))
+
+	resp, cleanup := client.Get("/v2/api_tokens")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+	assert.NotNil(t, tokens[0].LastUsed)
+
+	// Other users can neither see nor revoke the token.
+	viewUser := cltest.CreateUserWithRole(t, sessions.UserRoleView)
+	require.NoError(t, app.SessionORM().CreateUser(&viewUser))
+	viewClient := app.NewHTTPClient(viewUser.Email)
+	resp, cleanup = viewClient.Get("/v2/api_tokens")
+	t.Cleanup(cleanup)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	assert.Empty(t, tokens)
+	resp, cleanup = viewClient.Delete("/v2/api_tokens/" + token.ID)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, cleanup = client.Delete("/v2/api_tokens/" + token.ID)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, request("/v2/jobs"))
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"database/sql"
 	"net/http"
+	"strings"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -32,6 +33,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the scoped API token key in the session map
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -41,6 +45,7 @@ type Authenticator interface {
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(email string) (clsessions.User, error)
 	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	AuthorizedUserWithAPIToken(token *auth.Token) (clsessions.User, clsessions.APIToken, error)
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -70,7 +75,8 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateBySession
 
-// AuthenticateByToken authenticates a User by their API token.
+// AuthenticateByToken authenticates a User by their API token, or by one of
+// their scoped API tokens.
 //
 // Implements authMethod
 func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
@@ -79,26 +85,47 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
+	user, apiToken, err := authenticateToken(token, authr)
+	if err != nil {
+		return err
+	}
+
+	c.Set(SessionUserKey, user)
+	if apiToken != nil {
+		c.Set(SessionAPITokenKey, apiToken)
+	}
+
+	return nil
+}
+
+// authenticateToken returns the user of token, and the scoped API token if
+// token is not the user's own API token.
+func authenticateToken(token *auth.Token, authr Authenticator) (*clsessions.User, *clsessions.APIToken, error) {
+	if token.AccessKey == "" {
+		return nil, nil, auth.ErrorAuthFailed
+	}
+
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(token.AccessKey)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return auth.ErrorAuthFailed
+	if errors.Is(err, sql.ErrNoRows) {
+		user, apiToken, err := authr.AuthorizedUserWithAPIToken(token)
+		if err != nil {
+			return nil, nil, err
 		}
-		return err
+		return &user, &apiToken, nil
+	} else if err != nil {
+		return nil, nil, err
 	}
 
 	ok, err := clsessions.AuthenticateUserByToken(token, &user)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if !ok {
-		return auth.ErrorAuthFailed
+		return nil, nil, auth.ErrorAuthFailed
 	}
 
-	c.Set(SessionUserKey, &user)
-
-	return nil
+	return &user, nil, nil
 }
 
 var _ authMethod = AuthenticateByToken
@@ -186,46 +213,88 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
-// 'run'
-func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
+// GetAuthenticatedAPIToken extracts the scoped API token from the context, if
+// the request was authenticated by one.
+func GetAuthenticatedAPIToken(c *gin.Context) (*clsessions.APIToken, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
 	}
+
+	apiToken, ok := obj.(*clsessions.APIToken)
+
+	return apiToken, ok
 }
 
-// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
-// 'edit'
-func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
+// AuthorizeScopes is middleware which asserts that the scoped API token the
+// request was authenticated by, if any, grants access to the resource of the
+// requested path: read access for GET and HEAD requests, and write access
+// otherwise.
+func AuthorizeScopes(c *gin.Context) {
+	access := clsessions.AccessWrite
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		access = clsessions.AccessRead
+	}
+	if err := authorizeScope(c, access); err != nil {
+		c.Abort()
+		jsonAPIError(c, http.StatusForbidden, err)
+		return
+	}
+	c.Next()
+}
+
+func authorizeScope(c *gin.Context, access clsessions.Access) error {
+	apiToken, ok := GetAuthenticatedAPIToken(c)
+	if !ok {
+		return nil
+	}
+	resource, ok := pathResource(c.Request.URL.Path)
+	if !ok || apiToken.Scopes.Allows(resource, access) {
+		return nil
+	}
+	return errors.Errorf("API token is not permitted %s access to %s", access, resource)
+}
+
+// pathResource returns the resource of an API path, or false if it can be
+// requested with any scope. Paths of unknown resources require a "*" scope.
+func pathResource(path string) (clsessions.Resource, bool) {
+	segments := strings.Split(strings.TrimPrefix(path, "/v2/"), "/")
+	switch segments[0] {
+	case "ping":
+		return "", false
+	case "bridge_types":
+		return clsessions.ResourceBridges, true
+	case "external_initiators":
+		return clsessions.ResourceExternalInitiators, true
+	case "chains", "nodes", "replay_from_block":
+		return clsessions.ResourceChains, true
+	case "build_info", "config", "debug", "features", "log":
+		return clsessions.ResourceConfig, true
+	case "keys":
+		return clsessions.ResourceKeys, true
+	case "jobs":
+		if len(segments) > 2 && segments[2] == "runs" {
+			return clsessions.ResourceRuns, true
 		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
+		return clsessions.ResourceJobs, true
+	case "pipeline":
+		if len(segments) > 1 && segments[1] == "runs" {
+			return clsessions.ResourceRuns, true
 		}
-		handler(c)
+		return clsessions.ResourceJobs, true
+	case "transactions", "transfers", "tx_attempts":
+		return clsessions.ResourceTxs, true
+	case "api_tokens", "enroll_webauthn", "roles", "user", "users":
+		return clsessions.ResourceUsers, true
+	default:
+		return clsessions.ResourceAll, true
 	}
 }
 
-// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
-func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
+// RequiresPermission extracts the user object from the context, and asserts the role of the user grants
+// permission. Scoped API tokens must grant read access to the resource for read permissions, and write
+// access otherwise.
+func RequiresPermission(permission clsessions.Permission, handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -233,12 +302,17 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role != clsessions.UserRoleAdmin {
+		if !user.HasPermission(permission) {
 			c.Abort()
-			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
+			addForbiddenErrorHeaders(c, string(permission), string(user.Role), user.Email)
 			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
 			return
 		}
+		if err := authorizeScope(c, permission.Access()); err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusForbidden, err)
+			return
+		}
 		handler(c)
 	}
 }
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"database/sql"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -103,6 +104,77 @@ func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type apiTokenFinder struct {
+	sessions.ORM
+	user     sessions.User
+	apiToken sessions.APIToken
+}
+
+func (a apiTokenFinder) FindUserByAPIToken(token string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (a apiTokenFinder) AuthorizedUserWithAPIToken(token *auth.Token) (sessions.User, sessions.APIToken, error) {
+	if token.AccessKey != a.apiToken.AccessKey {
+		return sessions.User{}, sessions.APIToken{}, auth.ErrorAuthFailed
+	}
+	return a.user, a.apiToken, nil
+}
+
+func TestAuthenticateByToken_Scoped(t *testing.T) {
+	scopes, err := sessions.ParseScopes("jobs:read,bridges:write,keys:none")
+	require.NoError(t, err)
+	authr := apiTokenFinder{
+		user:     sessions.User{Email: cltest.APIEmailAdmin, Role: sessions.UserRoleEdit},
+		apiToken: sessions.APIToken{AccessKey: cltest.APIKey, Scopes: scopes},
+	}
+
+	router := gin.New()
+	v2 := router.Group("/v2", webauth.Authenticate(authr, webauth.AuthenticateByToken), webauth.AuthorizeScopes)
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	v2.GET("/jobs", ok)
+	v2.POST("/jobs", webauth.RequiresPermission(sessions.PermissionJobsCreate, ok))
+	v2.GET("/jobs/:ID/runs", ok)
+	v2.POST("/bridge_types", webauth.RequiresPermission(sessions.PermissionBridgesCreate, ok))
+	v2.GET("/keys/eth", ok)
+	v2.GET("/ping", ok)
+	v2.GET("/unknown", ok)
+
+	for _, test := range []struct {
+		verb, path string
+		want       int
+	}{
+		{"GET", "/v2/jobs", http.StatusOK},
+		{"POST", "/v2/jobs", http.StatusForbidden},
+		{"GET", "/v2/jobs/1/runs", http.StatusForbidden},
+		{"POST", "/v2/bridge_types", http.StatusOK},
+		{"GET", "/v2/keys/eth", http.StatusForbidden},
+		{"GET", "/v2/ping", http.StatusOK},
+		{"GET", "/v2/unknown", http.StatusForbidden},
+	} {
+		t.Run(test.verb+test.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(test.verb, test.path, nil)
+			req.Header.Set(webauth.APIKey, cltest.APIKey)
+			req.Header.Set(webauth.APISecret, cltest.APISecret)
+			router.ServeHTTP(w, req)
+			assert.Equal(t, test.want, w.Code)
+		})
+	}
+
+	// The role of the user still applies.
+	authr.user.Role = sessions.UserRoleView
+	router = gin.New()
+	router.POST("/v2/bridge_types", webauth.Authenticate(authr, webauth.AuthenticateByToken), webauth.RequiresPermission(sessions.PermissionBridgesCreate, ok))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v2/bridge_types", nil)
+	req.Header.Set(webauth.APIKey, cltest.APIKey)
+	req.Header.Set(webauth.APISecret, cltest.APISecret)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, string(sessions.PermissionBridgesCreate), w.Header().Get("forbidden-required-permission"))
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
@@ -195,6 +267,9 @@ var routesRolesMap = [...]routeRules{
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/api_tokens", true, true, true},
+	{"POST", "/v2/api_tokens", true, true, true},
+	{"DELETE", "/v2/api_tokens/MOCK", true, true, true},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -289,6 +364,11 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/nodes/evm/forwarders/track", false, false, true},
 	{"DELETE", "/v2/nodes/evm/forwarders/MOCK", false, false, true},
 	{"GET", "/v2/build_info", true, true, true},
+	{"GET", "/v2/roles", true, true, true},
+	{"GET", "/v2/roles/MOCK", true, true, true},
+	{"POST", "/v2/roles", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
 	{"GET", "/v2/ping", true, true, true},
 	{"POST", "/v2/jobs/MOCK/runs", false, true, true},
 }
@@ -374,10 +454,8 @@ func TestRBAC_Routemap_Edit(t *testing.T) {
 			if route.EditAllowed || route.editMinimalAllowed || route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		}()
 	}
@@ -422,10 +500,8 @@ func TestRBAC_Routemap_Run(t *testing.T) {
 			if route.editMinimalAllowed || route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		}()
 	}
@@ -471,10 +547,8 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 			if route.viewOnlyAllowed {
 				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
 				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
-			} else if !route.EditAllowed {
-				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			} else {
-				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 			}
 		})
 	}
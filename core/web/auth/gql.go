@@ -10,6 +10,7 @@ import (
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 )
 
@@ -17,18 +18,20 @@ type sessionUserKey struct{}
 type GQLSession struct {
 	SessionID string
 	User      *clsessions.User
+	// APIToken is set when the request was authenticated by a scoped API token.
+	APIToken *clsessions.APIToken
 }
 
-// AuthenticateGQL middleware checks the session cookie for a user and sets it
-// on the request context if it exists. It is the responsibility of each resolver
-// to validate whether it requires an authenticated user.
-//
-// We currently only support GQL authentication by session cookie.
+// AuthenticateGQL middleware checks the session cookie for a user, or else
+// the API token headers, and sets it on the request context if it exists. It
+// is the responsibility of each resolver to validate whether it requires an
+// authenticated user.
 func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		session := sessions.Default(c)
 		sessionID, ok := session.Get(SessionIDKey).(string)
 		if !ok {
+			authenticateGQLByToken(c, authenticator, lggr)
 			return
 		}
 
@@ -48,6 +51,26 @@ func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.Handle
 	}
 }
 
+func authenticateGQLByToken(c *gin.Context, authenticator Authenticator, lggr logger.Logger) {
+	token := &auth.Token{
+		AccessKey: c.GetHeader(APIKey),
+		Secret:    c.GetHeader(APISecret),
+	}
+	if token.AccessKey == "" {
+		return
+	}
+
+	user, apiToken, err := authenticateToken(token, authenticator)
+	if err != nil {
+		lggr.Warnw("Failed to authenticate API token", "err", err)
+		return
+	}
+
+	ctx := SetGQLAuthenticatedAPIToken(c.Request.Context(), *user, apiToken)
+
+	c.Request = c.Request.WithContext(ctx)
+}
+
 // SetGQLAuthenticatedSession sets the authenticated session in the context
 //
 // There shouldn't be a need to do this outside of testing
@@ -55,7 +78,19 @@ func SetGQLAuthenticatedSession(ctx context.Context, user clsessions.User, sessi
 	return context.WithValue(
 		ctx,
 		sessionUserKey{},
-		&GQLSession{sessionID, &user},
+		&GQLSession{SessionID: sessionID, User: &user},
+	)
+}
+
+// SetGQLAuthenticatedAPIToken sets the user authenticated by an API token in
+// the context. apiToken is nil for the user's own API token.
+//
+// There shouldn't be a need to do this outside of testing
+func SetGQLAuthenticatedAPIToken(ctx context.Context, user clsessions.User, apiToken *clsessions.APIToken) context.Context {
+	return context.WithValue(
+		ctx,
+		sessionUserKey{},
+		&GQLSession{User: &user, APIToken: apiToken},
 	)
 }
 
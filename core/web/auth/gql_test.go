@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -9,6 +10,8 @@ import (
 	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -70,6 +73,41 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 	r.ServeHTTP(w, req)
 }
 
+func Test_AuthenticateGQL_APIToken(t *testing.T) {
+	t.Parallel()
+
+	sessionORM := mocks.NewORM(t)
+	sessionStore := cookie.NewStore([]byte(cltest.SessionSecret))
+	user := clsessions.User{Email: cltest.APIEmailAdmin, Role: clsessions.UserRoleAdmin}
+	apiToken := clsessions.APIToken{AccessKey: cltest.APIKey, Scopes: clsessions.Scopes{clsessions.ResourceJobs: clsessions.AccessRead}}
+
+	r := gin.Default()
+	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
+	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+
+	called := false
+	r.GET("/", func(c *gin.Context) {
+		called = true
+		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+		require.True(t, ok)
+		assert.Equal(t, &user, session.User)
+		assert.Equal(t, &apiToken, session.APIToken)
+
+		c.String(http.StatusOK, "")
+	})
+
+	sessionORM.On("FindUserByAPIToken", cltest.APIKey).Return(clsessions.User{}, sql.ErrNoRows)
+	sessionORM.On("AuthorizedUserWithAPIToken", mock.Anything).Return(user, apiToken, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set(auth.APIKey, cltest.APIKey)
+	req.Header.Set(auth.APISecret, cltest.APISecret)
+
+	r.ServeHTTP(w, req)
+	assert.True(t, called)
+}
+
 func Test_GetAndSetGQLAuthenticatedSession(t *testing.T) {
 	t.Parallel()
 
@@ -24,10 +24,10 @@ func jsonAPIError(c *gin.Context, statusCode int, err error) {
 // addForbiddenErrorHeaders adds custom headers to the 403 (Forbidden) response
 // so that they can be parsed by the remote client for friendly/actionable error messages.
 //
-// The fields are specific because Forbidden error is caused by the user not having the correct role
-// for the required action
-func addForbiddenErrorHeaders(c *gin.Context, requiredRole string, providedRole string, providedEmail string) {
-	c.Header("forbidden-required-role", requiredRole)
+// The fields are specific because Forbidden error is caused by the role of the user not granting the
+// permission of the required action
+func addForbiddenErrorHeaders(c *gin.Context, requiredPermission string, providedRole string, providedEmail string) {
+	c.Header("forbidden-required-permission", requiredPermission)
 	c.Header("forbidden-provided-role", providedRole)
 	c.Header("forbidden-provided-email", providedEmail)
 }
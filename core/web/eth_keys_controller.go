@@ -82,7 +82,8 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, resources, "keys")
 }
 
-// Create adds a new account
+// Create adds a new account, or registers the key with the given
+// remoteAddress that is held by the remote signer.
 // Example:
 //
 //	"<application>/keys/eth"
@@ -106,7 +107,16 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	key, err := ethKeyStore.Create(chain.ID())
+	var key ethkey.KeyV2
+	if address := c.Query("remoteAddress"); address != "" {
+		if !common.IsHexAddress(address) {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid remoteAddress: %s, must be hex address", address))
+			return
+		}
+		key, err = ethKeyStore.AddRemote(common.HexToAddress(address), chain.ID())
+	} else {
+		key, err = ethKeyStore.Create(chain.ID())
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -227,3 +227,36 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 
 	cltest.AssertServerResponse(t, resp, http.StatusCreated)
 }
+
+func TestETHKeysController_CreateRemoteSuccess(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := evmclimocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("LINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	resp, cleanup := client.Post("/v2/keys/eth?remoteAddress=invalid", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	address := testutils.NewAddress()
+	resp, cleanup = client.Post("/v2/keys/eth?remoteAddress="+address.Hex(), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	key, err := app.KeyStore.Eth().Get(address.Hex())
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+}
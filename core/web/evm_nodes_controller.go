@@ -1,11 +1,21 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func NewEVMNodesController(app chainlink.Application) NodesController {
+	chainSet := app.GetChains().EVM
+	newResource := func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		if stats, ok := chainSet.NodeStats(status.ChainID, status.Name); ok {
+			r.Stats = presenters.NewEVMNodeStats(stats)
+		}
+		return r
+	}
 	return newNodesController[presenters.EVMNodeResource](
-		app.GetChains().EVM, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		chainSet, ErrEVMNotEnabled, newResource, app.GetAuditLogger())
 }
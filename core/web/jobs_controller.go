@@ -130,6 +130,40 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to simulate a pipeline run of a job
+// that is not saved.
+type SimulateJobRequest struct {
+	TOML string `json:"toml"`
+	// JobRun holds the $(jobRun) variables that the job's trigger would provide
+	JobRun map[string]interface{} `json:"jobRun"`
+}
+
+// Simulate validates a job spec and runs its pipeline once without side effects,
+// returning the result of every task.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, trace, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.JobRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(jb.ExternalJobID.String(), run, trace, jc.App.GetLogger()), "pipelineSimulation")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -377,6 +377,53 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate_WebhookSpec(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    parse    [type=jsonparse path="data" data="$(jobRun.requestBody)"]
+    multiply [type=multiply times=2]
+
+    parse -> multiply
+"""
+`
+	body, _ := json.Marshal(web.SimulateJobRequest{
+		TOML:   tomlStr,
+		JobRun: map[string]interface{}{"requestBody": `{"data": 21}`},
+	})
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	resource := presenters.PipelineSimulationResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	require.Len(t, resource.Outputs, 1)
+	assert.Equal(t, "42", *resource.Outputs[0])
+	require.Len(t, resource.Trace, 2)
+
+	byDotID := make(map[string]presenters.PipelineTaskTraceResource)
+	for _, tr := range resource.Trace {
+		byDotID[tr.DotID] = tr
+	}
+	assert.Empty(t, byDotID["parse"].Inputs)
+	assert.Equal(t, "21", *byDotID["parse"].Output)
+	require.Len(t, byDotID["multiply"].Inputs, 1)
+	assert.Equal(t, "21", *byDotID["multiply"].Inputs[0].Output)
+	assert.Nil(t, byDotID["multiply"].Error)
+
+	// Simulated jobs are not saved
+	_, count, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
@@ -0,0 +1,127 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyRotationsController rotates OCR2, EVM and P2P keys.
+type KeyRotationsController struct {
+	App chainlink.Application
+}
+
+// CreateKeyRotationRequest is a JSONAPI request for rotating a key.
+type CreateKeyRotationRequest struct {
+	KeyType string `json:"keyType"`
+	KeyID   string `json:"keyID"`
+}
+
+func (krc *KeyRotationsController) rotator() keyrotation.Rotator {
+	app := krc.App
+	return keyrotation.NewRotator(
+		keyrotation.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig()),
+		app.GetKeyStore(),
+		app.JobSpawner(),
+		forwarders.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig()),
+		keyrotation.NewChainSenderLookup(app.GetChains().EVM),
+		app.GetConfig(),
+		app.GetLogger(),
+	)
+}
+
+// Index lists key rotations.
+// Example:
+// "GET <application>/keys/rotations"
+func (krc *KeyRotationsController) Index(c *gin.Context) {
+	rotations, err := krc.rotator().Rotations(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyRotationResources(rotations), "key_rotations")
+}
+
+// References lists the job specs, forwarders and config which reference a
+// key, without rotating it.
+// Example:
+// "GET <application>/keys/rotations/references/:keyType/:keyID"
+func (krc *KeyRotationsController) References(c *gin.Context) {
+	keyType, err := keyrotation.ParseKeyType(c.Param("keyType"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	refs, err := krc.rotator().References(c.Request.Context(), keyType, c.Param("keyID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyReferenceResources(refs), "key_references")
+}
+
+// Create rotates a key, and returns the references of the old key.
+// Example:
+// "POST <application>/keys/rotations"
+func (krc *KeyRotationsController) Create(c *gin.Context) {
+	var request CreateKeyRotationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	keyType, err := keyrotation.ParseKeyType(request.KeyType)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, refs, err := krc.rotator().Rotate(c.Request.Context(), keyType, request.KeyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	krc.App.GetAuditLogger().Audit(audit.KeyRotated, map[string]interface{}{
+		"rotationID": rotation.ID,
+		"keyType":    rotation.KeyType,
+		"oldKeyID":   rotation.OldKeyID,
+		"newKeyID":   rotation.NewKeyID,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewKeyRotationResource(rotation, refs), "key_rotations", http.StatusCreated)
+}
+
+// Confirm deletes the old key of a retiring rotation.
+// Example:
+// "POST <application>/keys/rotations/:ID/confirm"
+func (krc *KeyRotationsController) Confirm(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rotation, err := krc.rotator().ConfirmRemoval(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("key rotation not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	krc.App.GetAuditLogger().Audit(audit.KeyRotationConfirmed, map[string]interface{}{
+		"rotationID": rotation.ID,
+		"keyType":    rotation.KeyType,
+		"keyID":      rotation.OldKeyID,
+	})
+	jsonAPIResponse(c, presenters.NewKeyRotationResource(rotation, nil), "key_rotations")
+}
@@ -0,0 +1,86 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyRotationsController_RotateOCR2Key(t *testing.T) {
+	client, ocr2KeyStore := setupKeyRotationsControllerTests(t)
+	oldKeyID := cltest.DefaultOCR2Key.ID()
+
+	response, cleanup := client.Get("/v2/keys/rotations/references/ocr2/" + oldKeyID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var refs []presenters.KeyReferenceResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &refs))
+	assert.Empty(t, refs)
+
+	body, err := json.Marshal(web.CreateKeyRotationRequest{KeyType: "ocr2", KeyID: oldKeyID})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/keys/rotations", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusCreated)
+	var rotation presenters.KeyRotationResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rotation))
+	assert.Equal(t, keyrotation.StatusRetiring, rotation.Status)
+	assert.Equal(t, oldKeyID, rotation.OldKeyID)
+	_, err = ocr2KeyStore.Get(rotation.NewKeyID)
+	require.NoError(t, err)
+
+	response, cleanup = client.Post("/v2/keys/rotations", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+
+	response, cleanup = client.Get("/v2/keys/rotations")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var rotations []presenters.KeyRotationResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rotations))
+	require.Len(t, rotations, 1)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/keys/rotations/%s/confirm", rotation.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rotation))
+	assert.Equal(t, keyrotation.StatusRemoved, rotation.Status)
+	_, err = ocr2KeyStore.Get(oldKeyID)
+	require.Error(t, err)
+}
+
+func TestKeyRotationsController_Errors(t *testing.T) {
+	client, _ := setupKeyRotationsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/keys/rotations/references/csa/abc")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/keys/rotations/42/confirm", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func setupKeyRotationsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.OCR2) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	require.NoError(t, app.KeyStore.OCR2().Add(cltest.DefaultOCR2Key))
+
+	return client, app.GetKeyStore().OCR2()
+}
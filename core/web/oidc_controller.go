@@ -0,0 +1,104 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	oidcStateKey = "oidc_state"
+	oidcNonceKey = "oidc_nonce"
+)
+
+// OIDCController logs users in with an OpenID Connect provider.
+type OIDCController struct {
+	App      chainlink.Application
+	provider *clsessions.OIDCProvider
+}
+
+func NewOIDCController(app chainlink.Application, provider *clsessions.OIDCProvider) *OIDCController {
+	return &OIDCController{app, provider}
+}
+
+// Login redirects the user to the provider's login page.
+func (oc *OIDCController) Login(c *gin.Context) {
+	state, nonce := utils.NewSecret(16), utils.NewSecret(16)
+	redirect, err := oc.provider.AuthCodeURL(c.Request.Context(), state, nonce)
+	if err != nil {
+		oc.App.GetLogger().Errorw("Failed to start OIDC login", "err", err)
+		jsonAPIError(c, http.StatusBadGateway, errors.New("identity provider is unavailable"))
+		return
+	}
+
+	session := sessions.Default(c)
+	session.Set(oidcStateKey, state)
+	session.Set(oidcNonceKey, nonce)
+	if err = session.Save(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, redirect)
+}
+
+// Callback completes the login once the provider redirects the user back, and
+// returns the new session ID in a cookie.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+
+	session := sessions.Default(c)
+	state, _ := session.Get(oidcStateKey).(string)
+	nonce, _ := session.Get(oidcNonceKey).(string)
+	session.Delete(oidcStateKey)
+	session.Delete(oidcNonceKey)
+
+	if errParam := c.Query("error"); errParam != "" {
+		oc.fail(c, errors.New(errParam+": "+c.Query("error_description")))
+		return
+	}
+	if state == "" || c.Query("state") != state {
+		oc.fail(c, errors.New("invalid state"))
+		return
+	}
+
+	identity, err := oc.provider.Exchange(c.Request.Context(), c.Query("code"), nonce)
+	if err != nil {
+		oc.fail(c, err)
+		return
+	}
+
+	sid, err := oc.App.SessionORM().CreateSSOSession(identity.Email, identity.Roles, clsessions.AuthProviderOIDC)
+	if errors.Is(err, clsessions.ErrPasswordUserSSO) || errors.Is(err, clsessions.ErrNoSSORole) {
+		oc.fail(c, err)
+		return
+	} else if err != nil {
+		oc.App.GetLogger().Errorw("Failed to create OIDC session", "email", identity.Email, "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to create session"))
+		return
+	}
+	if err = saveSessionID(session, sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, "/")
+}
+
+func (oc *OIDCController) fail(c *gin.Context, err error) {
+	if saveErr := sessions.Default(c).Save(); saveErr != nil {
+		oc.App.GetLogger().Errorw("Failed to clear OIDC login state", "err", saveErr)
+	}
+	oc.App.GetAuditLogger().Audit(audit.AuthLoginFailedSSO, map[string]interface{}{
+		"provider": clsessions.AuthProviderOIDC,
+		"issuer":   oc.provider.Issuer(),
+		"error":    err.Error(),
+	})
+	jsonAPIError(c, http.StatusUnauthorized, errors.New("OIDC login failed"))
+}
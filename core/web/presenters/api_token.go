@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// APITokenResource represents a scoped API token JSONAPI resource. The secret
+// is only set when the token is created.
+type APITokenResource struct {
+	JAID
+	Name      string     `json:"name"`
+	UserEmail string     `json:"userEmail"`
+	Scopes    string     `json:"scopes"`
+	Secret    string     `json:"secret,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	LastUsed  *time.Time `json:"lastUsed"`
+	RevokedAt *time.Time `json:"revokedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource, identified by its
+// access key.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:      NewJAID(t.AccessKey),
+		Name:      t.Name,
+		UserEmail: t.UserEmail,
+		Scopes:    t.Scopes.String(),
+		ExpiresAt: t.ExpiresAt,
+		LastUsed:  t.LastUsed,
+		RevokedAt: t.RevokedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources.
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewAPITokenResource(t))
+	}
+	return rs
+}
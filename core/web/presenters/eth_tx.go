@@ -14,18 +14,19 @@ import (
 // EthTxResource represents a Ethereum Transaction JSONAPI resource.
 type EthTxResource struct {
 	JAID
-	State      string          `json:"state"`
-	Data       hexutil.Bytes   `json:"data"`
-	From       *common.Address `json:"from"`
-	GasLimit   string          `json:"gasLimit"`
-	GasPrice   string          `json:"gasPrice"`
-	Hash       common.Hash     `json:"hash"`
-	Hex        string          `json:"rawHex"`
-	Nonce      string          `json:"nonce"`
-	SentAt     string          `json:"sentAt"`
-	To         *common.Address `json:"to"`
-	Value      string          `json:"value"`
-	EVMChainID utils.Big       `json:"evmChainID"`
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	GasLimit     string          `json:"gasLimit"`
+	GasPrice     string          `json:"gasPrice"`
+	Hash         common.Hash     `json:"hash"`
+	Hex          string          `json:"rawHex"`
+	Nonce        string          `json:"nonce"`
+	SentAt       string          `json:"sentAt"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	EVMChainID   utils.Big       `json:"evmChainID"`
+	RevertReason string          `json:"revertReason"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -41,12 +42,13 @@ func (EthTxResource) GetName() string {
 func NewEthTxResource(tx txmgr.EvmTx) EthTxResource {
 	v := assets.Eth(tx.Value)
 	r := EthTxResource{
-		Data:     hexutil.Bytes(tx.EncodedPayload),
-		From:     &tx.FromAddress,
-		GasLimit: strconv.FormatUint(uint64(tx.FeeLimit), 10),
-		State:    string(tx.State),
-		To:       &tx.ToAddress,
-		Value:    v.String(),
+		Data:         hexutil.Bytes(tx.EncodedPayload),
+		From:         &tx.FromAddress,
+		GasLimit:     strconv.FormatUint(uint64(tx.FeeLimit), 10),
+		State:        string(tx.State),
+		To:           &tx.ToAddress,
+		Value:        v.String(),
+		RevertReason: tx.RevertReason.ValueOrZero(),
 	}
 
 	if tx.ChainID != nil {
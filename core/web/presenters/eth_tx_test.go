@@ -9,6 +9,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -51,7 +52,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "0"
+			"evmChainID": "0",
+			"revertReason": ""
 		  }
 		}
 	  }
@@ -67,6 +69,7 @@ func TestEthTxResource(t *testing.T) {
 	)
 
 	tx.Sequence = &nonce
+	tx.RevertReason = null.StringFrom("InsufficientBalance()")
 	txa := txmgr.EvmTxAttempt{
 		Tx:                      tx,
 		Hash:                    hash,
@@ -97,7 +100,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "300",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "0"
+			"evmChainID": "0",
+			"revertReason": "InsufficientBalance()"
 		  }
 		}
 	  }
@@ -1,6 +1,10 @@
 package presenters
 
-import "github.com/smartcontractkit/chainlink-relay/pkg/types"
+import (
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
 
 // EVMChainResource is an EVM chain JSONAPI resource.
 type EVMChainResource struct {
@@ -24,6 +28,25 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	// Stats holds the rolling RPC performance of the node. It is only set
+	// for primary nodes that are running.
+	Stats *EVMNodeStats `json:"stats,omitempty"`
+}
+
+// EVMNodeStats is the rolling RPC performance of an EVM node.
+type EVMNodeStats struct {
+	Latency   string  `json:"latency"`
+	ErrorRate float64 `json:"errorRate"`
+	Score     string  `json:"score"`
+}
+
+// NewEVMNodeStats returns a new EVMNodeStats for stats.
+func NewEVMNodeStats(stats evmclient.NodeStats) *EVMNodeStats {
+	return &EVMNodeStats{
+		Latency:   stats.Latency.String(),
+		ErrorRate: stats.ErrorRate,
+		Score:     stats.Score.String(),
+	}
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -33,7 +56,7 @@ func (r EVMNodeResource) GetName() string {
 
 // NewEVMNodeResource returns a new EVMNodeResource for node.
 func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
-	return EVMNodeResource{NodeResource{
+	return EVMNodeResource{NodeResource: NodeResource{
 		JAID:    NewJAID(node.Name),
 		ChainID: node.ChainID,
 		Name:    node.Name,
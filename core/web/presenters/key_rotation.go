@@ -0,0 +1,70 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+)
+
+// KeyRotationResource represents a key rotation JSONAPI resource. References
+// are only set when the rotation is created.
+type KeyRotationResource struct {
+	JAID
+	KeyType    keyrotation.KeyType     `json:"keyType"`
+	OldKeyID   string                  `json:"oldKeyID"`
+	NewKeyID   string                  `json:"newKeyID"`
+	Status     keyrotation.Status      `json:"status"`
+	References []keyrotation.Reference `json:"references,omitempty"`
+	CreatedAt  time.Time               `json:"createdAt"`
+	RemovedAt  *time.Time              `json:"removedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyRotationResource) GetName() string {
+	return "key_rotations"
+}
+
+// NewKeyRotationResource constructs a new KeyRotationResource.
+func NewKeyRotationResource(r keyrotation.Rotation, refs []keyrotation.Reference) *KeyRotationResource {
+	return &KeyRotationResource{
+		JAID:       NewJAIDInt64(r.ID),
+		KeyType:    r.KeyType,
+		OldKeyID:   r.OldKeyID,
+		NewKeyID:   r.NewKeyID,
+		Status:     r.Status,
+		References: refs,
+		CreatedAt:  r.CreatedAt,
+		RemovedAt:  r.RemovedAt,
+	}
+}
+
+// NewKeyRotationResources constructs a slice of KeyRotationResources.
+func NewKeyRotationResources(rotations []keyrotation.Rotation) []KeyRotationResource {
+	rs := []KeyRotationResource{}
+	for _, r := range rotations {
+		rs = append(rs, *NewKeyRotationResource(r, nil))
+	}
+	return rs
+}
+
+// KeyReferenceResource represents a use of a key, identified by its position
+// in the list of references.
+type KeyReferenceResource struct {
+	JAID
+	keyrotation.Reference
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyReferenceResource) GetName() string {
+	return "key_references"
+}
+
+// NewKeyReferenceResources constructs a slice of KeyReferenceResources.
+func NewKeyReferenceResources(refs []keyrotation.Reference) []KeyReferenceResource {
+	rs := []KeyReferenceResource{}
+	for i, ref := range refs {
+		rs = append(rs, KeyReferenceResource{JAID: NewJAID(strconv.Itoa(i + 1)), Reference: ref})
+	}
+	return rs
+}
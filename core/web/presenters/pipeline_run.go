@@ -103,3 +103,106 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineSimulationResource represents the result of a simulated pipeline run.
+type PipelineSimulationResource struct {
+	JAID
+	Outputs     []*string                   `json:"outputs"`
+	AllErrors   []*string                   `json:"allErrors"`
+	FatalErrors []*string                   `json:"fatalErrors"`
+	Inputs      pipeline.JSONSerializable   `json:"inputs"`
+	Trace       []PipelineTaskTraceResource `json:"trace"`
+	CreatedAt   time.Time                   `json:"createdAt"`
+	FinishedAt  null.Time                   `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulation"
+}
+
+// NewPipelineSimulationResource constructs a resource for a simulated run of
+// the job with the given external job ID.
+func NewPipelineSimulationResource(externalJobID string, pr pipeline.Run, trace []pipeline.TaskRunTrace, lggr logger.Logger) PipelineSimulationResource {
+	lggr = lggr.Named("PipelineSimulationResource")
+	trs := make([]PipelineTaskTraceResource, len(trace))
+	for i := range trace {
+		trs[i] = NewPipelineTaskTraceResource(trace[i])
+	}
+
+	outputs, err := pr.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", pr.Outputs)
+	}
+
+	return PipelineSimulationResource{
+		JAID:        NewJAID(externalJobID),
+		Outputs:     outputs,
+		AllErrors:   pr.StringAllErrors(),
+		FatalErrors: pr.StringFatalErrors(),
+		Inputs:      pr.Inputs,
+		Trace:       trs,
+		CreatedAt:   pr.CreatedAt,
+		FinishedAt:  pr.FinishedAt,
+	}
+}
+
+// PipelineTaskTraceResource describes a single task of a simulated run.
+type PipelineTaskTraceResource struct {
+	DotID      string               `json:"dotId"`
+	Type       pipeline.TaskType    `json:"type"`
+	Index      int32                `json:"index"`
+	Inputs     []PipelineTaskResult `json:"inputs"`
+	Output     *string              `json:"output"`
+	Error      *string              `json:"error"`
+	Attempts   uint                 `json:"attempts"`
+	CreatedAt  time.Time            `json:"createdAt"`
+	FinishedAt null.Time            `json:"finishedAt"`
+	Duration   string               `json:"duration"`
+}
+
+// PipelineTaskResult is the output or error of a task, as passed to the tasks
+// that depend on it.
+type PipelineTaskResult struct {
+	Output *string `json:"output"`
+	Error  *string `json:"error"`
+}
+
+func NewPipelineTaskTraceResource(tr pipeline.TaskRunTrace) PipelineTaskTraceResource {
+	inputs := make([]PipelineTaskResult, len(tr.Inputs))
+	for i, input := range tr.Inputs {
+		inputs[i] = PipelineTaskResult{
+			Output: resultOutputString(input),
+			Error:  resultErrorString(input),
+		}
+	}
+	return PipelineTaskTraceResource{
+		DotID:      tr.DotID,
+		Type:       tr.Type,
+		Index:      tr.Index,
+		Inputs:     inputs,
+		Output:     resultOutputString(tr.Result),
+		Error:      resultErrorString(tr.Result),
+		Attempts:   tr.Attempts,
+		CreatedAt:  tr.CreatedAt,
+		FinishedAt: tr.FinishedAt,
+		Duration:   tr.Duration().String(),
+	}
+}
+
+func resultOutputString(r pipeline.Result) *string {
+	if r.Error != nil {
+		return nil
+	}
+	outputBytes, _ := pipeline.JSONSerializable{Val: r.Value, Valid: r.Value != nil}.MarshalJSON()
+	outputStr := string(outputBytes)
+	return &outputStr
+}
+
+func resultErrorString(r pipeline.Result) *string {
+	if r.Error == nil {
+		return nil
+	}
+	errString := r.Error.Error()
+	return &errString
+}
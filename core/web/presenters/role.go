@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// RoleResource represents a Role JSONAPI resource, identified by its name.
+type RoleResource struct {
+	JAID
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	BuiltIn     bool      `json:"builtIn"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RoleResource) GetName() string {
+	return "roles"
+}
+
+// NewRoleResource constructs a new RoleResource.
+func NewRoleResource(role sessions.Role) *RoleResource {
+	perms := []string{}
+	for _, p := range role.Permissions {
+		perms = append(perms, string(p))
+	}
+	return &RoleResource{
+		JAID:        NewJAID(string(role.Name)),
+		Description: role.Description,
+		Permissions: perms,
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// NewRoleResources constructs a slice of RoleResources.
+func NewRoleResources(roles []sessions.Role) []RoleResource {
+	rs := []RoleResource{}
+	for _, role := range roles {
+		rs = append(rs, *NewRoleResource(role))
+	}
+	return rs
+}
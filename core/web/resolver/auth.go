@@ -8,50 +8,37 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
-// Authenticates the user from the session cookie, presence of user inherently provides 'view' access.
-func authenticateUser(ctx context.Context) error {
-	if _, ok := auth.GetGQLAuthenticatedSession(ctx); !ok {
-		return unauthorizedError{}
-	}
-	return nil
-}
-
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie or API token, presence of user inherently provides 'view' access.
+// API tokens must be scoped to read resource.
+func authenticateUser(ctx context.Context, resource sessions.Resource) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
+	return authorizeScope(session, resource, sessions.AccessRead)
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
+// Authenticates the user from the session cookie or API token and asserts that the role of the user
+// grants permission. API tokens must be scoped to read resource for read permissions, and to write
+// resource otherwise.
+func authenticateUserHasPermission(ctx context.Context, permission sessions.Permission, resource sessions.Resource) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
+	if !session.User.HasPermission(permission) {
+		return RoleNotPermittedErr{session.User.Role, permission}
 	}
-	return nil
+	return authorizeScope(session, resource, permission.Access())
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
+// authorizeScope asserts that the scoped API token of the session, if any,
+// grants access to resource.
+func authorizeScope(session *auth.GQLSession, resource sessions.Resource, access sessions.Access) error {
+	if session.APIToken == nil || session.APIToken.Scopes.Allows(resource, access) {
+		return nil
 	}
-	return nil
+	return ScopeNotPermittedErr{resource, access}
 }
 
 type unauthorizedError struct{}
@@ -67,9 +54,19 @@ func (e unauthorizedError) Extensions() map[string]interface{} {
 }
 
 type RoleNotPermittedErr struct {
-	Role sessions.UserRole
+	Role       sessions.UserRole
+	Permission sessions.Permission
 }
 
 func (e RoleNotPermittedErr) Error() string {
-	return fmt.Sprintf("Not permitted with current role: %s", e.Role)
+	return fmt.Sprintf("Not permitted with current role: %s, requires permission %s", e.Role, e.Permission)
+}
+
+type ScopeNotPermittedErr struct {
+	Resource sessions.Resource
+	Access   sessions.Access
+}
+
+func (e ScopeNotPermittedErr) Error() string {
+	return fmt.Sprintf("Not permitted with current API token scopes: requires %s:%s", e.Resource, e.Access)
 }
@@ -0,0 +1,87 @@
+package resolver
+
+import (
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func Test_APITokenScopes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetBridges {
+				bridges {
+					metadata {
+						total
+					}
+				}
+			}`
+		mutation = `
+			mutation DeleteBridge {
+				deleteBridge(id: "bridge1") {
+					__typename
+				}
+			}`
+		user = clsessions.User{Email: "gqltester@chain.link", Role: clsessions.UserRoleAdmin}
+	)
+
+	withScopes := func(scopes clsessions.Scopes) func(*gqlTestFramework) {
+		return func(f *gqlTestFramework) {
+			f.Ctx = auth.SetGQLAuthenticatedAPIToken(f.Ctx, user, &clsessions.APIToken{Scopes: scopes})
+		}
+	}
+
+	testCases := []GQLTestCase{
+		{
+			name: "read scope",
+			before: func(f *gqlTestFramework) {
+				withScopes(clsessions.Scopes{clsessions.ResourceBridges: clsessions.AccessRead})(f)
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("BridgeTypes", PageDefaultOffset, PageDefaultLimit).Return([]bridges.BridgeType{}, 0, nil)
+			},
+			query:  query,
+			result: `{"bridges": {"metadata": {"total": 0}}}`,
+		},
+		{
+			name:   "no scope",
+			before: withScopes(clsessions.Scopes{clsessions.ResourceJobs: clsessions.AccessWrite}),
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{{
+				ResolverError: ScopeNotPermittedErr{clsessions.ResourceBridges, clsessions.AccessRead},
+				Path:          []interface{}{"bridges"},
+				Message:       "Not permitted with current API token scopes: requires bridges:read",
+			}},
+		},
+		{
+			name:   "none scope",
+			before: withScopes(clsessions.Scopes{clsessions.ResourceBridges: clsessions.AccessNone, clsessions.ResourceAll: clsessions.AccessWrite}),
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{{
+				ResolverError: ScopeNotPermittedErr{clsessions.ResourceBridges, clsessions.AccessRead},
+				Path:          []interface{}{"bridges"},
+				Message:       "Not permitted with current API token scopes: requires bridges:read",
+			}},
+		},
+		{
+			name:   "read scope on mutation",
+			before: withScopes(clsessions.Scopes{clsessions.ResourceBridges: clsessions.AccessRead}),
+			query:  mutation,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{{
+				ResolverError: ScopeNotPermittedErr{clsessions.ResourceBridges, clsessions.AccessWrite},
+				Path:          []interface{}{"deleteBridge"},
+				Message:       "Not permitted with current API token scopes: requires bridges:write",
+			}},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
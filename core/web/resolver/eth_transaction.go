@@ -78,6 +78,12 @@ func (r *EthTransactionResolver) Nonce() *string {
 	return &value
 }
 
+// RevertReason resolves the decoded reason the transaction reverted on-chain,
+// if it did.
+func (r *EthTransactionResolver) RevertReason() *string {
+	return r.tx.RevertReason.Ptr()
+}
+
 func (r *EthTransactionResolver) Hash(ctx context.Context) string {
 	attempts, err := r.Attempts(ctx)
 	if err != nil || len(attempts) == 0 {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/v2"
@@ -39,6 +40,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					hash
 					hex
 					sentAt
+					revertReason
 					attempts {
 						hash
 					}
@@ -105,6 +107,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 						"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81",
 						"hex": "0x736f6d657468696e67",
 						"sentAt": null,
+						"revertReason": null,
 						"evmChainID": "22",
 						"attempts": [{
 							"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81"
@@ -129,6 +132,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					Value:          big.Int(assets.NewEthValue(100)),
 					ChainID:        big.NewInt(22),
 					Sequence:       &nonce,
+					RevertReason:   null.StringFrom("InsufficientBalance()"),
 				}, nil)
 				f.Mocks.txmStore.On("FindEthTxAttemptConfirmedByEthTxIDs", []int64{1}).Return([]txmgr.EvmTxAttempt{
 					{
@@ -162,6 +166,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 						"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81",
 						"hex": "0x736f6d657468696e67",
 						"sentAt": "2",
+						"revertReason": "InsufficientBalance()",
 						"evmChainID": "22",
 						"attempts": [{
 							"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81"
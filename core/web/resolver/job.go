@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
@@ -224,6 +225,69 @@ func (r *CreateJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
 
+// -- SimulateJob Mutation --
+
+type SimulateJobPayloadResolver struct {
+	run       pipeline.Run
+	trace     []pipeline.TaskRunTrace
+	inputErrs map[string]string
+}
+
+func NewSimulateJobPayload(run pipeline.Run, trace []pipeline.TaskRunTrace, inputErrs map[string]string) *SimulateJobPayloadResolver {
+	return &SimulateJobPayloadResolver{run: run, trace: trace, inputErrs: inputErrs}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewSimulateJobSuccess(r.run, r.trace), true
+}
+
+func (r *SimulateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type SimulateJobSuccessResolver struct {
+	run   pipeline.Run
+	trace []pipeline.TaskRunTrace
+}
+
+func NewSimulateJobSuccess(run pipeline.Run, trace []pipeline.TaskRunTrace) *SimulateJobSuccessResolver {
+	return &SimulateJobSuccessResolver{run: run, trace: trace}
+}
+
+// Outputs resolves the final outputs of the simulated run.
+func (r *SimulateJobSuccessResolver) Outputs() ([]*string, error) {
+	return r.run.StringOutputs()
+}
+
+// AllErrors resolves the errors of every task of the simulated run.
+func (r *SimulateJobSuccessResolver) AllErrors() []*string {
+	return r.run.StringAllErrors()
+}
+
+// FatalErrors resolves the errors of the final tasks of the simulated run.
+func (r *SimulateJobSuccessResolver) FatalErrors() []*string {
+	return r.run.StringFatalErrors()
+}
+
+// Trace resolves the execution of every task of the simulated run.
+func (r *SimulateJobSuccessResolver) Trace() []*TaskRunTraceResolver {
+	return NewTaskRunTraces(r.trace)
+}
+
 // -- DeleteJob Mutation --
 
 type DeleteJobPayloadResolver struct {
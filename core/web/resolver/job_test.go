@@ -362,6 +362,170 @@ func TestResolver_CreateJob(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($input: SimulateJobInput!) {
+			simulateJob(input: $input) {
+				... on SimulateJobSuccess {
+					outputs
+					allErrors
+					fatalErrors
+					trace {
+						dotID
+						type
+						index
+						inputs {
+							output
+							error
+						}
+						output
+						error
+						attempts
+						createdAt
+						finishedAt
+						duration
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	jobRun := map[string]interface{}{"requestBody": `{"data": 21}`}
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":   testspecs.DirectRequestSpec,
+			"jobRun": jobRun,
+		},
+	}
+	invalid := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML": "some wrong value",
+		},
+	}
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	assert.NoError(t, err)
+
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := pipeline.Run{
+		Outputs:     pipeline.JSONSerializable{Val: []interface{}{"42"}, Valid: true},
+		AllErrors:   pipeline.RunErrors{null.String{}, null.String{}},
+		FatalErrors: pipeline.RunErrors{null.String{}},
+	}
+	trace := []pipeline.TaskRunTrace{
+		{
+			DotID:      "parse",
+			Type:       pipeline.TaskTypeJSONParse,
+			Index:      0,
+			Result:     pipeline.Result{Value: "21"},
+			CreatedAt:  createdAt,
+			FinishedAt: null.TimeFrom(createdAt.Add(time.Second)),
+		},
+		{
+			DotID:     "multiply",
+			Type:      pipeline.TaskTypeMultiply,
+			Index:     0,
+			Inputs:    []pipeline.Result{{Value: "21"}},
+			Result:    pipeline.Result{Error: errors.New("boom")},
+			Attempts:  2,
+			CreatedAt: createdAt,
+		},
+	}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "simulateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("SimulateJobV2", mock.Anything, jb, jobRun).Return(run, trace, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"outputs": ["42"],
+						"allErrors": [null, null],
+						"fatalErrors": [null],
+						"trace": [{
+							"dotID": "parse",
+							"type": "jsonparse",
+							"index": 0,
+							"inputs": [],
+							"output": "\"21\"",
+							"error": null,
+							"attempts": 0,
+							"createdAt": "2021-01-01T00:00:00Z",
+							"finishedAt": "2021-01-01T00:00:01Z",
+							"duration": "1s"
+						}, {
+							"dotID": "multiply",
+							"type": "multiply",
+							"index": 0,
+							"inputs": [{
+								"output": "\"21\"",
+								"error": null
+							}],
+							"output": null,
+							"error": "boom",
+							"attempts": 2,
+							"createdAt": "2021-01-01T00:00:00Z",
+							"finishedAt": null,
+							"duration": "0s"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid TOML error",
+			authenticated: true,
+			query:         mutation,
+			variables:     invalid,
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "failed to parse TOML: (1, 6): was expecting token =, but got \"wrong\" instead",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error when simulating the job",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("SimulateJobV2", mock.Anything, jb, jobRun).Return(pipeline.Run{}, nil, gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"simulateJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
@@ -35,13 +37,16 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/gqlscalar"
 )
 
 type Resolver struct {
@@ -57,7 +62,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesCreate, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -107,7 +112,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -131,7 +136,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysDelete, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -172,7 +177,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagers, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -252,7 +257,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagers, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -306,7 +311,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagers, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -385,7 +390,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagers, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -438,7 +443,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesUpdate, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -506,7 +511,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagers, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -551,7 +556,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -571,7 +576,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysDelete, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -590,7 +595,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesDelete, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -626,7 +631,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -649,7 +654,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysDelete, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -675,7 +680,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -697,7 +702,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysDelete, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -722,7 +727,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsProposals, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -761,7 +766,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsProposals, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -796,7 +801,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsProposals, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -832,7 +837,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsProposals, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -868,7 +873,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	Input UpdatePasswordInput
 }) (*UpdatePasswordPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.ResourceUsers); err != nil {
 		return nil, err
 	}
 
@@ -906,7 +911,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigUpdate, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -924,7 +929,7 @@ func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*CreateAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.ResourceUsers); err != nil {
 		return nil, err
 	}
 
@@ -957,7 +962,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*DeleteAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, sessions.ResourceUsers); err != nil {
 		return nil, err
 	}
 
@@ -995,75 +1000,114 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsCreate, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	jb, inputErrs, err := r.validateJobSpec(args.Input.TOML)
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
 	if err != nil {
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = r.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		return nil, err
+	}
+
+	jbj, _ := json.Marshal(jb)
+	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+
+	return NewCreateJobPayload(r.App, &jb, nil), nil
+}
+
+// validateJobSpec parses and validates a TOML job spec. Problems with the spec
+// itself are returned as input errors.
+func (r *Resolver) validateJobSpec(tomlString string) (jb job.Job, inputErrs map[string]string, err error) {
+	jbt, err := job.ValidateSpec(tomlString)
+	if err != nil {
+		return jb, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
-		}), nil
+		}, nil
 	}
 
-	var jb job.Job
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(r.App.GetChains().EVM, args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(r.App.GetChains().EVM, tomlString)
 		if !config.FeatureOffchainReporting() {
-			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(r.App.GetConfig(), args.Input.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(r.App.GetConfig(), tomlString)
 		if !config.FeatureOffchainReporting2() {
-			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlString, r.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.BlockHeaderFeeder:
-		jb, err = blockheaderfeeder.ValidatedSpec(args.Input.TOML)
+		jb, err = blockheaderfeeder.ValidatedSpec(tomlString)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
 	default:
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
-		}), nil
+		}, nil
 	}
-	if err != nil {
+	return jb, nil, err
+}
+
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	Input struct {
+		TOML   string
+		JobRun *gqlscalar.Map
+	}
+}) (*SimulateJobPayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsSimulate, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	err = r.App.AddJobV2(ctx, &jb)
+	jb, inputErrs, err := r.validateJobSpec(args.Input.TOML)
+	if inputErrs != nil {
+		return NewSimulateJobPayload(pipeline.Run{}, nil, inputErrs), nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	jbj, _ := json.Marshal(jb)
-	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	var jobRun map[string]interface{}
+	if args.Input.JobRun != nil {
+		jobRun = *args.Input.JobRun
+	}
+	run, trace, err := r.App.SimulateJobV2(ctx, jb, jobRun)
+	if err != nil {
+		return nil, err
+	}
 
-	return NewCreateJobPayload(r.App, &jb, nil), nil
+	return NewSimulateJobPayload(run, trace, nil), nil
 }
 
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsDelete, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1097,7 +1141,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsDismissError, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1131,7 +1175,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRunsCreate, sessions.ResourceRuns); err != nil {
 		return nil, err
 	}
 
@@ -1161,7 +1205,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigUpdate, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -1187,7 +1231,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -1215,7 +1259,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysDelete, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -1233,3 +1277,108 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+type createRoleInput struct {
+	Name        string
+	Description string
+	Permissions []string
+}
+
+// CreateRole resolves a create custom role mutation
+func (r *Resolver) CreateRole(ctx context.Context, args struct {
+	Input createRoleInput
+}) (*CreateRolePayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRolesManage, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	if err := sessions.ValidateRoleName(args.Input.Name); err != nil {
+		return NewCreateRolePayload(nil, map[string]string{
+			"input/name": err.Error(),
+		}), nil
+	}
+	perms, err := sessions.ParsePermissions(strings.Join(args.Input.Permissions, ","))
+	if err != nil {
+		return NewCreateRolePayload(nil, map[string]string{
+			"input/permissions": err.Error(),
+		}), nil
+	}
+
+	role := sessions.Role{Name: sessions.UserRole(args.Input.Name), Description: args.Input.Description, Permissions: perms}
+	if err = r.App.SessionORM().CreateCustomRole(&role); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return NewCreateRolePayload(nil, map[string]string{
+				"input/name": fmt.Sprintf("role %s already exists", args.Input.Name),
+			}), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]interface{}{"name": role.Name, "permissions": role.Permissions.String()})
+	return NewCreateRolePayload(&role, nil), nil
+}
+
+type updateRoleInput struct {
+	Description string
+	Permissions []string
+}
+
+// UpdateRole resolves an update custom role mutation
+func (r *Resolver) UpdateRole(ctx context.Context, args struct {
+	Name  string
+	Input updateRoleInput
+}) (*UpdateRolePayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRolesManage, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	perms, err := sessions.ParsePermissions(strings.Join(args.Input.Permissions, ","))
+	if err != nil {
+		return NewUpdateRolePayload(nil, map[string]string{
+			"input/permissions": err.Error(),
+		}, nil), nil
+	}
+
+	role := sessions.Role{Name: sessions.UserRole(args.Name), Description: args.Input.Description, Permissions: perms}
+	err = r.App.SessionORM().UpdateCustomRole(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewUpdateRolePayload(nil, nil, err), nil
+	} else if errors.Is(err, sessions.ErrBuiltInRole) {
+		return NewUpdateRolePayload(nil, map[string]string{
+			"name": err.Error(),
+		}, nil), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]interface{}{"name": role.Name, "permissions": role.Permissions.String()})
+	return NewUpdateRolePayload(&role, nil, nil), nil
+}
+
+// DeleteRole resolves a delete custom role mutation
+func (r *Resolver) DeleteRole(ctx context.Context, args struct {
+	Name string
+}) (*DeleteRolePayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRolesManage, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	role, err := r.App.SessionORM().FindRole(args.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewDeleteRolePayload(nil, err), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = r.App.SessionORM().DeleteCustomRole(args.Name)
+	var inUse sessions.RoleInUseError
+	if errors.Is(err, sessions.ErrBuiltInRole) || errors.As(err, &inUse) {
+		return NewDeleteRolePayload(&role, err), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]interface{}{"name": role.Name})
+	return NewDeleteRolePayload(&role, nil), nil
+}
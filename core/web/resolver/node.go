@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -17,6 +18,8 @@ import (
 type NodeResolver struct {
 	node   v2.Node
 	status types.NodeStatus
+	// nodeStats looks up the rolling RPC stats of a running node.
+	nodeStats func(status types.NodeStatus) *evmclient.NodeStats
 }
 
 func NewNode(status types.NodeStatus) (nr *NodeResolver, warn error) {
@@ -81,6 +84,19 @@ func (r *NodeResolver) SendOnly() bool {
 	return orZero(r.node.SendOnly)
 }
 
+// Stats resolves the node's rolling RPC performance. It is only set for
+// primary nodes that are running.
+func (r *NodeResolver) Stats() *NodeStatsResolver {
+	if r.nodeStats == nil {
+		return nil
+	}
+	stats := r.nodeStats(r.status)
+	if stats == nil {
+		return nil
+	}
+	return &NodeStatsResolver{stats: *stats}
+}
+
 // Chain resolves the node's chain object field.
 func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	chain, err := loader.GetChainByID(ctx, r.status.ChainID)
@@ -91,6 +107,26 @@ func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	return NewChain(*chain), nil
 }
 
+// NodeStatsResolver resolves the NodeStats type.
+type NodeStatsResolver struct {
+	stats evmclient.NodeStats
+}
+
+// Latency resolves the moving average of RPC call durations.
+func (r *NodeStatsResolver) Latency() string {
+	return r.stats.Latency.String()
+}
+
+// ErrorRate resolves the moving average of the fraction of failed RPC calls.
+func (r *NodeStatsResolver) ErrorRate() float64 {
+	return r.stats.ErrorRate
+}
+
+// Score resolves the score used by the LatencyWeighted selection mode.
+func (r *NodeStatsResolver) Score() string {
+	return r.stats.Score.String()
+}
+
 // -- Node Query --
 
 type NodePayloadResolver struct {
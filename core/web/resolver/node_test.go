@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -73,6 +75,57 @@ func TestResolver_Nodes(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name:          "with stats",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chainSet.On("NodeStatuses", mock.Anything, PageDefaultOffset, PageDefaultLimit).Return([]types.NodeStatus{
+					{
+						Name:    "node-name",
+						ChainID: chainID.String(),
+						Config:  `Name = 'node-name'`,
+					},
+				}, 1, nil)
+				f.Mocks.chainSet.On("NodeStats", chainID.String(), "node-name").Return(evmclient.NodeStats{
+					Latency:   120 * time.Millisecond,
+					ErrorRate: 0.25,
+					Score:     1370 * time.Millisecond,
+				}, true)
+			},
+			query: `
+				query GetNodes {
+					nodes {
+						results {
+							name
+							stats {
+								latency
+								errorRate
+								score
+							}
+						}
+						metadata {
+							total
+						}
+					}
+				}`,
+			result: `
+			{
+				"nodes": {
+					"results": [{
+						"name": "node-name",
+						"stats": {
+							"latency": "120ms",
+							"errorRate": 0.25,
+							"score": "1.37s"
+						}
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`,
+		},
 		{
 			name:          "generic error",
 			authenticated: true,
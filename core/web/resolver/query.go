@@ -15,14 +15,16 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesRead, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -48,7 +50,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesRead, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -65,7 +67,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context, args struct{ ID graphql.ID }) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionChainsRead, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -88,7 +90,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionChainsRead, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -105,7 +107,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsRead, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -127,7 +129,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsRead, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -141,7 +143,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsRead, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -168,7 +170,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsRead, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -184,7 +186,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -197,7 +199,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -211,7 +213,7 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 
 // Features retrieves each featured enabled by boolean mapping
 func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigRead, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -220,7 +222,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionChainsRead, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -241,11 +243,12 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 	if warn != nil {
 		r.App.GetLogger().Warnw("Error creating NodePayloadResolver", "name", name, "error", warn)
 	}
+	npr.nr.nodeStats = r.nodeStats
 	return npr, nil
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -259,7 +262,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -275,7 +278,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -294,7 +297,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsRead, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +323,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionChainsRead, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -336,14 +339,30 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	if warn != nil {
 		r.App.GetLogger().Warnw("Error creating NodesPayloadResolver", "error", warn)
 	}
+	for _, nr := range npr.nrs {
+		nr.nodeStats = r.nodeStats
+	}
 	return npr, nil
 }
 
+// nodeStats returns the rolling RPC stats of a running node, or nil.
+func (r *Resolver) nodeStats(status types.NodeStatus) *evmclient.NodeStats {
+	chainSet := r.App.GetChains().EVM
+	if chainSet == nil {
+		return nil
+	}
+	stats, ok := chainSet.NodeStats(status.ChainID, status.Name)
+	if !ok {
+		return nil
+	}
+	return &stats
+}
+
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRunsRead, sessions.ResourceRuns); err != nil {
 		return nil, err
 	}
 
@@ -361,7 +380,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRunsRead, sessions.ResourceRuns); err != nil {
 		return nil, err
 	}
 
@@ -383,7 +402,7 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -436,7 +455,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigRead, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -447,7 +466,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionTxsRead, sessions.ResourceTxs); err != nil {
 		return nil, err
 	}
 
@@ -468,7 +487,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionTxsRead, sessions.ResourceTxs); err != nil {
 		return nil, err
 	}
 
@@ -487,7 +506,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionTxsRead, sessions.ResourceTxs); err != nil {
 		return nil, err
 	}
 
@@ -503,7 +522,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigRead, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -513,7 +532,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -526,7 +545,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionConfigRead, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -537,7 +556,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysRead, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -548,3 +567,17 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// Roles resolves the list of built-in and custom roles
+func (r *Resolver) Roles(ctx context.Context) (*RolesPayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionRolesRead, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	roles, err := r.App.SessionORM().ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRolesPayload(roles), nil
+}
@@ -0,0 +1,219 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// RoleResolver resolves the Role type.
+type RoleResolver struct {
+	role sessions.Role
+}
+
+func NewRole(role sessions.Role) *RoleResolver {
+	return &RoleResolver{role: role}
+}
+
+func NewRoles(roles []sessions.Role) []*RoleResolver {
+	var resolvers []*RoleResolver
+	for _, role := range roles {
+		resolvers = append(resolvers, NewRole(role))
+	}
+
+	return resolvers
+}
+
+// Name resolves the role's name.
+func (r *RoleResolver) Name() string {
+	return string(r.role.Name)
+}
+
+// Description resolves the role's description.
+func (r *RoleResolver) Description() string {
+	return r.role.Description
+}
+
+// Permissions resolves the permissions the role grants.
+func (r *RoleResolver) Permissions() []string {
+	perms := []string{}
+	for _, p := range r.role.Permissions {
+		perms = append(perms, string(p))
+	}
+	return perms
+}
+
+// BuiltIn resolves whether the role is one of the built-in roles.
+func (r *RoleResolver) BuiltIn() bool {
+	return r.role.BuiltIn
+}
+
+// CreatedAt resolves the role's created at field.
+func (r *RoleResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.role.CreatedAt}
+}
+
+// UpdatedAt resolves the role's updated at field.
+func (r *RoleResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.role.UpdatedAt}
+}
+
+// RolesPayloadResolver resolves the list of roles
+type RolesPayloadResolver struct {
+	roles []sessions.Role
+}
+
+func NewRolesPayload(roles []sessions.Role) *RolesPayloadResolver {
+	return &RolesPayloadResolver{roles: roles}
+}
+
+// Results returns the roles.
+func (r *RolesPayloadResolver) Results() []*RoleResolver {
+	return NewRoles(r.roles)
+}
+
+func inputErrors(inputErrs map[string]string) *InputErrorsResolver {
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+	return NewInputErrors(errs)
+}
+
+// -- CreateRole Mutation --
+
+type CreateRolePayloadResolver struct {
+	role      *sessions.Role
+	inputErrs map[string]string
+}
+
+func NewCreateRolePayload(role *sessions.Role, inputErrs map[string]string) *CreateRolePayloadResolver {
+	return &CreateRolePayloadResolver{role: role, inputErrs: inputErrs}
+}
+
+func (r *CreateRolePayloadResolver) ToCreateRoleSuccess() (*CreateRoleSuccessResolver, bool) {
+	if r.role == nil {
+		return nil, false
+	}
+
+	return NewCreateRoleSuccess(*r.role), true
+}
+
+func (r *CreateRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return inputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type CreateRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewCreateRoleSuccess(role sessions.Role) *CreateRoleSuccessResolver {
+	return &CreateRoleSuccessResolver{role: role}
+}
+
+func (r *CreateRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
+
+// -- UpdateRole Mutation --
+
+type UpdateRolePayloadResolver struct {
+	role      *sessions.Role
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateRolePayload(role *sessions.Role, inputErrs map[string]string, err error) *UpdateRolePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "role not found"}
+
+	return &UpdateRolePayloadResolver{role: role, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateRolePayloadResolver) ToUpdateRoleSuccess() (*UpdateRoleSuccessResolver, bool) {
+	if r.role == nil {
+		return nil, false
+	}
+
+	return NewUpdateRoleSuccess(*r.role), true
+}
+
+func (r *UpdateRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return inputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type UpdateRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewUpdateRoleSuccess(role sessions.Role) *UpdateRoleSuccessResolver {
+	return &UpdateRoleSuccessResolver{role: role}
+}
+
+func (r *UpdateRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
+
+// -- DeleteRole Mutation --
+
+type DeleteRolePayloadResolver struct {
+	role *sessions.Role
+	NotFoundErrorUnionType
+}
+
+func NewDeleteRolePayload(role *sessions.Role, err error) *DeleteRolePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "role not found"}
+
+	return &DeleteRolePayloadResolver{role: role, NotFoundErrorUnionType: e}
+}
+
+func (r *DeleteRolePayloadResolver) ToDeleteRoleSuccess() (*DeleteRoleSuccessResolver, bool) {
+	if r.role == nil || r.err != nil {
+		return nil, false
+	}
+
+	return NewDeleteRoleSuccess(*r.role), true
+}
+
+func (r *DeleteRolePayloadResolver) ToDeleteRoleConflictError() (*DeleteRoleConflictErrorResolver, bool) {
+	if r.role != nil && r.err != nil {
+		return NewDeleteRoleConflictError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+type DeleteRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewDeleteRoleSuccess(role sessions.Role) *DeleteRoleSuccessResolver {
+	return &DeleteRoleSuccessResolver{role: role}
+}
+
+func (r *DeleteRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
+
+type DeleteRoleConflictErrorResolver struct {
+	message string
+}
+
+func NewDeleteRoleConflictError(message string) *DeleteRoleConflictErrorResolver {
+	return &DeleteRoleConflictErrorResolver{message: message}
+}
+
+func (r *DeleteRoleConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *DeleteRoleConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
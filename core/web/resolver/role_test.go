@@ -0,0 +1,249 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func TestResolver_Roles(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetRoles {
+			roles {
+				results {
+					name
+					description
+					permissions
+					builtIn
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "roles"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.Mocks.sessionsORM.On("ListRoles").Return([]clsessions.Role{
+					{Name: clsessions.UserRoleView, Description: "Read-only access", Permissions: clsessions.Permissions{clsessions.PermissionJobsRead}, BuiltIn: true},
+					{Name: "key-custodian", Description: "Key operations", Permissions: clsessions.Permissions{clsessions.PermissionKeysExport, clsessions.PermissionKeysRead}},
+				}, nil)
+			},
+			query: query,
+			result: `
+				{
+					"roles": {
+						"results": [{
+							"name": "view",
+							"description": "Read-only access",
+							"permissions": ["jobs.read"],
+							"builtIn": true
+						}, {
+							"name": "key-custodian",
+							"description": "Key operations",
+							"permissions": ["keys.export", "keys.read"],
+							"builtIn": false
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreateRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreateRole($input: CreateRoleInput!) {
+			createRole(input: $input) {
+				... on CreateRoleSuccess {
+					role {
+						name
+						permissions
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := func(name string, perms ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":        name,
+				"description": "Jobs and bridges",
+				"permissions": perms,
+			},
+		}
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables("job-operator", "jobs.*")}, "createRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.Mocks.sessionsORM.On("CreateCustomRole", mock.MatchedBy(func(role *clsessions.Role) bool {
+					return role.Name == "job-operator" && role.Permissions.String() == "bridges.read,jobs.read"
+				})).Return(nil)
+			},
+			query:     mutation,
+			variables: variables("job-operator", "jobs.read", "bridges.read"),
+			result: `
+				{
+					"createRole": {
+						"role": {
+							"name": "job-operator",
+							"permissions": ["bridges.read", "jobs.read"]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "built-in name",
+			authenticated: true,
+			query:         mutation,
+			variables:     variables("admin", "jobs.read"),
+			result: `
+				{
+					"createRole": {
+						"errors": [{
+							"path": "input/name",
+							"message": "invalid role name \"admin\": can not replace a built-in role",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "unknown permission",
+			authenticated: true,
+			query:         mutation,
+			variables:     variables("job-operator", "jobs.fly"),
+			result: `
+				{
+					"createRole": {
+						"errors": [{
+							"path": "input/permissions",
+							"message": "unknown permission \"jobs.fly\"",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not permitted",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := auth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+				session.User.Role = clsessions.UserRoleEdit
+			},
+			query:     mutation,
+			variables: variables("job-operator", "jobs.read"),
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{{
+				ResolverError: RoleNotPermittedErr{clsessions.UserRoleEdit, clsessions.PermissionRolesManage},
+				Path:          []interface{}{"createRole"},
+				Message:       "Not permitted with current role: edit, requires permission roles.manage",
+			}},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_DeleteRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DeleteRole($name: String!) {
+			deleteRole(name: $name) {
+				... on DeleteRoleSuccess {
+					role {
+						name
+					}
+				}
+				... on DeleteRoleConflictError {
+					message
+					code
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"name": "job-operator"}
+	role := clsessions.Role{Name: "job-operator"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.Mocks.sessionsORM.On("FindRole", "job-operator").Return(role, nil)
+				f.Mocks.sessionsORM.On("DeleteCustomRole", "job-operator").Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `{"deleteRole": {"role": {"name": "job-operator"}}}`,
+		},
+		{
+			name:          "in use",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.Mocks.sessionsORM.On("FindRole", "job-operator").Return(role, nil)
+				f.Mocks.sessionsORM.On("DeleteCustomRole", "job-operator").Return(clsessions.RoleInUseError{Role: "job-operator", Users: 2})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteRole": {
+						"message": "role job-operator is assigned to 2 user(s)",
+						"code": "UNPROCESSABLE"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.Mocks.sessionsORM.On("FindRole", "job-operator").Return(clsessions.Role{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteRole": {
+						"message": "role not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 type TaskRunResolver struct {
@@ -59,3 +60,84 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
+
+// TaskRunTraceResolver resolves the TaskRunTrace type.
+type TaskRunTraceResolver struct {
+	tr presenters.PipelineTaskTraceResource
+}
+
+func NewTaskRunTrace(tr pipeline.TaskRunTrace) *TaskRunTraceResolver {
+	return &TaskRunTraceResolver{tr: presenters.NewPipelineTaskTraceResource(tr)}
+}
+
+func NewTaskRunTraces(trace []pipeline.TaskRunTrace) []*TaskRunTraceResolver {
+	var resolvers []*TaskRunTraceResolver
+
+	for _, tr := range trace {
+		resolvers = append(resolvers, NewTaskRunTrace(tr))
+	}
+
+	return resolvers
+}
+
+func (r *TaskRunTraceResolver) DotID() string {
+	return r.tr.DotID
+}
+
+func (r *TaskRunTraceResolver) Type() string {
+	return string(r.tr.Type)
+}
+
+func (r *TaskRunTraceResolver) Index() int32 {
+	return r.tr.Index
+}
+
+func (r *TaskRunTraceResolver) Inputs() []*TaskResultResolver {
+	var resolvers []*TaskResultResolver
+
+	for _, input := range r.tr.Inputs {
+		resolvers = append(resolvers, &TaskResultResolver{result: input})
+	}
+
+	return resolvers
+}
+
+func (r *TaskRunTraceResolver) Output() *string {
+	return r.tr.Output
+}
+
+func (r *TaskRunTraceResolver) Error() *string {
+	return r.tr.Error
+}
+
+func (r *TaskRunTraceResolver) Attempts() int32 {
+	return int32(r.tr.Attempts)
+}
+
+func (r *TaskRunTraceResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.tr.CreatedAt}
+}
+
+func (r *TaskRunTraceResolver) FinishedAt() *graphql.Time {
+	if !r.tr.FinishedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.tr.FinishedAt.Time}
+}
+
+func (r *TaskRunTraceResolver) Duration() string {
+	return r.tr.Duration
+}
+
+// TaskResultResolver resolves the TaskResult type.
+type TaskResultResolver struct {
+	result presenters.PipelineTaskResult
+}
+
+func (r *TaskResultResolver) Output() *string {
+	return r.result.Output
+}
+
+func (r *TaskResultResolver) Error() *string {
+	return r.result.Error
+}
@@ -0,0 +1,155 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// RolesController manages the roles which users are assigned, and the
+// permissions they grant.
+type RolesController struct {
+	App chainlink.Application
+}
+
+// Index lists the built-in and custom roles.
+// Example:
+// "GET <application>/roles"
+func (rc *RolesController) Index(c *gin.Context) {
+	roles, err := rc.App.SessionORM().ListRoles()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResources(roles), "roles")
+}
+
+// Show returns a role by name.
+// Example:
+// "GET <application>/roles/:name"
+func (rc *RolesController) Show(c *gin.Context) {
+	role, err := rc.App.SessionORM().FindRole(c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Create creates a custom role.
+// Example:
+// "POST <application>/roles"
+func (rc *RolesController) Create(c *gin.Context) {
+	var request clsessions.RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := clsessions.ValidateRoleName(request.Name); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	perms, err := clsessions.ParsePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role := clsessions.Role{Name: clsessions.UserRole(request.Name), Description: request.Description, Permissions: perms}
+	if err = rc.App.SessionORM().CreateCustomRole(&role); err != nil {
+		// If this is a duplicate key error (code 23505), return a nicer error message
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusConflict, errors.Errorf("role %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.auditRole(c, audit.RoleCreated, role)
+	jsonAPIResponseWithStatus(c, presenters.NewRoleResource(role), "role", http.StatusCreated)
+}
+
+// Update replaces the description and permissions of a custom role. The
+// built-in roles can not be updated.
+// Example:
+// "PATCH <application>/roles/:name"
+func (rc *RolesController) Update(c *gin.Context) {
+	var request clsessions.RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	perms, err := clsessions.ParsePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role := clsessions.Role{Name: clsessions.UserRole(c.Param("name")), Description: request.Description, Permissions: perms}
+	err = rc.App.SessionORM().UpdateCustomRole(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if errors.Is(err, clsessions.ErrBuiltInRole) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.auditRole(c, audit.RoleUpdated, role)
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Delete deletes a custom role, which must not be assigned to any user.
+// Example:
+// "DELETE <application>/roles/:name"
+func (rc *RolesController) Delete(c *gin.Context) {
+	role, err := rc.App.SessionORM().FindRole(c.Param("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = rc.App.SessionORM().DeleteCustomRole(string(role.Name))
+	var inUse clsessions.RoleInUseError
+	if errors.Is(err, clsessions.ErrBuiltInRole) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if errors.As(err, &inUse) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.auditRole(c, audit.RoleDeleted, role)
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+func (rc *RolesController) auditRole(c *gin.Context, eventID audit.EventID, role clsessions.Role) {
+	data := map[string]interface{}{"name": role.Name, "permissions": role.Permissions.String()}
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		data["user"] = user.Email
+	}
+	rc.App.GetAuditLogger().Audit(eventID, data)
+}
@@ -0,0 +1,93 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestRolesController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	send := func(verb, path string, request sessions.RoleRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		var resp *http.Response
+		var cleanup func()
+		switch verb {
+		case "POST":
+			resp, cleanup = client.Post(path, bytes.NewBuffer(body))
+		case "PATCH":
+			resp, cleanup = client.Patch(path, bytes.NewBuffer(body))
+		}
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	assert.Equal(t, http.StatusUnprocessableEntity, send("POST", "/v2/roles", sessions.RoleRequest{Name: "Key Custodian"}).StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, send("POST", "/v2/roles", sessions.RoleRequest{Name: "admin"}).StatusCode)
+	assert.Equal(t, http.StatusUnprocessableEntity, send("POST", "/v2/roles", sessions.RoleRequest{Name: "key-custodian", Permissions: "keys.fly"}).StatusCode)
+
+	resp := send("POST", "/v2/roles", sessions.RoleRequest{Name: "key-custodian", Description: "Key operations only", Permissions: "keys.*"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var role presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &role))
+	assert.Equal(t, "key-custodian", role.ID)
+	assert.Contains(t, role.Permissions, string(sessions.PermissionKeysExport))
+	assert.False(t, role.BuiltIn)
+	assert.Equal(t, http.StatusConflict, send("POST", "/v2/roles", sessions.RoleRequest{Name: "key-custodian"}).StatusCode)
+
+	resp, cleanup := client.Get("/v2/roles")
+	t.Cleanup(cleanup)
+	var roles []presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &roles))
+	require.Len(t, roles, 5)
+
+	// A user of the custom role is only permitted key operations.
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.SessionORM().CreateUser(&user))
+	_, err := app.SessionORM().UpdateRole(user.Email, "key-custodian")
+	require.NoError(t, err)
+	userClient := app.NewHTTPClient(user.Email)
+	resp, cleanup = userClient.Get("/v2/keys/eth")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, cleanup = userClient.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, string(sessions.PermissionJobsRead), resp.Header.Get("forbidden-required-permission"))
+
+	resp = send("PATCH", "/v2/roles/key-custodian", sessions.RoleRequest{Description: "Keys and jobs", Permissions: "keys.read,jobs.read"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, cleanup = userClient.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assert.Equal(t, http.StatusBadRequest, send("PATCH", "/v2/roles/edit", sessions.RoleRequest{Permissions: "jobs.read"}).StatusCode)
+	assert.Equal(t, http.StatusNotFound, send("PATCH", "/v2/roles/no-such-role", sessions.RoleRequest{}).StatusCode)
+
+	resp, cleanup = client.Delete("/v2/roles/key-custodian")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	require.NoError(t, app.SessionORM().DeleteUser(user.Email))
+	resp, cleanup = client.Delete("/v2/roles/key-custodian")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, cleanup = client.Get("/v2/roles/key-custodian")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -81,7 +82,9 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 
 	debugRoutes(app, api)
 	healthRoutes(app, api)
-	sessionRoutes(app, api)
+	if err = sessionRoutes(app, api); err != nil {
+		return nil, err
+	}
 	v2Routes(app, api)
 	loopRoutes(app, api)
 
@@ -203,7 +206,7 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 	}
 }
 
-func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
+func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) error {
 	config := app.GetConfig()
 	unauth := r.Group("/", rateLimiter(
 		config.UnAuthenticatedRateLimitPeriod().Duration(),
@@ -211,8 +214,18 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	if config.OIDCEnabled() {
+		provider, err := clsessions.NewOIDCProvider(config)
+		if err != nil {
+			return errors.Wrap(err, "failed to enable OIDC login")
+		}
+		oc := NewOIDCController(app, provider)
+		unauth.GET("/oidc/login", oc.Login)
+		unauth.GET("/oidc/callback", oc.Callback)
+	}
 	auth := r.Group("/", auth.Authenticate(app.SessionORM(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
+	return nil
 }
 
 func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
@@ -238,99 +251,117 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.AuthorizeScopes)
 	{
 		uc := UserController{app}
-		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
-		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
-		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
-		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.GET("/users", auth.RequiresPermission(clsessions.PermissionUsersRead, uc.Index))
+		authv2.POST("/users", auth.RequiresPermission(clsessions.PermissionUsersManage, uc.Create))
+		authv2.PATCH("/users", auth.RequiresPermission(clsessions.PermissionUsersManage, uc.UpdateRole))
+		authv2.DELETE("/users/:email", auth.RequiresPermission(clsessions.PermissionUsersManage, uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		rlc := RolesController{app}
+		authv2.GET("/roles", auth.RequiresPermission(clsessions.PermissionRolesRead, rlc.Index))
+		authv2.GET("/roles/:name", auth.RequiresPermission(clsessions.PermissionRolesRead, rlc.Show))
+		authv2.POST("/roles", auth.RequiresPermission(clsessions.PermissionRolesManage, rlc.Create))
+		authv2.PATCH("/roles/:name", auth.RequiresPermission(clsessions.PermissionRolesManage, rlc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresPermission(clsessions.PermissionRolesManage, rlc.Delete))
+
+		atc := APITokensController{app}
+		authv2.GET("/api_tokens", atc.Index)
+		authv2.POST("/api_tokens", atc.Create)
+		authv2.DELETE("/api_tokens/:accessKey", atc.Revoke)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
-		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.GET("/external_initiators", auth.RequiresPermission(clsessions.PermissionExternalInitiatorsRead, paginatedRequest(eia.Index)))
+		authv2.POST("/external_initiators", auth.RequiresPermission(clsessions.PermissionExternalInitiatorsCreate, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(clsessions.PermissionExternalInitiatorsDelete, eia.Destroy))
 
 		bt := BridgeTypesController{app}
-		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
-		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.GET("/bridge_types", auth.RequiresPermission(clsessions.PermissionBridgesRead, paginatedRequest(bt.Index)))
+		authv2.POST("/bridge_types", auth.RequiresPermission(clsessions.PermissionBridgesCreate, bt.Create))
+		authv2.GET("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.PermissionBridgesRead, bt.Show))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.PermissionBridgesUpdate, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.PermissionBridgesDelete, bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
-		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers", auth.RequiresPermission(clsessions.PermissionTxsTransfer, ets.Create))
+		authv2.POST("/transfers/evm", auth.RequiresPermission(clsessions.PermissionTxsTransfer, ets.Create))
 		tts := CosmosTransfersController{app}
-		authv2.POST("/transfers/cosmos", auth.RequiresAdminRole(tts.Create))
+		authv2.POST("/transfers/cosmos", auth.RequiresPermission(clsessions.PermissionTxsTransfer, tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
+		authv2.POST("/transfers/solana", auth.RequiresPermission(clsessions.PermissionTxsTransfer, sts.Create))
 
 		cc := ConfigController{app}
-		authv2.GET("/config", cc.Show)
-		authv2.GET("/config/v2", cc.Show)
+		authv2.GET("/config", auth.RequiresPermission(clsessions.PermissionConfigRead, cc.Show))
+		authv2.GET("/config/v2", auth.RequiresPermission(clsessions.PermissionConfigRead, cc.Show))
 
 		tas := TxAttemptsController{app}
-		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
-		authv2.GET("/tx_attempts/evm", paginatedRequest(tas.Index))
+		authv2.GET("/tx_attempts", auth.RequiresPermission(clsessions.PermissionTxsRead, paginatedRequest(tas.Index)))
+		authv2.GET("/tx_attempts/evm", auth.RequiresPermission(clsessions.PermissionTxsRead, paginatedRequest(tas.Index)))
 
 		txs := TransactionsController{app}
-		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/evm/:TxHash", txs.Show)
-		authv2.GET("/transactions", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.GET("/transactions/evm", auth.RequiresPermission(clsessions.PermissionTxsRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/evm/:TxHash", auth.RequiresPermission(clsessions.PermissionTxsRead, txs.Show))
+		authv2.GET("/transactions", auth.RequiresPermission(clsessions.PermissionTxsRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/:TxHash", auth.RequiresPermission(clsessions.PermissionTxsRead, txs.Show))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", auth.RequiresPermission(clsessions.PermissionChainsReplay, rc.ReplayFromBlock))
 
 		csakc := CSAKeysController{app}
-		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.GET("/keys/csa", auth.RequiresPermission(clsessions.PermissionKeysRead, csakc.Index))
+		authv2.POST("/keys/csa", auth.RequiresPermission(clsessions.PermissionKeysCreate, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(clsessions.PermissionKeysImport, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysExport, csakc.Export))
 
 		ekc := NewETHKeysController(app)
-		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/eth", auth.RequiresPermission(clsessions.PermissionKeysRead, ekc.Index))
+		authv2.POST("/keys/eth", auth.RequiresPermission(clsessions.PermissionKeysCreate, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(clsessions.PermissionKeysImport, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(clsessions.PermissionKeysExport, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
-		authv2.GET("/keys/evm", ekc.Index)
-		authv2.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/evm/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		authv2.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.GET("/keys/evm", auth.RequiresPermission(clsessions.PermissionKeysRead, ekc.Index))
+		authv2.POST("/keys/evm", auth.RequiresPermission(clsessions.PermissionKeysCreate, ekc.Create))
+		authv2.DELETE("/keys/evm/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, ekc.Delete))
+		authv2.POST("/keys/evm/import", auth.RequiresPermission(clsessions.PermissionKeysImport, ekc.Import))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(clsessions.PermissionKeysExport, ekc.Export))
+		authv2.POST("/keys/evm/chain", auth.RequiresPermission(clsessions.PermissionKeysChain, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
-		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.GET("/keys/ocr", auth.RequiresPermission(clsessions.PermissionKeysRead, ocrkc.Index))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(clsessions.PermissionKeysCreate, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(clsessions.PermissionKeysImport, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysExport, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
-		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.GET("/keys/ocr2", auth.RequiresPermission(clsessions.PermissionKeysRead, ocr2kc.Index))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(clsessions.PermissionKeysCreate, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(clsessions.PermissionKeysImport, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysExport, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
-		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.GET("/keys/p2p", auth.RequiresPermission(clsessions.PermissionKeysRead, p2pkc.Index))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(clsessions.PermissionKeysCreate, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(clsessions.PermissionKeysImport, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysExport, p2pkc.Export))
+
+		krc := KeyRotationsController{app}
+		authv2.GET("/keys/rotations", auth.RequiresPermission(clsessions.PermissionKeysRead, krc.Index))
+		authv2.GET("/keys/rotations/references/:keyType/:keyID", auth.RequiresPermission(clsessions.PermissionKeysRead, krc.References))
+		authv2.POST("/keys/rotations", auth.RequiresPermission(clsessions.PermissionKeysRotate, krc.Create))
+		authv2.POST("/keys/rotations/:ID/confirm", auth.RequiresPermission(clsessions.PermissionKeysRotate, krc.Confirm))
 
 		for _, keys := range []struct {
 			path string
@@ -342,42 +373,43 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"dkgsign", NewDKGSignKeysController(app)},
 			{"dkgencrypt", NewDKGEncryptKeysController(app)},
 		} {
-			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.GET("/keys/"+keys.path, auth.RequiresPermission(clsessions.PermissionKeysRead, keys.kc.Index))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(clsessions.PermissionKeysCreate, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(clsessions.PermissionKeysImport, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysExport, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
-		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.GET("/keys/vrf", auth.RequiresPermission(clsessions.PermissionKeysRead, vrfkc.Index))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(clsessions.PermissionKeysCreate, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(clsessions.PermissionKeysDelete, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(clsessions.PermissionKeysImport, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(clsessions.PermissionKeysExport, vrfkc.Export))
 
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs", auth.RequiresPermission(clsessions.PermissionJobsRead, paginatedRequest(jc.Index)))
+		authv2.GET("/jobs/:ID", auth.RequiresPermission(clsessions.PermissionJobsRead, jc.Show))
+		authv2.POST("/jobs", auth.RequiresPermission(clsessions.PermissionJobsCreate, jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresPermission(clsessions.PermissionJobsSimulate, jc.Simulate))
+		authv2.PUT("/jobs/:ID", auth.RequiresPermission(clsessions.PermissionJobsUpdate, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresPermission(clsessions.PermissionJobsDelete, jc.Delete))
 
 		// PipelineRunsController
-		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/pipeline/runs", auth.RequiresPermission(clsessions.PermissionRunsRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs", auth.RequiresPermission(clsessions.PermissionRunsRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs/:runID", auth.RequiresPermission(clsessions.PermissionRunsRead, prc.Show))
 
 		// FeaturesController
 		fc := FeaturesController{app}
-		authv2.GET("/features", fc.Index)
+		authv2.GET("/features", auth.RequiresPermission(clsessions.PermissionConfigRead, fc.Index))
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresPermission(clsessions.PermissionJobsDismissError, psec.Destroy))
 
 		lgc := LogController{app}
-		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+		authv2.GET("/log", auth.RequiresPermission(clsessions.PermissionConfigRead, lgc.Get))
+		authv2.PATCH("/log", auth.RequiresPermission(clsessions.PermissionConfigUpdate, lgc.Patch))
 
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
@@ -389,8 +421,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetChainsController(app)},
 			{"cosmos", NewCosmosChainsController(app)},
 		} {
-			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
-			chains.GET(chain.path+"/:ID", chain.cc.Show)
+			chains.GET(chain.path, auth.RequiresPermission(clsessions.PermissionChainsRead, paginatedRequest(chain.cc.Index)))
+			chains.GET(chain.path+"/:ID", auth.RequiresPermission(clsessions.PermissionChainsRead, chain.cc.Show))
 		}
 
 		nodes := authv2.Group("nodes")
@@ -405,19 +437,19 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		} {
 			if chain.path == "evm" {
 				// TODO still EVM only https://app.shortcut.com/chainlinklabs/story/26276/multi-chain-type-ui-node-chain-configuration
-				nodes.GET("", paginatedRequest(chain.nc.Index))
+				nodes.GET("", auth.RequiresPermission(clsessions.PermissionChainsRead, paginatedRequest(chain.nc.Index)))
 			}
-			nodes.GET(chain.path, paginatedRequest(chain.nc.Index))
-			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
+			nodes.GET(chain.path, auth.RequiresPermission(clsessions.PermissionChainsRead, paginatedRequest(chain.nc.Index)))
+			chains.GET(chain.path+"/:ID/nodes", auth.RequiresPermission(clsessions.PermissionChainsRead, paginatedRequest(chain.nc.Index)))
 		}
 
 		efc := EVMForwardersController{app}
-		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.GET("/nodes/evm/forwarders", auth.RequiresPermission(clsessions.PermissionChainsRead, paginatedRequest(efc.Index)))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresPermission(clsessions.PermissionChainsForwarders, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresPermission(clsessions.PermissionChainsForwarders, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
-		authv2.GET("/build_info", buildInfo.Show)
+		authv2.GET("/build_info", auth.RequiresPermission(clsessions.PermissionConfigRead, buildInfo.Show))
 
 		// Debug routes accessible via authentication
 		metricRoutes(authv2, build.IsDev())
@@ -428,9 +460,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.AuthorizeScopes)
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresPermission(clsessions.PermissionRunsCreate, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -55,11 +56,15 @@ func (c *UserController) Create(ctx *gin.Context) {
 		return
 	}
 
-	userRole, err := clsession.GetUserRole(request.Role)
-	if err != nil {
-		jsonAPIError(ctx, http.StatusBadRequest, err)
+	role, err := c.App.SessionORM().FindRole(request.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.Errorf("Invalid role: %s. Allowed roles are the built-in roles 'admin', 'edit', 'run', 'view', and the custom roles listed by 'chainlink admin roles list'.", request.Role))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	userRole := role.Name
 
 	if verr := clsession.ValidateEmail(request.Email); verr != nil {
 		jsonAPIError(ctx, http.StatusBadRequest, verr)
@@ -123,12 +128,15 @@ func (c *UserController) UpdateRole(ctx *gin.Context) {
 		return
 	}
 	if request.NewRole == "" {
-		jsonAPIError(ctx, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view'"))
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view', or a custom role"))
 		return
 	}
-	_, err := clsession.GetUserRole(request.NewRole)
-	if err != nil {
-		jsonAPIError(ctx, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view'"))
+	_, err := c.App.SessionORM().FindRole(request.NewRole)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view', or a custom role"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 